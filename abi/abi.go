@@ -5,6 +5,7 @@ import (
 	"crypto/sha3"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -18,12 +19,16 @@ type TypeEnum int
 const (
 	// Function represents a function entry
 	Function TypeEnum = iota
+	// Event represents an event entry
+	Event
 )
 
 func (te TypeEnum) String() string {
 	switch te {
 	case Function:
 		return "function"
+	case Event:
+		return "event"
 	default:
 		return "unknown"
 	}
@@ -65,11 +70,10 @@ func DecodeList(params []Param, encoded []byte) ([]interface{}, error) {
 
 		if param.Type.IsDynamicType() {
 			// For dynamic types, read the offset pointer
-			offsetBig, decodeErr := DecodeInt(encoded, offset)
+			dataOffset, decodeErr := decodeSize(encoded, offset, "offset", len(encoded))
 			if decodeErr != nil {
-				return nil, fmt.Errorf("failed to decode offset for param %s: %w", param.Name, decodeErr)
+				return nil, fmt.Errorf("param %s: %w", param.Name, decodeErr)
 			}
-			dataOffset := int(offsetBig.Int64())
 
 			// Decode from the pointed location
 			decoded, err = param.Type.Decode(encoded, dataOffset)
@@ -289,13 +293,19 @@ func parseEntries(jsonStr string) ([]Entry, error) {
 			return nil, fmt.Errorf("entry missing 'name' field")
 		}
 
-		// Check entry type (only functions supported for now)
+		// Check entry type (functions and events are supported)
 		entryType, ok := raw["type"].(string)
 		if !ok {
 			return nil, fmt.Errorf("entry missing 'type' field")
 		}
-		if entryType != "function" {
-			return nil, fmt.Errorf("only ABI functions supported, got: %s", entryType)
+		var kind TypeEnum
+		switch entryType {
+		case "function":
+			kind = Function
+		case "event":
+			kind = Event
+		default:
+			return nil, fmt.Errorf("only ABI functions and events supported, got: %s", entryType)
 		}
 
 		// Parse inputs
@@ -318,16 +328,17 @@ func parseEntries(jsonStr string) ([]Entry, error) {
 				if err != nil {
 					return nil, fmt.Errorf("failed to create param '%s': %w", paramName, err)
 				}
+				//nolint:errcheck // indexed is optional and only meaningful for events
+				param.Indexed, _ = inputMap["indexed"].(bool)
 
 				inputs = append(inputs, *param)
 			}
 		}
 
-		// Create ABI function entry
 		entry := Entry{
 			Name:   name,
 			Inputs: inputs,
-			Type:   Function,
+			Type:   kind,
 		}
 		entries = append(entries, entry)
 	}
@@ -352,7 +363,7 @@ func (a *Abi) EncodeFunction(name string, args []interface{}) ([]byte, error) {
 	// Find function by name
 	var foundEntry *Entry
 	for i := range a.Entries {
-		if a.Entries[i].Name == name {
+		if a.Entries[i].Type == Function && a.Entries[i].Name == name {
 			foundEntry = &a.Entries[i]
 			break
 		}
@@ -382,6 +393,9 @@ func (a *Abi) DecodeFunction(encoded []byte) ([]interface{}, error) {
 	// Find matching function by signature
 	var foundEntry *Entry
 	for i := range a.Entries {
+		if a.Entries[i].Type != Function {
+			continue
+		}
 		entrySignature := extractSignature(a.Entries[i].EncodeSignature())
 		if bytes.Equal(signature, entrySignature) {
 			foundEntry = &a.Entries[i]
@@ -400,3 +414,206 @@ func (a *Abi) DecodeFunction(encoded []byte) ([]interface{}, error) {
 
 	return fn.Decode(encoded)
 }
+
+// =============================================================================
+// AbiContract - Method Table Built From a JSON ABI Definition
+// =============================================================================
+
+// AbiMethod describes one contract method loaded by ParseABI.
+//
+// Types lists the input types in declaration order and Selector holds the
+// 4-byte function selector that prefixes every encoded call.
+type AbiMethod struct {
+	Name     string
+	Types    []AbiType
+	Selector []byte
+
+	function *AbiFunction
+}
+
+// AbiEvent describes one event loaded by ParseABI.
+//
+// Inputs lists every declared field in declaration order; fields marked
+// indexed are not part of the event data.
+type AbiEvent struct {
+	Name   string
+	Inputs []Param
+}
+
+// AbiContract is a method table built from a JSON ABI definition.
+//
+// It lets callers encode and decode contract calls by method name without
+// listing parameter types by hand. Create one with ParseABI.
+type AbiContract struct {
+	Methods map[string]*AbiMethod
+	Events  map[string]*AbiEvent
+}
+
+// ParseABI builds an AbiContract from a JSON ABI definition.
+//
+// The JSON format is the one accepted by FromJson: an array of function and
+// event entries, each with a name and a list of inputs carrying a name and
+// type. Event inputs may also set "indexed".
+//
+// Parameters:
+//   - jsonBytes: JSON ABI definition
+//
+// Returns the contract method and event tables, or an error when the JSON is
+// malformed, an entry uses an unsupported type, or two methods or two events
+// share the same name.
+//
+// Example:
+//
+//	contract, err := abi.ParseABI([]byte(embedded.PlasmaDefinition))
+//	if err != nil {
+//	    return err
+//	}
+//	data, err := contract.PackMethod("Fuse", beneficiary)
+func ParseABI(jsonBytes []byte) (*AbiContract, error) {
+	entries, err := parseEntries(string(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	methods := make(map[string]*AbiMethod, len(entries))
+	events := make(map[string]*AbiEvent)
+	for _, entry := range entries {
+		if entry.Type == Event {
+			if _, exists := events[entry.Name]; exists {
+				return nil, fmt.Errorf("duplicate event '%s' in ABI", entry.Name)
+			}
+			events[entry.Name] = &AbiEvent{Name: entry.Name, Inputs: entry.Inputs}
+			continue
+		}
+		if _, exists := methods[entry.Name]; exists {
+			return nil, fmt.Errorf("duplicate method '%s' in ABI", entry.Name)
+		}
+		fn := NewAbiFunction(entry.Name, entry.Inputs)
+		inputTypes := make([]AbiType, len(entry.Inputs))
+		for i, input := range entry.Inputs {
+			inputTypes[i] = input.Type
+		}
+		methods[entry.Name] = &AbiMethod{
+			Name:     entry.Name,
+			Types:    inputTypes,
+			Selector: fn.EncodeSignature(),
+			function: fn,
+		}
+	}
+
+	return &AbiContract{Methods: methods, Events: events}, nil
+}
+
+// Method returns the method with the given name, or an error if the contract
+// does not declare it.
+func (c *AbiContract) Method(name string) (*AbiMethod, error) {
+	method, ok := c.Methods[name]
+	if !ok {
+		return nil, fmt.Errorf("method '%s' not found in ABI", name)
+	}
+	return method, nil
+}
+
+// PackMethod encodes a call to the named method.
+//
+// The result is the 4-byte selector followed by the ABI-encoded arguments,
+// ready to be used as account block data.
+//
+// Parameters:
+//   - name: Method name as declared in the ABI
+//   - args: One value per declared input, in declaration order
+//
+// Returns the encoded call data, or an error when the method is unknown, the
+// argument count is wrong, or an argument cannot be encoded as its type.
+func (c *AbiContract) PackMethod(name string, args ...interface{}) ([]byte, error) {
+	method, err := c.Method(name)
+	if err != nil {
+		return nil, err
+	}
+	return method.function.Encode(args)
+}
+
+// UnpackMethod decodes call data for the named method.
+//
+// Parameters:
+//   - name: Method name as declared in the ABI
+//   - data: Encoded call data including the 4-byte selector
+//
+// Returns the decoded arguments in declaration order, or an error when the
+// method is unknown, the selector belongs to a different method, or the
+// arguments cannot be decoded.
+func (c *AbiContract) UnpackMethod(name string, data []byte) ([]interface{}, error) {
+	method, err := c.Method(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < EncodedSignLength {
+		return nil, fmt.Errorf("encoded data too short: %d bytes", len(data))
+	}
+	if !bytes.Equal(data[:EncodedSignLength], method.Selector) {
+		return nil, fmt.Errorf("selector %x does not match method '%s' (%x)", data[:EncodedSignLength], name, method.Selector)
+	}
+	return method.function.Decode(data)
+}
+
+// Event returns the event with the given name, or an error if the contract
+// does not declare it.
+func (c *AbiContract) Event(name string) (*AbiEvent, error) {
+	event, ok := c.Events[name]
+	if !ok {
+		return nil, fmt.Errorf("event '%s' not found in ABI", name)
+	}
+	return event, nil
+}
+
+// UnpackEvent decodes the data of the named event into its field values.
+//
+// Only the non-indexed fields are decoded, in declaration order, from data
+// encoded as an argument list without a selector. Zenon has no EVM-style log
+// topics, so indexed fields are skipped rather than read from elsewhere.
+//
+// Parameters:
+//   - name: Event name as declared in the ABI
+//   - data: ABI-encoded non-indexed fields
+//
+// Returns the decoded values keyed by field name, or an error when the event
+// is unknown or the data cannot be decoded. An unnamed field is keyed by its
+// zero-based position among all declared fields, such as "1".
+//
+// Example:
+//
+//	fields, err := contract.UnpackEvent("Fused", data)
+//	if err != nil {
+//	    return err
+//	}
+//	amount := fields["amount"].(*big.Int)
+func (c *AbiContract) UnpackEvent(name string, data []byte) (map[string]interface{}, error) {
+	event, err := c.Event(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var params []Param
+	var keys []string
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			continue
+		}
+		key := input.Name
+		if key == "" {
+			key = strconv.Itoa(i)
+		}
+		params = append(params, input)
+		keys = append(keys, key)
+	}
+
+	values, err := DecodeList(params, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode event '%s': %w", name, err)
+	}
+	fields := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		fields[keys[i]] = value
+	}
+	return fields, nil
+}
@@ -0,0 +1,89 @@
+package abi
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestSafeLen(t *testing.T) {
+	tooBig := new(big.Int).Lsh(big.NewInt(1), 64)
+	tests := []struct {
+		name    string
+		value   *big.Int
+		max     int
+		want    int
+		wantErr bool
+	}{
+		{name: "zero", value: big.NewInt(0), max: 0, want: 0},
+		{name: "at max", value: big.NewInt(64), max: 64, want: 64},
+		{name: "above max", value: big.NewInt(65), max: 64, wantErr: true},
+		{name: "negative", value: big.NewInt(-1), max: 64, wantErr: true},
+		{name: "beyond int64", value: tooBig, max: math.MaxInt, wantErr: true},
+		{name: "negative max", value: big.NewInt(1), max: -32, wantErr: true},
+		{name: "nil", value: nil, max: 64, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := safeLen(tt.value, tt.max)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("safeLen(%v, %d) error = %v, wantErr %v", tt.value, tt.max, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("safeLen(%v, %d) = %d, want %d", tt.value, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+// FuzzDecodeSizeWords feeds arbitrary length and offset words to the dynamic
+// decoders. They must return an error for anything out of range, never panic
+// or allocate beyond the input.
+func FuzzDecodeSizeWords(f *testing.F) {
+	huge, _ := EncodeUint(1 << 40)
+	f.Add(EncodeInt(-32), []byte{})
+	f.Add(huge, make([]byte, 3*Int32Size))
+	f.Add(EncodeInt(2), make([]byte, 2*Int32Size))
+	f.Add(EncodeInt(64), []byte("short"))
+
+	typeNames := []string{"bytes", "string", "uint256[]", "string[]", "bytes[2]", "uint8[][]"}
+	abiTypes := make([]AbiType, len(typeNames))
+	for i, name := range typeNames {
+		abiType, err := GetType(name)
+		if err != nil {
+			f.Fatalf("GetType(%s): %v", name, err)
+		}
+		abiTypes[i] = abiType
+	}
+
+	f.Fuzz(func(t *testing.T, word []byte, tail []byte) {
+		sizeWord := make([]byte, Int32Size)
+		copy(sizeWord, word)
+		encoded := append(sizeWord, tail...)
+
+		for i, abiType := range abiTypes {
+			decoded, err := abiType.Decode(encoded, 0)
+			if err != nil {
+				continue
+			}
+			// Every decoded element or byte came from the input.
+			switch v := decoded.(type) {
+			case []byte:
+				if len(v) > len(encoded) {
+					t.Fatalf("%s decoded %d bytes from %d-byte input", typeNames[i], len(v), len(encoded))
+				}
+			case string:
+				if len(v) > len(encoded) {
+					t.Fatalf("%s decoded %d bytes from %d-byte input", typeNames[i], len(v), len(encoded))
+				}
+			case []interface{}:
+				if len(v)*Int32Size > len(encoded) {
+					t.Fatalf("%s decoded %d elements from %d-byte input", typeNames[i], len(v), len(encoded))
+				}
+			}
+		}
+
+		params := []Param{{Name: "data", Type: abiTypes[0]}, {Name: "list", Type: abiTypes[3]}}
+		_, _ = DecodeList(params, encoded)
+	})
+}
@@ -520,14 +520,14 @@ func TestFromJson_InvalidType(t *testing.T) {
 	jsonStr := `[
 		{
 			"name": "test",
-			"type": "event",
+			"type": "constructor",
 			"inputs": []
 		}
 	]`
 
 	_, err := FromJson(jsonStr)
 	if err == nil {
-		t.Error("FromJson() expected error for non-function type, got nil")
+		t.Error("FromJson() expected error for unsupported entry type, got nil")
 	}
 }
 
@@ -868,6 +868,99 @@ func TestDecodeList_MultipleDynamicBeforeStatic(t *testing.T) {
 	}
 }
 
+// ==================== AbiContract Tests ====================
+
+const twoMethodABI = `[
+	{"type": "function", "name": "Fuse", "inputs": [{"name": "address", "type": "address"}]},
+	{"type": "function", "name": "CancelFuse", "inputs": [{"name": "id", "type": "hash"}]}
+]`
+
+func TestParseABI_MethodTable(t *testing.T) {
+	contract, err := ParseABI([]byte(twoMethodABI))
+	if err != nil {
+		t.Fatalf("ParseABI() error = %v", err)
+	}
+	if len(contract.Methods) != 2 {
+		t.Fatalf("len(Methods) = %d, want 2", len(contract.Methods))
+	}
+
+	fuse, err := contract.Method("Fuse")
+	if err != nil {
+		t.Fatalf("Method() error = %v", err)
+	}
+	if len(fuse.Types) != 1 || fuse.Types[0].GetName() != "address" {
+		t.Errorf("Fuse types = %v, want [address]", fuse.Types)
+	}
+	want := NewAbiFunction("Fuse", []Param{{Name: "address", Type: mustGetType("address")}}).EncodeSignature()
+	if hex.EncodeToString(fuse.Selector) != hex.EncodeToString(want) {
+		t.Errorf("Fuse selector = %x, want %x", fuse.Selector, want)
+	}
+}
+
+func TestAbiContract_PackUnpackRoundTrip(t *testing.T) {
+	contract, err := ParseABI([]byte(twoMethodABI))
+	if err != nil {
+		t.Fatalf("ParseABI() error = %v", err)
+	}
+
+	address := types.PlasmaContract
+	data, err := contract.PackMethod("Fuse", address)
+	if err != nil {
+		t.Fatalf("PackMethod() error = %v", err)
+	}
+	decoded, err := contract.UnpackMethod("Fuse", data)
+	if err != nil {
+		t.Fatalf("UnpackMethod() error = %v", err)
+	}
+	if decoded[0].(types.Address) != address {
+		t.Errorf("decoded address = %v, want %v", decoded[0], address)
+	}
+
+	id := types.HexToHashPanic("0101010101010101010101010101010101010101010101010101010101010101")
+	data, err = contract.PackMethod("CancelFuse", id)
+	if err != nil {
+		t.Fatalf("PackMethod() error = %v", err)
+	}
+	decoded, err = contract.UnpackMethod("CancelFuse", data)
+	if err != nil {
+		t.Fatalf("UnpackMethod() error = %v", err)
+	}
+	if decoded[0].(types.Hash) != id {
+		t.Errorf("decoded id = %v, want %v", decoded[0], id)
+	}
+
+	if _, err := contract.UnpackMethod("Fuse", data); err == nil {
+		t.Error("UnpackMethod() expected selector mismatch error, got nil")
+	}
+}
+
+func TestAbiContract_Errors(t *testing.T) {
+	contract, err := ParseABI([]byte(twoMethodABI))
+	if err != nil {
+		t.Fatalf("ParseABI() error = %v", err)
+	}
+	if _, err := contract.PackMethod("Missing"); err == nil {
+		t.Error("PackMethod() expected unknown method error, got nil")
+	}
+	if _, err := contract.PackMethod("Fuse"); err == nil {
+		t.Error("PackMethod() expected argument count error, got nil")
+	}
+	if _, err := contract.UnpackMethod("Fuse", []byte{1, 2}); err == nil {
+		t.Error("UnpackMethod() expected short data error, got nil")
+	}
+
+	duplicate := `[
+		{"type": "function", "name": "Fuse", "inputs": []},
+		{"type": "function", "name": "Fuse", "inputs": []}
+	]`
+	if _, err := ParseABI([]byte(duplicate)); err == nil {
+		t.Error("ParseABI() expected duplicate method error, got nil")
+	}
+	if _, err := ParseABI([]byte("not json")); err == nil {
+		t.Error("ParseABI() expected JSON error, got nil")
+	}
+}
+
 // ==================== Helper Functions ====================
 
 func mustGetType(typeName string) AbiType {
@@ -877,3 +970,94 @@ func mustGetType(typeName string) AbiType {
 	}
 	return t
 }
+
+// ==================== Event Tests ====================
+
+const eventABI = `[
+	{"type": "function", "name": "Fuse", "inputs": [{"name": "address", "type": "address"}]},
+	{"type": "event", "name": "Fused", "inputs": [
+		{"name": "beneficiary", "type": "address", "indexed": true},
+		{"name": "memo", "type": "string"},
+		{"name": "amount", "type": "uint256"}
+	]}
+]`
+
+func TestAbiContract_UnpackEvent(t *testing.T) {
+	contract, err := ParseABI([]byte(eventABI))
+	if err != nil {
+		t.Fatalf("ParseABI() error = %v", err)
+	}
+	if len(contract.Methods) != 1 || len(contract.Events) != 1 {
+		t.Fatalf("methods = %d, events = %d, want 1 and 1", len(contract.Methods), len(contract.Events))
+	}
+	event, err := contract.Event("Fused")
+	if err != nil {
+		t.Fatalf("Event() error = %v", err)
+	}
+	if !event.Inputs[0].Indexed || event.Inputs[1].Indexed {
+		t.Errorf("indexed flags = %v, %v, want true, false", event.Inputs[0].Indexed, event.Inputs[1].Indexed)
+	}
+
+	data, err := NewEntry("Fused", event.Inputs[1:], Event).EncodeArguments([]interface{}{"plasma for bot", big.NewInt(5000000000)})
+	if err != nil {
+		t.Fatalf("EncodeArguments() error = %v", err)
+	}
+	fields, err := contract.UnpackEvent("Fused", data)
+	if err != nil {
+		t.Fatalf("UnpackEvent() error = %v", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("fields = %v, want memo and amount only", fields)
+	}
+	if fields["memo"] != "plasma for bot" {
+		t.Errorf("memo = %v, want %q", fields["memo"], "plasma for bot")
+	}
+	if amount, ok := fields["amount"].(*big.Int); !ok || amount.Int64() != 5000000000 {
+		t.Errorf("amount = %v, want 5000000000", fields["amount"])
+	}
+}
+
+func TestAbiContract_UnpackEventErrors(t *testing.T) {
+	contract, err := ParseABI([]byte(eventABI))
+	if err != nil {
+		t.Fatalf("ParseABI() error = %v", err)
+	}
+	if _, err := contract.UnpackEvent("Missing", nil); err == nil {
+		t.Error("UnpackEvent() expected unknown event error, got nil")
+	}
+	if _, err := contract.UnpackEvent("Fuse", nil); err == nil {
+		t.Error("UnpackEvent() expected error for a method name, got nil")
+	}
+	if _, err := contract.UnpackEvent("Fused", []byte{1, 2, 3}); err == nil {
+		t.Error("UnpackEvent() expected short data error, got nil")
+	}
+
+	duplicate := `[
+		{"type": "event", "name": "Fused", "inputs": []},
+		{"type": "event", "name": "Fused", "inputs": []}
+	]`
+	if _, err := ParseABI([]byte(duplicate)); err == nil {
+		t.Error("ParseABI() expected duplicate event error, got nil")
+	}
+}
+
+func TestAbi_FunctionLookupSkipsEvents(t *testing.T) {
+	a, err := FromJson(`[
+		{"type": "event", "name": "Fuse", "inputs": [{"name": "amount", "type": "uint256"}]},
+		{"type": "function", "name": "Fuse", "inputs": [{"name": "address", "type": "address"}]}
+	]`)
+	if err != nil {
+		t.Fatalf("FromJson() error = %v", err)
+	}
+	data, err := a.EncodeFunction("Fuse", []interface{}{types.PlasmaContract})
+	if err != nil {
+		t.Fatalf("EncodeFunction() error = %v", err)
+	}
+	decoded, err := a.DecodeFunction(data)
+	if err != nil {
+		t.Fatalf("DecodeFunction() error = %v", err)
+	}
+	if decoded[0].(types.Address) != types.PlasmaContract {
+		t.Errorf("decoded = %v, want %v", decoded[0], types.PlasmaContract)
+	}
+}
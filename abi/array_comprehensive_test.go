@@ -1,14 +1,24 @@
 package abi
 
 import (
+	"bytes"
+	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	zabi "github.com/zenon-network/go-zenon/vm/abi"
 )
 
 func TestTypeEnumStringValues(t *testing.T) {
 	if Function.String() != "function" {
 		t.Fatalf("Function.String() = %q", Function.String())
 	}
+	if Event.String() != "event" {
+		t.Fatalf("Event.String() = %q", Event.String())
+	}
 	if TypeEnum(99).String() != "unknown" {
 		t.Fatalf("unknown TypeEnum.String() = %q", TypeEnum(99).String())
 	}
@@ -112,3 +122,553 @@ func TestArrayTypedSliceAndErrorPaths(t *testing.T) {
 		t.Fatal("dynamic array accepted a negative length")
 	}
 }
+
+func TestDynamicArrayVariableLengthElements(t *testing.T) {
+	long := strings.Repeat("z", 40)
+	for _, test := range []struct {
+		typeName string
+		value    interface{}
+		want     []interface{}
+	}{
+		{
+			typeName: "string[]",
+			value:    []string{"a", "", long},
+			want:     []interface{}{"a", "", long},
+		},
+		{
+			typeName: "bytes[]",
+			value:    [][]byte{{0x01}, {}, []byte(long)},
+			want:     []interface{}{[]byte{0x01}, []byte{}, []byte(long)},
+		},
+	} {
+		t.Run(test.typeName, func(t *testing.T) {
+			array, err := GetType(test.typeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			encoded, err := array.Encode(test.value)
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+
+			// Offsets are relative to the start of the tuple (after the
+			// length word): 3 head words, then "a" (2 words), "" (1 word),
+			// and the 40-byte element (3 words).
+			for i, want := range []int64{0x60, 0xa0, 0xc0} {
+				offset, err := DecodeInt(encoded, Int32Size*(i+1))
+				if err != nil || offset.Int64() != want {
+					t.Errorf("offset[%d] = %v (%v), want %#x", i, offset, err, want)
+				}
+			}
+			if len(encoded) != Int32Size*(1+3+2+1+3) {
+				t.Errorf("len(encoded) = %d, want %d", len(encoded), Int32Size*10)
+			}
+
+			definition := zabi.JSONToABIContract(strings.NewReader(
+				`[{"type":"function","name":"f","inputs":[{"name":"a","type":"` + test.typeName + `"}]}]`))
+			packed, err := definition.PackMethod("f", test.value)
+			if err != nil {
+				t.Fatalf("go-zenon PackMethod: %v", err)
+			}
+			// Skip the selector and the argument's head offset
+			if reference := packed[4+Int32Size:]; !bytes.Equal(encoded, reference) {
+				t.Errorf("Encode() = %x, go-zenon = %x", encoded, reference)
+			}
+
+			decoded, err := array.Decode(encoded, 0)
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, test.want) {
+				t.Errorf("decoded = %#v, want %#v", decoded, test.want)
+			}
+		})
+	}
+}
+
+func TestDynamicDecodersRejectOutOfRangeSizeWords(t *testing.T) {
+	negative := EncodeInt(-32) // MSB set: negative if read as a signed int
+	huge, _ := EncodeUint(1 << 40)
+
+	for _, typeName := range []string{"bytes", "string", "uint256[]", "string[]", "bytes[2]"} {
+		abiType, err := GetType(typeName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, word := range map[string][]byte{"negative": negative, "huge": huge} {
+			// The word is the length for bytes/string/T[], or the first
+			// element offset for string[2]; pad so only the word is at fault
+			encoded := append(append([]byte{}, word...), make([]byte, 3*Int32Size)...)
+			if typeName == "string[]" {
+				encoded = append(EncodeInt(1), encoded...)
+			}
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Errorf("%s with %s size word panicked: %v", typeName, name, r)
+					}
+				}()
+				if _, err := abiType.Decode(encoded, 0); err == nil {
+					t.Errorf("%s accepted a %s size word", typeName, name)
+				}
+			}()
+		}
+	}
+
+	if _, err := DecodeInt(negative, -1); err == nil {
+		t.Error("DecodeInt accepted a negative offset")
+	}
+	if _, err := DecodeUint(negative, -1); err == nil {
+		t.Error("DecodeUint accepted a negative offset")
+	}
+
+	params := []Param{{Name: "data", Type: mustGetType("bytes")}}
+	if _, err := DecodeList(params, append(append([]byte{}, negative...), make([]byte, Int32Size)...)); err == nil {
+		t.Error("DecodeList accepted a negative parameter offset")
+	}
+}
+
+type addressPair struct {
+	From types.Address `abi:"from,address"`
+	To   types.Address `abi:"to,address"`
+	Note string
+}
+
+func TestDynamicArrayStructSliceRoundTrip(t *testing.T) {
+	first := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	second := types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")
+	pairs := []addressPair{
+		{From: first, To: second, Note: "not encoded"},
+		{From: second, To: first},
+	}
+	arrayType := mustGetType("address[][2]")
+
+	encoded, err := arrayType.Encode(pairs)
+	if err != nil {
+		t.Fatalf("Encode([]addressPair) error = %v", err)
+	}
+	manual, err := arrayType.Encode([]interface{}{
+		[]interface{}{first, second},
+		[]interface{}{second, first},
+	})
+	if err != nil {
+		t.Fatalf("Encode(nested lists) error = %v", err)
+	}
+	if !bytes.Equal(encoded, manual) {
+		t.Fatalf("struct encoding = %x, want %x", encoded, manual)
+	}
+	pointers, err := arrayType.Encode([]*addressPair{&pairs[0], &pairs[1]})
+	if err != nil || !bytes.Equal(pointers, manual) {
+		t.Fatalf("Encode([]*addressPair) = %x, %v; want %x", pointers, err, manual)
+	}
+
+	var decoded struct {
+		Pairs []addressPair `abi:"pairs,address[][2]"`
+	}
+	data := append(EncodeInt(Int32Size), encoded...)
+	if err := DecodeResponse(data, &decoded); err != nil {
+		t.Fatalf("DecodeResponse() error = %v", err)
+	}
+	pairs[0].Note = ""
+	if !reflect.DeepEqual(decoded.Pairs, pairs) {
+		t.Fatalf("decoded pairs = %+v, want %+v", decoded.Pairs, pairs)
+	}
+}
+
+func TestDynamicArrayStructSliceErrors(t *testing.T) {
+	arrayType := mustGetType("address[][2]")
+	if _, err := arrayType.Encode([]struct{ A types.Address }{{}}); err == nil {
+		t.Error("Encode() of untagged structs should fail")
+	}
+	if _, err := arrayType.Encode([]*addressPair{nil}); err == nil {
+		t.Error("Encode() of a nil struct pointer should fail")
+	}
+	if _, err := mustGetType("address[][3]").Encode([]addressPair{{}}); err == nil {
+		t.Error("Encode() should fail when the element size does not match the tagged fields")
+	}
+}
+
+// TestNestedStaticArrayRoundTrip covers multidimensional static arrays. The
+// first bracket is the outer dimension, so "uint256[2][3]" is two elements of
+// uint256[3]. Nested static arrays are laid out flat, element after element.
+// go-zenon's ABI parser rejects multidimensional types, so the expected words
+// are spelled out rather than packed by it.
+func TestNestedStaticArrayRoundTrip(t *testing.T) {
+	words := func(values ...int64) []interface{} {
+		out := make([]interface{}, len(values))
+		for i, v := range values {
+			out[i] = big.NewInt(v)
+		}
+		return out
+	}
+
+	for _, test := range []struct {
+		typeName  string
+		fixedSize int
+		value     []interface{}
+		want      []int64
+	}{
+		{
+			typeName:  "uint256[2][3]",
+			fixedSize: 6 * Int32Size,
+			value:     []interface{}{words(1, 2, 3), words(4, 5, 6)},
+			want:      []int64{1, 2, 3, 4, 5, 6},
+		},
+		{
+			typeName:  "bool[2][2]",
+			fixedSize: 4 * Int32Size,
+			value:     []interface{}{[]interface{}{true, false}, []interface{}{false, true}},
+			want:      []int64{1, 0, 0, 1},
+		},
+	} {
+		t.Run(test.typeName, func(t *testing.T) {
+			array, err := GetType(test.typeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if array.IsDynamicType() {
+				t.Error("nested static array reported as dynamic")
+			}
+			if got := array.GetFixedSize(); got != test.fixedSize {
+				t.Errorf("GetFixedSize() = %d, want %d", got, test.fixedSize)
+			}
+
+			encoded, err := array.Encode(test.value)
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			if len(encoded) != test.fixedSize {
+				t.Errorf("len(encoded) = %d, want %d", len(encoded), test.fixedSize)
+			}
+
+			var want []byte
+			for _, w := range test.want {
+				want = append(want, big.NewInt(w).FillBytes(make([]byte, Int32Size))...)
+			}
+			if !bytes.Equal(encoded, want) {
+				t.Errorf("Encode() = %x, want %x", encoded, want)
+			}
+
+			decoded, err := array.Decode(encoded, 0)
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if !reflect.DeepEqual(decoded, test.value) {
+				t.Errorf("decoded = %v, want %v", decoded, test.value)
+			}
+		})
+	}
+}
+
+// TestBoolArraysInArgumentList is a regression test for bool arrays next to
+// other arguments. Bool is a full 32-byte word, so bool[2][2] must take four
+// head words and the dynamic arguments' offsets must account for all of them.
+func TestBoolArraysInArgumentList(t *testing.T) {
+	params := make([]Param, 0, 4)
+	for _, p := range []struct{ name, typeName string }{
+		{"flags", "bool[]"},
+		{"grid", "bool[2][2]"},
+		{"pairs", "bool[][2]"},
+		{"tail", "uint256"},
+	} {
+		param, err := NewParam(p.name, p.typeName)
+		if err != nil {
+			t.Fatalf("NewParam(%s): %v", p.typeName, err)
+		}
+		params = append(params, *param)
+	}
+	entry := NewEntry("f", params, Function)
+
+	flags := []interface{}{true, false, true}
+	grid := []interface{}{[]interface{}{true, false}, []interface{}{false, true}}
+	pairs := []interface{}{[]interface{}{true, true}, []interface{}{false, true}, []interface{}{true, false}}
+	tail := big.NewInt(7)
+
+	encoded, err := entry.EncodeArguments([]interface{}{flags, grid, pairs, tail})
+	if err != nil {
+		t.Fatalf("EncodeArguments: %v", err)
+	}
+
+	// Head: flags offset, four grid words, pairs offset, tail
+	if offset, _ := DecodeInt(encoded, 0); offset.Int64() != 7*Int32Size {
+		t.Errorf("flags offset = %v, want %d", offset, 7*Int32Size)
+	}
+	if offset, _ := DecodeInt(encoded, 5*Int32Size); offset.Int64() != 11*Int32Size {
+		t.Errorf("pairs offset = %v, want %d", offset, 11*Int32Size)
+	}
+
+	// go-zenon's ABI parser rejects multidimensional types, so the full
+	// encoding is spelled out word by word.
+	var want []byte
+	for _, w := range []int64{
+		7 * Int32Size, 1, 0, 0, 1, 11 * Int32Size, 7, // head
+		3, 1, 0, 1, // flags
+		3, 1, 1, 0, 1, 1, 0, // pairs
+	} {
+		want = append(want, big.NewInt(w).FillBytes(make([]byte, Int32Size))...)
+	}
+	if !bytes.Equal(encoded, want) {
+		t.Errorf("EncodeArguments() = %x, want %x", encoded, want)
+	}
+
+	decoded, err := DecodeList(params, encoded)
+	if err != nil {
+		t.Fatalf("DecodeList: %v", err)
+	}
+	wantDecoded := []interface{}{flags, grid, pairs, tail}
+	if !reflect.DeepEqual(decoded, wantDecoded) {
+		t.Errorf("decoded = %v, want %v", decoded, wantDecoded)
+	}
+}
+
+// TestPaddedFixedTypeArrays checks arrays of the left-padded custom types.
+// tokenStandard (10 bytes after 22 zero bytes) and address (20 bytes after 12)
+// each take one full word, so neighbouring elements and arguments must not
+// overlap their padding.
+func TestPaddedFixedTypeArrays(t *testing.T) {
+	allOnes := types.ZenonTokenStandard{}
+	for i := range allOnes {
+		allOnes[i] = 0xff
+	}
+	tokens := []interface{}{types.ZnnTokenStandard, allOnes, types.QsrTokenStandard}
+	user := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	pair := []interface{}{types.PillarContract, user}
+	members := []interface{}{user, types.TokenContract, types.PlasmaContract}
+	tail := big.NewInt(255)
+
+	params := make([]Param, 0, 4)
+	for _, p := range []struct{ name, typeName string }{
+		{"tokens", "tokenStandard[]"},
+		{"pair", "address[2]"},
+		{"members", "address[]"},
+		{"tail", "uint8"},
+	} {
+		param, err := NewParam(p.name, p.typeName)
+		if err != nil {
+			t.Fatalf("NewParam(%s): %v", p.typeName, err)
+		}
+		params = append(params, *param)
+	}
+	entry := NewEntry("f", params, Function)
+
+	encoded, err := entry.EncodeArguments([]interface{}{tokens, pair, members, tail})
+	if err != nil {
+		t.Fatalf("EncodeArguments: %v", err)
+	}
+
+	// Head: tokens offset, two pair words, members offset, tail. The tokens
+	// array follows at word 5: its length, then one padded word per ZTS.
+	if offset, _ := DecodeInt(encoded, 0); offset.Int64() != 5*Int32Size {
+		t.Errorf("tokens offset = %v, want %d", offset, 5*Int32Size)
+	}
+	if offset, _ := DecodeInt(encoded, 3*Int32Size); offset.Int64() != 9*Int32Size {
+		t.Errorf("members offset = %v, want %d", offset, 9*Int32Size)
+	}
+	for i := 0; i < len(tokens); i++ {
+		word := encoded[(6+i)*Int32Size : (7+i)*Int32Size]
+		if !bytes.Equal(word[:22], make([]byte, 22)) {
+			t.Errorf("tokens[%d] padding = %x, want zeros", i, word[:22])
+		}
+	}
+
+	definition := zabi.JSONToABIContract(strings.NewReader(`[{"type":"function","name":"f","inputs":[` +
+		`{"name":"tokens","type":"tokenStandard[]"},{"name":"pair","type":"address[2]"},` +
+		`{"name":"members","type":"address[]"},{"name":"tail","type":"uint8"}]}]`))
+	packed, err := definition.PackMethod("f",
+		[]types.ZenonTokenStandard{types.ZnnTokenStandard, allOnes, types.QsrTokenStandard},
+		[2]types.Address{types.PillarContract, user},
+		[]types.Address{user, types.TokenContract, types.PlasmaContract},
+		uint8(255),
+	)
+	if err != nil {
+		t.Fatalf("go-zenon PackMethod: %v", err)
+	}
+	if !bytes.Equal(encoded, packed[4:]) {
+		t.Errorf("EncodeArguments() = %x, go-zenon = %x", encoded, packed[4:])
+	}
+
+	decoded, err := DecodeList(params, encoded)
+	if err != nil {
+		t.Fatalf("DecodeList: %v", err)
+	}
+	want := []interface{}{tokens, pair, members, tail}
+	if !reflect.DeepEqual(decoded, want) {
+		t.Errorf("decoded = %v, want %v", decoded, want)
+	}
+}
+
+// TestFunctionSelectorArrays checks that 24-byte function selectors keep their
+// own word in static and dynamic arrays, zero-padded on the right.
+func TestFunctionSelectorArrays(t *testing.T) {
+	selector := func(fill byte) []byte {
+		return bytes.Repeat([]byte{fill}, 24)
+	}
+
+	params := make([]Param, 0, 3)
+	for _, p := range []struct{ name, typeName string }{
+		{"callbacks", "function[]"},
+		{"pair", "function[2]"},
+		{"tail", "uint8"},
+	} {
+		param, err := NewParam(p.name, p.typeName)
+		if err != nil {
+			t.Fatalf("NewParam(%s): %v", p.typeName, err)
+		}
+		params = append(params, *param)
+	}
+	if _, ok := params[0].Type.(*DynamicArrayType).GetElementType().(*FunctionType); !ok {
+		t.Fatalf("function[] element type = %T, want *FunctionType", params[0].Type.(*DynamicArrayType).GetElementType())
+	}
+	entry := NewEntry("f", params, Function)
+
+	callbacks := []interface{}{selector(0xa1), selector(0xa2), selector(0xa3)}
+	pair := []interface{}{selector(0xb1), selector(0xb2)}
+	tail := big.NewInt(9)
+
+	encoded, err := entry.EncodeArguments([]interface{}{callbacks, pair, tail})
+	if err != nil {
+		t.Fatalf("EncodeArguments: %v", err)
+	}
+
+	// Head: callbacks offset, two pair words, tail; then length and 3 words
+	if len(encoded) != 8*Int32Size {
+		t.Fatalf("encoded length = %d, want %d", len(encoded), 8*Int32Size)
+	}
+	if offset, _ := DecodeInt(encoded, 0); offset.Int64() != 4*Int32Size {
+		t.Errorf("callbacks offset = %v, want %d", offset, 4*Int32Size)
+	}
+	if length, _ := DecodeInt(encoded, 4*Int32Size); length.Int64() != 3 {
+		t.Errorf("callbacks length = %v, want 3", length)
+	}
+	padding := make([]byte, Int32Size-24)
+	for i, word := range []struct {
+		index int
+		fill  byte
+	}{{1, 0xb1}, {2, 0xb2}, {5, 0xa1}, {6, 0xa2}, {7, 0xa3}} {
+		start := word.index * Int32Size
+		if !bytes.Equal(encoded[start:start+24], selector(word.fill)) || !bytes.Equal(encoded[start+24:start+Int32Size], padding) {
+			t.Errorf("selector %d word = %x, want %x followed by zero padding", i, encoded[start:start+Int32Size], selector(word.fill))
+		}
+	}
+
+	decoded, err := DecodeList(params, encoded)
+	if err != nil {
+		t.Fatalf("DecodeList: %v", err)
+	}
+	want := []interface{}{callbacks, pair, tail}
+	if !reflect.DeepEqual(decoded, want) {
+		t.Errorf("decoded = %x, want %x", decoded, want)
+	}
+
+	// Padding is part of the encoding: a dirty word must not decode.
+	encoded[6*Int32Size+Int32Size-1] = 1
+	if _, err := DecodeList(params, encoded); err == nil {
+		t.Error("DecodeList() with non-zero selector padding error = nil, want error")
+	}
+}
+
+// TestSignedIntArrayRoundTrip checks arrays of negative and mixed-sign
+// integers. Each element is sign-extended to a full two's-complement word, so a
+// negative int8 is 31 0xff bytes and its low byte, and the length word and
+// offsets around it stay unsigned.
+func TestSignedIntArrayRoundTrip(t *testing.T) {
+	// go-zenon packs *big.Int values in place, so the reference values are
+	// copies of the ones this SDK encodes.
+	minInt256 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	maxInt256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+
+	for _, test := range []struct {
+		typeName  string
+		value     []interface{}
+		reference interface{}
+		// head is the number of leading words before the elements: the
+		// argument offset and length word for dynamic arrays.
+		head int
+	}{
+		{
+			typeName:  "int256[]",
+			value:     []interface{}{big.NewInt(-1), big.NewInt(-12345), minInt256},
+			reference: []*big.Int{big.NewInt(-1), big.NewInt(-12345), new(big.Int).Set(minInt256)},
+			head:      2,
+		},
+		{
+			typeName:  "int256[]",
+			value:     []interface{}{big.NewInt(-7), big.NewInt(0), maxInt256, big.NewInt(42), minInt256},
+			reference: []*big.Int{big.NewInt(-7), big.NewInt(0), new(big.Int).Set(maxInt256), big.NewInt(42), new(big.Int).Set(minInt256)},
+			head:      2,
+		},
+		{
+			typeName:  "int8[3]",
+			value:     []interface{}{big.NewInt(-128), big.NewInt(-1), big.NewInt(-100)},
+			reference: [3]int8{-128, -1, -100},
+		},
+		{
+			typeName:  "int8[3]",
+			value:     []interface{}{big.NewInt(-128), big.NewInt(0), big.NewInt(127)},
+			reference: [3]int8{-128, 0, 127},
+		},
+	} {
+		t.Run(fmt.Sprintf("%s%v", test.typeName, test.value), func(t *testing.T) {
+			param, err := NewParam("a", test.typeName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			params := []Param{*param}
+			encoded, err := NewEntry("f", params, Function).EncodeArguments([]interface{}{test.value})
+			if err != nil {
+				t.Fatalf("EncodeArguments: %v", err)
+			}
+
+			definition := zabi.JSONToABIContract(strings.NewReader(
+				`[{"type":"function","name":"f","inputs":[{"name":"a","type":"` + test.typeName + `"}]}]`))
+			packed, err := definition.PackMethod("f", test.reference)
+			if err != nil {
+				t.Fatalf("go-zenon PackMethod: %v", err)
+			}
+			if !bytes.Equal(encoded, packed[4:]) {
+				t.Errorf("EncodeArguments() = %x, go-zenon = %x", encoded, packed[4:])
+			}
+
+			for i, element := range test.value {
+				word := encoded[(test.head+i)*Int32Size : (test.head+i+1)*Int32Size]
+				if negative := word[0]&0x80 != 0; negative != (element.(*big.Int).Sign() < 0) {
+					t.Errorf("element %d word %x has the wrong sign bit", i, word)
+				}
+			}
+
+			decoded, err := DecodeList(params, encoded)
+			if err != nil {
+				t.Fatalf("DecodeList: %v", err)
+			}
+			elements, ok := decoded[0].([]interface{})
+			if !ok || len(elements) != len(test.value) {
+				t.Fatalf("decoded = %#v, want %d elements", decoded[0], len(test.value))
+			}
+			for i, element := range elements {
+				if got, ok := element.(*big.Int); !ok || got.Cmp(test.value[i].(*big.Int)) != 0 {
+					t.Errorf("decoded element %d = %v, want %v", i, element, test.value[i])
+				}
+			}
+		})
+	}
+
+	// A word that is not sign-extended, such as 0x80 for int8, is 128 rather
+	// than -128 and must be rejected instead of wrapping.
+	array, err := GetType("int8[3]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := array.Encode([]interface{}{big.NewInt(-128), big.NewInt(1), big.NewInt(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < Int32Size-1; i++ {
+		encoded[i] = 0
+	}
+	if _, err := array.Decode(encoded, 0); err == nil {
+		t.Error("Decode() accepted an int8 element that is not sign-extended")
+	}
+	if _, err := array.Encode([]interface{}{big.NewInt(-129), big.NewInt(0), big.NewInt(0)}); err == nil {
+		t.Error("Encode() accepted an int8 element below -128")
+	}
+}
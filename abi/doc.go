@@ -33,11 +33,11 @@
 //
 // Decode contract response data:
 //
-//	// Define expected return types
+//	// Tag each field with its ABI name and type, in encoding order
 //	var result struct {
-//	    Name   string
-//	    Owner  types.Address
-//	    Amount *big.Int
+//	    Name   string        `abi:"name,string"`
+//	    Owner  types.Address `abi:"owner,address"`
+//	    Amount *big.Int      `abi:"amount,uint256"`
 //	}
 //
 //	// Decode response
@@ -46,6 +46,14 @@
 //	    log.Fatal(err)
 //	}
 //
+// # Event Decoding
+//
+// ParseABI also loads "event" entries. UnpackEvent decodes an event's
+// non-indexed fields into a map keyed by field name:
+//
+//	contract, _ := abi.ParseABI(definition)
+//	fields, err := contract.UnpackEvent("Fused", data)
+//
 // # Common Data Types
 //
 // The ABI package handles encoding/decoding of:
@@ -0,0 +1,224 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// =============================================================================
+// Struct Decoding - Contract Responses Into Tagged Go Structs
+// =============================================================================
+
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// DecodeResponse decodes an ABI-encoded response into the struct pointed to by
+// out.
+//
+// Each field to decode carries an `abi:"name,type"` tag, where type is any
+// name accepted by GetType. Tagged fields are decoded in declaration order;
+// untagged fields and fields tagged "-" are left unchanged. Decoded values are
+// converted to the field's type where this is lossless:
+//   - integers (*big.Int) into *big.Int, big.Int, or any Go integer kind that
+//     can hold the value
+//   - fixed bytes ([]byte) into []byte or a byte array of the same length
+//   - arrays into slices, or Go arrays of the same length, of a convertible
+//     element type, and array elements into structs whose abi-tagged fields
+//     match them in order
+//   - every other value into a field of its own type (string, bool,
+//     types.Address, types.Hash, types.ZenonTokenStandard)
+//
+// Parameters:
+//   - data: Encoded values without a method selector
+//   - out: Pointer to a struct with abi-tagged fields
+//
+// Returns an error if out is not a pointer to a struct, a tag is malformed or
+// names an unknown type, the data does not decode, or a decoded value cannot
+// be stored in its field.
+//
+// Example:
+//
+//	var pillar struct {
+//	    Name   string        `abi:"name,string"`
+//	    Owner  types.Address `abi:"owner,address"`
+//	    Weight *big.Int      `abi:"weight,uint256"`
+//	}
+//	if err := abi.DecodeResponse(data, &pillar); err != nil {
+//	    return err
+//	}
+func DecodeResponse(data []byte, out interface{}) error {
+	target := reflect.ValueOf(out)
+	if target.Kind() != reflect.Pointer || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("DecodeResponse requires a non-nil pointer to a struct, got %T", out)
+	}
+	target = target.Elem()
+
+	fieldIndexes, params, err := responseParams(target.Type())
+	if err != nil {
+		return err
+	}
+	values, err := DecodeList(params, data)
+	if err != nil {
+		return err
+	}
+	for i, value := range values {
+		field := target.Type().Field(fieldIndexes[i])
+		if err := assignDecoded(target.Field(fieldIndexes[i]), value); err != nil {
+			return fmt.Errorf("field %s (%s %s): %w", field.Name, params[i].Name, params[i].Type.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// responseParams reads the abi tags of structType and returns the indexes of
+// the tagged fields together with their parameters, in declaration order.
+func responseParams(structType reflect.Type) ([]int, []Param, error) {
+	var fieldIndexes []int
+	var params []Param
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag, ok := field.Tag.Lookup("abi")
+		if !ok || tag == "-" {
+			continue
+		}
+		if !field.IsExported() {
+			return nil, nil, fmt.Errorf("field %s has an abi tag but is not exported", field.Name)
+		}
+		name, typeName, found := strings.Cut(tag, ",")
+		if !found || strings.TrimSpace(typeName) == "" {
+			return nil, nil, fmt.Errorf("field %s: abi tag %q must be \"name,type\"", field.Name, tag)
+		}
+		param, err := NewParam(strings.TrimSpace(name), strings.TrimSpace(typeName))
+		if err != nil {
+			return nil, nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		fieldIndexes = append(fieldIndexes, i)
+		params = append(params, *param)
+	}
+	if len(params) == 0 {
+		return nil, nil, fmt.Errorf("struct %s has no abi-tagged fields", structType)
+	}
+	return fieldIndexes, params, nil
+}
+
+// assignDecoded stores a value produced by an AbiType's Decode into dst,
+// converting it to dst's type where this is lossless.
+func assignDecoded(dst reflect.Value, value interface{}) error {
+	src := reflect.ValueOf(value)
+	if src.IsValid() && src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+
+	switch v := value.(type) {
+	case *big.Int:
+		return assignBigInt(dst, v)
+	case []byte:
+		if dst.Kind() == reflect.Array && dst.Type().Elem().Kind() == reflect.Uint8 {
+			if dst.Len() != len(v) {
+				return fmt.Errorf("cannot store %d bytes in %s", len(v), dst.Type())
+			}
+			reflect.Copy(dst, src)
+			return nil
+		}
+	case []interface{}:
+		switch dst.Kind() {
+		case reflect.Slice:
+			elements := reflect.MakeSlice(dst.Type(), len(v), len(v))
+			for i, element := range v {
+				if err := assignDecoded(elements.Index(i), element); err != nil {
+					return fmt.Errorf("element %d: %w", i, err)
+				}
+			}
+			dst.Set(elements)
+			return nil
+		case reflect.Array:
+			if dst.Len() != len(v) {
+				return fmt.Errorf("cannot store %d elements in %s", len(v), dst.Type())
+			}
+			for i, element := range v {
+				if err := assignDecoded(dst.Index(i), element); err != nil {
+					return fmt.Errorf("element %d: %w", i, err)
+				}
+			}
+			return nil
+		case reflect.Struct:
+			fieldIndexes, _, err := responseParams(dst.Type())
+			if err != nil {
+				return err
+			}
+			if len(fieldIndexes) != len(v) {
+				return fmt.Errorf("cannot store %d elements in %s with %d abi-tagged fields", len(v), dst.Type(), len(fieldIndexes))
+			}
+			for i, element := range v {
+				if err := assignDecoded(dst.Field(fieldIndexes[i]), element); err != nil {
+					return fmt.Errorf("field %s: %w", dst.Type().Field(fieldIndexes[i]).Name, err)
+				}
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot store %T in %s", value, dst.Type())
+}
+
+// =============================================================================
+// Struct Encoding - Tagged Go Structs Into Array Elements
+// =============================================================================
+
+// structValues returns the abi-tagged fields of a struct, or of the struct a
+// non-nil pointer points to, in declaration order. It is the encoding
+// counterpart of DecodeResponse and uses the same tags.
+func structValues(v reflect.Value) ([]interface{}, error) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, fmt.Errorf("nil %s", v.Type())
+		}
+		v = v.Elem()
+	}
+	fieldIndexes, _, err := responseParams(v.Type())
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(fieldIndexes))
+	for i, index := range fieldIndexes {
+		values[i] = v.Field(index).Interface()
+	}
+	return values, nil
+}
+
+// isStructSlice reports whether t is a slice of structs or of struct
+// pointers.
+func isStructSlice(t reflect.Type) bool {
+	if t.Kind() != reflect.Slice {
+		return false
+	}
+	elem := t.Elem()
+	if elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	return elem.Kind() == reflect.Struct
+}
+
+// assignBigInt stores v into a big.Int or Go integer destination, rejecting
+// values that do not fit.
+func assignBigInt(dst reflect.Value, v *big.Int) error {
+	switch {
+	case dst.Type() == bigIntType:
+		dst.Addr().Interface().(*big.Int).Set(v)
+		return nil
+	case dst.CanInt():
+		if !v.IsInt64() || dst.OverflowInt(v.Int64()) {
+			return fmt.Errorf("value %s overflows %s", v, dst.Type())
+		}
+		dst.SetInt(v.Int64())
+		return nil
+	case dst.CanUint():
+		if v.Sign() < 0 || !v.IsUint64() || dst.OverflowUint(v.Uint64()) {
+			return fmt.Errorf("value %s overflows %s", v, dst.Type())
+		}
+		dst.SetUint(v.Uint64())
+		return nil
+	}
+	return fmt.Errorf("cannot store integer in %s", dst.Type())
+}
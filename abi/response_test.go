@@ -0,0 +1,129 @@
+package abi
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// encodeResponse ABI-encodes values for the given "name,type" parameters.
+func encodeResponse(t *testing.T, specs []string, values ...interface{}) []byte {
+	t.Helper()
+	params := make([]Param, len(specs))
+	for i, spec := range specs {
+		name, typeName, _ := strings.Cut(spec, ",")
+		param, err := NewParam(name, typeName)
+		if err != nil {
+			t.Fatalf("NewParam(%q) error = %v", spec, err)
+		}
+		params[i] = *param
+	}
+	data, err := NewEntry("response", params, Function).EncodeArguments(values)
+	if err != nil {
+		t.Fatalf("EncodeArguments() error = %v", err)
+	}
+	return data
+}
+
+func TestDecodeResponse_PillarStruct(t *testing.T) {
+	owner := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	weight, _ := new(big.Int).SetString("1500000000000000", 10)
+	data := encodeResponse(t, []string{"name,string", "owner,address", "weight,uint256"}, "Pillar1", owner, weight)
+
+	var pillar struct {
+		Name   string        `abi:"name,string"`
+		Note   string        // untagged fields are left alone
+		Owner  types.Address `abi:"owner,address"`
+		Weight *big.Int      `abi:"weight,uint256"`
+	}
+	pillar.Note = "kept"
+	if err := DecodeResponse(data, &pillar); err != nil {
+		t.Fatalf("DecodeResponse() error = %v", err)
+	}
+	if pillar.Name != "Pillar1" || pillar.Owner != owner || pillar.Weight.Cmp(weight) != 0 || pillar.Note != "kept" {
+		t.Errorf("DecodeResponse() = %+v", pillar)
+	}
+}
+
+func TestDecodeResponse_Conversions(t *testing.T) {
+	addresses := []interface{}{types.PlasmaContract, types.PillarContract}
+	data := encodeResponse(t,
+		[]string{"height,uint64", "delta,int32", "amount,uint256", "tag,bytes4", "members,address[]", "pair,uint8[2]"},
+		big.NewInt(42), big.NewInt(-7), big.NewInt(1000), []byte{1, 2, 3, 4}, addresses, []interface{}{big.NewInt(1), big.NewInt(2)},
+	)
+
+	var got struct {
+		Height  uint64          `abi:"height,uint64"`
+		Delta   int             `abi:"delta,int32"`
+		Amount  big.Int         `abi:"amount,uint256"`
+		Tag     [4]byte         `abi:"tag,bytes4"`
+		Members []types.Address `abi:"members,address[]"`
+		Pair    [2]uint8        `abi:"pair,uint8[2]"`
+	}
+	if err := DecodeResponse(data, &got); err != nil {
+		t.Fatalf("DecodeResponse() error = %v", err)
+	}
+	if got.Height != 42 || got.Delta != -7 || got.Amount.Int64() != 1000 || got.Tag != [4]byte{1, 2, 3, 4} || got.Pair != [2]uint8{1, 2} {
+		t.Errorf("DecodeResponse() = %+v", got)
+	}
+	if len(got.Members) != 2 || got.Members[0] != types.PlasmaContract || got.Members[1] != types.PillarContract {
+		t.Errorf("Members = %v", got.Members)
+	}
+}
+
+func TestDecodeResponse_Errors(t *testing.T) {
+	data := encodeResponse(t, []string{"owner,address", "weight,uint256"}, types.PlasmaContract, big.NewInt(300))
+
+	var mismatch struct {
+		Owner  string   `abi:"owner,address"`
+		Weight *big.Int `abi:"weight,uint256"`
+	}
+	var overflow struct {
+		Owner  types.Address `abi:"owner,address"`
+		Weight uint8         `abi:"weight,uint256"`
+	}
+	var badTag struct {
+		Owner types.Address `abi:"owner"`
+	}
+	var unknownType struct {
+		Owner types.Address `abi:"owner,addr"`
+	}
+	var untagged struct {
+		Owner types.Address
+	}
+	var plain types.Address
+
+	tests := []struct {
+		name string
+		out  interface{}
+		want string
+	}{
+		{"type mismatch", &mismatch, "field Owner"},
+		{"overflow", &overflow, "overflows uint8"},
+		{"tag without type", &badTag, "must be \"name,type\""},
+		{"unknown type", &unknownType, "field Owner"},
+		{"no tagged fields", &untagged, "no abi-tagged fields"},
+		{"not a pointer", mismatch, "pointer to a struct"},
+		{"pointer to non-struct", &plain, "pointer to a struct"},
+		{"nil", nil, "pointer to a struct"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DecodeResponse(data, tt.out)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("DecodeResponse() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+
+	var short struct {
+		Owner types.Address `abi:"owner,address"`
+		Extra *big.Int      `abi:"extra,uint256"`
+		More  *big.Int      `abi:"more,uint256"`
+	}
+	if err := DecodeResponse(data, &short); err == nil {
+		t.Error("DecodeResponse() with truncated data should fail")
+	}
+}
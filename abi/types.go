@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/zenon-network/go-zenon/common/types"
 )
@@ -62,18 +65,20 @@ type NumericType struct {
 }
 
 // EncodeInternal converts various value types to big.Int
+//
+// Strings are parsed as hexadecimal only when they carry an explicit "0x" or
+// "0X" prefix; every other string is parsed as base-10, so values such as
+// "12e3" or "ff" are rejected rather than silently read as hex.
 func (nt *NumericType) EncodeInternal(value interface{}) (*big.Int, error) {
 	switch v := value.(type) {
 	case string:
 		s := strings.ToLower(strings.TrimSpace(v))
 		radix := 10
 
-		// Handle hex strings
+		// Only an explicit prefix selects hex
 		if strings.HasPrefix(s, "0x") {
 			s = s[2:]
 			radix = 16
-		} else if strings.ContainsAny(s, "abcdef") {
-			radix = 16
 		}
 
 		bigInt := new(big.Int)
@@ -190,7 +195,7 @@ func EncodeIntBig(bigInt *big.Int) []byte {
 
 // DecodeInt decodes a signed integer from encoded bytes at offset
 func DecodeInt(encoded []byte, offset int) (*big.Int, error) {
-	if len(encoded) < offset+Int32Size {
+	if offset < 0 || len(encoded) < offset+Int32Size {
 		return nil, fmt.Errorf("insufficient bytes for decoding int")
 	}
 
@@ -379,7 +384,7 @@ func EncodeUintBig(bigInt *big.Int) ([]byte, error) {
 
 // DecodeUint decodes an unsigned integer from encoded bytes at offset
 func DecodeUint(encoded []byte, offset int) (*big.Int, error) {
-	if len(encoded) < offset+Int32Size {
+	if offset < 0 || len(encoded) < offset+Int32Size {
 		return nil, fmt.Errorf("insufficient bytes for decoding uint")
 	}
 
@@ -387,6 +392,38 @@ func DecodeUint(encoded []byte, offset int) (*big.Int, error) {
 	return decodeBigInt(bytes), nil
 }
 
+// safeLen converts a length or offset read from untrusted data to an int.
+// Such values are unsigned, and none can exceed max, typically the number of
+// input bytes (or elements) left after the word, so larger values (including
+// ones that would read as negative if signed, or that do not fit in an int)
+// are rejected before they can drive an allocation or slice.
+func safeLen(b *big.Int, max int) (int, error) {
+	if b == nil {
+		return 0, fmt.Errorf("missing value")
+	}
+	if max < 0 {
+		max = 0
+	}
+	if b.Sign() < 0 || !b.IsInt64() || b.Int64() > int64(max) {
+		return 0, fmt.Errorf("%s exceeds the limit of %d", b, max)
+	}
+	return int(b.Int64()), nil
+}
+
+// decodeSize decodes a length or offset word at offset and checks it with
+// safeLen against max.
+func decodeSize(encoded []byte, offset int, what string, max int) (int, error) {
+	value, err := DecodeUint(encoded, offset)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode %s: %w", what, err)
+	}
+	size, err := safeLen(value, max)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", what, err)
+	}
+	return size, nil
+}
+
 // bigIntToBytes converts a big.Int to a fixed-size byte array (unsigned)
 func bigIntToBytes(b *big.Int, numBytes int) []byte {
 	// Create byte array filled with zeros
@@ -748,14 +785,31 @@ func (tst *TokenStandardType) Encode(value interface{}) ([]byte, error) {
 	return result, nil
 }
 
-// Decode decodes a token standard value from encoded bytes at offset
+// Decode decodes a token standard value from encoded bytes at offset.
+//
+// Two encodings are accepted, chosen by the number of bytes available at
+// offset:
+//   - 32 or more: the standard ABI word, 22 zero bytes followed by the 10-byte
+//     ZTS. Only the first word is read.
+//   - exactly 10: a bare ZTS, as found at the end of some older embedded
+//     contract responses that omit the left padding.
+//
+// Any other length is an error.
 func (tst *TokenStandardType) Decode(encoded []byte, offset int) (interface{}, error) {
-	if len(encoded) < offset+Int32Size {
+	if offset < 0 || offset > len(encoded) {
 		return nil, fmt.Errorf("insufficient bytes for decoding token standard")
 	}
 
-	// ZTS bytes are at offset+22 (skip 22 padding bytes) and are 10 bytes long
-	ztsBytes := encoded[offset+22 : offset+Int32Size]
+	var ztsBytes []byte
+	switch available := len(encoded) - offset; {
+	case available >= Int32Size:
+		// ZTS bytes are at offset+22 (skip 22 padding bytes) and are 10 bytes long
+		ztsBytes = encoded[offset+Int32Size-types.ZenonTokenStandardSize : offset+Int32Size]
+	case available == types.ZenonTokenStandardSize:
+		ztsBytes = encoded[offset:]
+	default:
+		return nil, fmt.Errorf("insufficient bytes for decoding token standard")
+	}
 
 	zts, err := types.BytesToZTS(ztsBytes)
 	if err != nil {
@@ -840,14 +894,9 @@ func (bt *BytesType) Decode(encoded []byte, offset int) (interface{}, error) {
 	}
 
 	// Decode length from first 32 bytes
-	lengthBig, err := DecodeInt(encoded, offset)
+	length, err := decodeSize(encoded, offset, "bytes length", len(encoded)-offset-Int32Size)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode bytes length: %w", err)
-	}
-
-	length := int(lengthBig.Int64())
-	if length < 0 {
-		return nil, fmt.Errorf("invalid bytes length: %d", length)
+		return nil, err
 	}
 
 	if length == 0 {
@@ -932,12 +981,128 @@ func (st *StringType) Decode(encoded []byte, offset int) (interface{}, error) {
 // GetType - Type Factory Function
 // =============================================================================
 
-// GetType creates an ABI type from a type name string
+// maxCachedTypes bounds typeCache. Valid type names are unlimited
+// ("uint8[1]", "uint8[2]", ...), so once the cache is full an arbitrary entry
+// is evicted for each new type.
+const maxCachedTypes = 1024
+
+// typeCache holds parsed ABI types keyed by their canonical name, so "uint[]",
+// "uint256[]" and "uint256 [ ]" share one entry. ABI types are never mutated
+// after construction, so a single instance can be shared safely between
+// goroutines and callers.
+var (
+	typeCacheMu sync.Mutex
+	typeCache   = make(map[string]AbiType)
+)
+
+// GetType returns the ABI type for a type name string.
+//
+// Parsed types are cached by canonical name, so repeated calls such as
+// GetType("uint256[]") and GetType("uint[]") return the same shared instance
+// instead of re-parsing the name and its element types. Returned types are
+// immutable and safe for concurrent use. Invalid names are not cached and
+// return an error on every call.
+//
+// Parameters:
+//   - typeName: ABI type name, such as "uint256", "address" or "hash[]".
+//     Whitespace at either end and around brackets is ignored, so names read
+//     from hand-written ABI JSON such as "uint256 " or "hash [ ]" work
+//
+// Returns the parsed type, or an error quoting typeName when it is not a
+// supported type or contains whitespace inside a name (such as "uint 256").
+//
+// Example:
+//
+//	arrayType, err := GetType("uint256[]")
+//	encoded, err := arrayType.Encode([]interface{}{big.NewInt(1), big.NewInt(2)})
 func GetType(typeName string) (AbiType, error) {
-	if strings.Contains(typeName, "[") {
-		return getArrayType(typeName)
+	normalized, err := normalizeTypeName(typeName)
+	if err != nil {
+		return nil, err
+	}
+	typeCacheMu.Lock()
+	cached, ok := typeCache[canonicalTypeName(normalized)]
+	typeCacheMu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	abiType, err := parseType(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	key := abiType.GetCanonicalName()
+	typeCacheMu.Lock()
+	defer typeCacheMu.Unlock()
+	if cached, ok := typeCache[key]; ok {
+		return cached, nil
+	}
+	if len(typeCache) >= maxCachedTypes {
+		// Map iteration order is unspecified, so this evicts an arbitrary entry.
+		for name := range typeCache {
+			delete(typeCache, name)
+			break
+		}
+	}
+	typeCache[key] = abiType
+	return abiType, nil
+}
+
+// canonicalTypeName expands the int and uint aliases at the base of a
+// normalized type name, giving the name GetCanonicalName reports for the
+// parsed type in all but unusual spellings such as "uint256[02]". Those miss
+// the cache lookup but still share the instance stored under the canonical
+// name.
+func canonicalTypeName(normalized string) string {
+	dims := strings.IndexByte(normalized, '[')
+	if dims == -1 {
+		dims = len(normalized)
 	}
-	return getPrimitiveType(typeName)
+	switch normalized[:dims] {
+	case "int":
+		return "int256" + normalized[dims:]
+	case "uint":
+		return "uint256" + normalized[dims:]
+	}
+	return normalized
+}
+
+// typeNamePunctuation matches a bracket, parenthesis, or comma together with
+// any whitespace around it.
+var typeNamePunctuation = regexp.MustCompile(`\s*([\[\](),])\s*`)
+
+// arrayTypeName matches an element name followed by one or more dimensions.
+var arrayTypeName = regexp.MustCompile(`^[^\[\]]+(\[[0-9]*\])+$`)
+
+// normalizeTypeName trims whitespace around a type name and around its
+// brackets, parentheses, and commas, so "uint256 [ 2 ]" and " uint256[2]"
+// both become "uint256[2]". Whitespace anywhere else, as in "uint 256", is
+// an error.
+func normalizeTypeName(typeName string) (string, error) {
+	normalized := typeNamePunctuation.ReplaceAllString(strings.TrimSpace(typeName), "$1")
+	if normalized == "" {
+		return "", fmt.Errorf("invalid type name %q: empty", typeName)
+	}
+	if strings.IndexFunc(normalized, unicode.IsSpace) != -1 {
+		return "", fmt.Errorf("invalid type name %q: unexpected whitespace", typeName)
+	}
+	return normalized, nil
+}
+
+// parseType builds a new ABI type from a type name without consulting the cache.
+func parseType(typeName string) (AbiType, error) {
+	normalized, err := normalizeTypeName(typeName)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(normalized, "[") {
+		if !arrayTypeName.MatchString(normalized) {
+			return nil, fmt.Errorf("invalid array type %q", typeName)
+		}
+		return getArrayType(normalized)
+	}
+	return getPrimitiveType(normalized)
 }
 
 func getArrayType(typeName string) (AbiType, error) {
@@ -976,7 +1141,7 @@ func getPrimitiveType(typeName string) (AbiType, error) {
 	case typeName == "function":
 		return NewFunctionType()
 	default:
-		return nil, fmt.Errorf("unknown type: %s", typeName)
+		return nil, fmt.Errorf("unknown type %q", typeName)
 	}
 }
 
@@ -1007,6 +1172,11 @@ type StaticArrayType struct {
 // NewStaticArrayType creates a new static array type
 // typeName should be in format "elementType[size]" e.g. "uint256[3]"
 func NewStaticArrayType(typeName string) (*StaticArrayType, error) {
+	typeName, err := normalizeTypeName(typeName)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse type name to extract element type and size
 	idx1 := strings.Index(typeName, "[")
 	if idx1 == -1 {
@@ -1056,7 +1226,7 @@ func (sat *StaticArrayType) GetElementType() AbiType {
 
 // GetCanonicalName returns the canonical type name
 func (sat *StaticArrayType) GetCanonicalName() string {
-	return fmt.Sprintf("%s[%d]", sat.elementType.GetCanonicalName(), sat.size)
+	return canonicalArrayName(sat.name, sat.elementType)
 }
 
 // GetFixedSize returns the total size (element size * count)
@@ -1071,7 +1241,7 @@ func (sat *StaticArrayType) Encode(value interface{}) ([]byte, error) {
 	switch v := value.(type) {
 	case []interface{}:
 		values = v
-	case []string, []int, []int64, []uint64, []bool:
+	case []string, []int, []int64, []uint64, []bool, [][]byte:
 		// Use reflection to convert typed slices
 		rv := reflect.ValueOf(v)
 		values = make([]interface{}, rv.Len())
@@ -1113,7 +1283,8 @@ func (sat *StaticArrayType) EncodeTuple(values []interface{}) ([]byte, error) {
 			}
 			elems[len(values)+i] = encoded
 
-			// Update offset (round up to 32-byte multiple)
+			// Advance past this element's tail: its length word plus data
+			// padded to a 32-byte multiple (round up defensively)
 			offset += (len(encoded) / Int32Size) * Int32Size
 			if len(encoded)%Int32Size != 0 {
 				offset += Int32Size
@@ -1162,11 +1333,11 @@ func (sat *StaticArrayType) DecodeTuple(encoded []byte, origOffset int, length i
 	for i := 0; i < length; i++ {
 		if sat.elementType.IsDynamicType() {
 			// For dynamic types, read offset and decode from there
-			offsetBig, err := DecodeInt(encoded, offset)
+			relative, err := decodeSize(encoded, offset, "element offset", len(encoded)-origOffset)
 			if err != nil {
-				return nil, fmt.Errorf("failed to decode offset for element %d: %w", i, err)
+				return nil, fmt.Errorf("element %d: %w", i, err)
 			}
-			elemOffset := origOffset + int(offsetBig.Int64())
+			elemOffset := origOffset + relative
 
 			decoded, err := sat.elementType.Decode(encoded, elemOffset)
 			if err != nil {
@@ -1204,6 +1375,11 @@ type DynamicArrayType struct {
 // NewDynamicArrayType creates a new dynamic array type
 // typeName should be in format "elementType[]" e.g. "uint256[]"
 func NewDynamicArrayType(typeName string) (*DynamicArrayType, error) {
+	typeName, err := normalizeTypeName(typeName)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse type name to extract element type
 	idx1 := strings.Index(typeName, "[")
 	if idx1 == -1 {
@@ -1250,7 +1426,54 @@ func (dat *DynamicArrayType) GetElementType() AbiType {
 
 // GetCanonicalName returns the canonical type name
 func (dat *DynamicArrayType) GetCanonicalName() string {
-	return dat.elementType.GetCanonicalName() + "[]"
+	return canonicalArrayName(dat.name, dat.elementType)
+}
+
+// canonicalArrayName returns the canonical name of an array type: the
+// canonical name of its innermost (non-array) element followed by the array
+// dimensions in the order they were written, with sizes normalized. For
+// example "uint[]" becomes "uint256[]" and "int[][03]" becomes "int256[][3]".
+//
+// The dimensions are taken from typeName rather than from the nested element
+// types because the parser treats the first bracket pair as the outermost
+// array, which would otherwise reverse multi-dimensional names.
+func canonicalArrayName(typeName string, elementType AbiType) string {
+	base := elementType
+	for {
+		switch inner := base.(type) {
+		case *StaticArrayType:
+			base = inner.elementType
+			continue
+		case *DynamicArrayType:
+			base = inner.elementType
+			continue
+		}
+		break
+	}
+
+	var name strings.Builder
+	name.WriteString(base.GetCanonicalName())
+	dims := typeName[strings.Index(typeName, "["):]
+	for len(dims) > 0 {
+		closing := strings.Index(dims, "]")
+		if dims[0] != '[' || closing == -1 {
+			// Constructors reject malformed names, so this is unreachable for
+			// parsed types; keep the remainder verbatim rather than panic.
+			name.WriteString(dims)
+			break
+		}
+		name.WriteByte('[')
+		if size := dims[1:closing]; size != "" {
+			if n, err := strconv.Atoi(size); err == nil {
+				name.WriteString(strconv.Itoa(n))
+			} else {
+				name.WriteString(size)
+			}
+		}
+		name.WriteByte(']')
+		dims = dims[closing+1:]
+	}
+	return name.String()
 }
 
 // IsDynamicType returns true as dynamic arrays are dynamic types
@@ -1263,14 +1486,35 @@ func (dat *DynamicArrayType) GetFixedSize() int {
 	return 0
 }
 
-// Encode encodes a dynamic array
+// Encode encodes a dynamic array.
+//
+// value may be a []interface{}, a typed slice of basic values, or a slice of
+// structs (or struct pointers) whose fields carry the same `abi:"name,type"`
+// tags DecodeResponse reads. Each struct becomes the list of its tagged
+// fields in declaration order, so the element type must be a static array
+// of that length, for example "address[][2]" (a dynamic array of address[2];
+// GetType reads the first bracket as the outer dimension) for a struct with
+// two tagged address fields.
+//
+// Note: All tagged fields must have the element's type. The package has no
+// tuple type, so structs of mixed field types, such as (address, uint256)
+// pairs, cannot be encoded.
+//
+// Example:
+//
+//	type pair struct {
+//	    From types.Address `abi:"from,address"`
+//	    To   types.Address `abi:"to,address"`
+//	}
+//	arrayType, _ := abi.GetType("address[][2]")
+//	encoded, err := arrayType.Encode([]pair{{From: a, To: b}})
 func (dat *DynamicArrayType) Encode(value interface{}) ([]byte, error) {
 	// Convert value to slice
 	var values []interface{}
 	switch v := value.(type) {
 	case []interface{}:
 		values = v
-	case []string, []int, []int64, []uint64, []bool:
+	case []string, []int, []int64, []uint64, []bool, [][]byte:
 		// Use reflection to convert typed slices
 		rv := reflect.ValueOf(v)
 		values = make([]interface{}, rv.Len())
@@ -1278,7 +1522,20 @@ func (dat *DynamicArrayType) Encode(value interface{}) ([]byte, error) {
 			values[i] = rv.Index(i).Interface()
 		}
 	default:
-		return nil, fmt.Errorf("unsupported value type for array encoding: %T", value)
+		// Slices of abi-tagged structs encode each struct as the list of its
+		// tagged fields, in declaration order
+		rv := reflect.ValueOf(value)
+		if !rv.IsValid() || !isStructSlice(rv.Type()) {
+			return nil, fmt.Errorf("unsupported value type for array encoding: %T", value)
+		}
+		values = make([]interface{}, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			fields, err := structValues(rv.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode element %d: %w", i, err)
+			}
+			values[i] = fields
+		}
 	}
 
 	return dat.EncodeList(values)
@@ -1319,7 +1576,8 @@ func (dat *DynamicArrayType) EncodeTuple(values []interface{}) ([]byte, error) {
 			}
 			elems[len(values)+i] = encoded
 
-			// Update offset (round up to 32-byte multiple)
+			// Advance past this element's tail: its length word plus data
+			// padded to a 32-byte multiple (round up defensively)
 			offset += (len(encoded) / Int32Size) * Int32Size
 			if len(encoded)%Int32Size != 0 {
 				offset += Int32Size
@@ -1344,13 +1602,10 @@ func (dat *DynamicArrayType) EncodeTuple(values []interface{}) ([]byte, error) {
 // Decode decodes a dynamic array from encoded data
 func (dat *DynamicArrayType) Decode(encoded []byte, origOffset int) (interface{}, error) {
 	// Decode length
-	lengthBig, err := DecodeInt(encoded, origOffset)
+	// Every element takes at least one word after the length word
+	length, err := decodeSize(encoded, origOffset, "array length", (len(encoded)-origOffset-Int32Size)/Int32Size)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode array length: %w", err)
-	}
-	length := int(lengthBig.Int64())
-	if length < 0 {
-		return nil, fmt.Errorf("invalid array length: %d", length)
+		return nil, err
 	}
 
 	// Move past length
@@ -1361,11 +1616,11 @@ func (dat *DynamicArrayType) Decode(encoded []byte, origOffset int) (interface{}
 	for i := 0; i < length; i++ {
 		if dat.elementType.IsDynamicType() {
 			// For dynamic types, read offset and decode from there
-			offsetBig, err := DecodeInt(encoded, offset)
+			relative, err := decodeSize(encoded, offset, "element offset", len(encoded)-origOffset)
 			if err != nil {
-				return nil, fmt.Errorf("failed to decode offset for element %d: %w", i, err)
+				return nil, fmt.Errorf("element %d: %w", i, err)
 			}
-			elemOffset := origOffset + int(offsetBig.Int64())
+			elemOffset := origOffset + relative
 
 			decoded, err := dat.elementType.Decode(encoded, elemOffset)
 			if err != nil {
@@ -1398,11 +1653,11 @@ func (dat *DynamicArrayType) DecodeTuple(encoded []byte, origOffset int, length
 	for i := 0; i < length; i++ {
 		if dat.elementType.IsDynamicType() {
 			// For dynamic types, read offset and decode from there
-			offsetBig, err := DecodeInt(encoded, offset)
+			relative, err := decodeSize(encoded, offset, "element offset", len(encoded)-origOffset)
 			if err != nil {
-				return nil, fmt.Errorf("failed to decode offset for element %d: %w", i, err)
+				return nil, fmt.Errorf("element %d: %w", i, err)
 			}
-			elemOffset := origOffset + int(offsetBig.Int64())
+			elemOffset := origOffset + relative
 
 			decoded, err := dat.elementType.Decode(encoded, elemOffset)
 			if err != nil {
@@ -1484,7 +1739,16 @@ func (ft *FunctionType) Encode(value interface{}) ([]byte, error) {
 	return ft.Bytes32Type.Encode(padded)
 }
 
-// Decode is unimplemented for FunctionType
+// Decode decodes a 24-byte function selector from one 32-byte word and
+// rejects non-zero padding in the last 8 bytes.
 func (ft *FunctionType) Decode(encoded []byte, offset int) (interface{}, error) {
-	return nil, fmt.Errorf("FunctionType.Decode is not implemented")
+	decoded, err := ft.Bytes32Type.Decode(encoded, offset)
+	if err != nil {
+		return nil, err
+	}
+	word := decoded.([]byte)
+	if !bytes.Equal(word[24:], make([]byte, Int32Size-24)) {
+		return nil, fmt.Errorf("invalid function encoding: non-zero right padding")
+	}
+	return word[:24], nil
 }
@@ -150,13 +150,28 @@ func TestIntType_Encode(t *testing.T) {
 			},
 		},
 		{
-			name:  "string hex without prefix",
-			value: "ff",
+			name:  "string decimal 255",
+			value: "255",
 			want: []byte{
 				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
 			},
 		},
+		{
+			name:      "string hex without prefix is not hex",
+			value:     "ff",
+			wantError: true,
+		},
+		{
+			name:      "string exponent notation is not hex",
+			value:     "12e3",
+			wantError: true,
+		},
+		{
+			name:      "string mixed digits and hex letters",
+			value:     "12ab34",
+			wantError: true,
+		},
 		{
 			name:      "invalid string",
 			value:     "not a number",
@@ -2131,12 +2146,39 @@ func TestTokenStandardType_Decode(t *testing.T) {
 			wantZTS:    "zts1znnxxxxxxxxxxxxx9z4ulx",
 			wantErr:    false,
 		},
+		{
+			name:       "bare 10-byte ZNN token standard",
+			encodedHex: "14e66318c6318c6318c6",
+			offset:     0,
+			wantZTS:    "zts1znnxxxxxxxxxxxxx9z4ulx",
+			wantErr:    false,
+		},
+		{
+			name:       "bare 10-byte QSR token standard after a word",
+			encodedHex: "000000000000000000000000000000000000000000000000000000000000002a04066318c6318c6318c6",
+			offset:     32,
+			wantZTS:    "zts1qsrxxxxxxxxxxxxxmrhjll",
+			wantErr:    false,
+		},
+		{
+			name:       "padded word followed by more data",
+			encodedHex: "0000000000000000000000000000000000000000000004066318c6318c6318c614e66318c6318c6318c6",
+			offset:     0,
+			wantZTS:    "zts1qsrxxxxxxxxxxxxxmrhjll",
+			wantErr:    false,
+		},
 		{
 			name:       "insufficient bytes",
 			encodedHex: "000000000000000000000000000000000000000000000014e6",
 			offset:     0,
 			wantErr:    true,
 		},
+		{
+			name:       "fewer than 10 bytes",
+			encodedHex: "14e66318c6318c6318",
+			offset:     0,
+			wantErr:    true,
+		},
 		{
 			name:       "offset too large",
 			encodedHex: "000000000000000000000000000000000000000000000014e66318c6318c6318c6",
@@ -2991,6 +3033,51 @@ func TestDynamicArrayType_GetCanonicalName(t *testing.T) {
 	}
 }
 
+func TestArrayType_GetCanonicalNameAliases(t *testing.T) {
+	tests := []struct {
+		typeName string
+		want     string
+	}{
+		{"uint[]", "uint256[]"},
+		{"int[]", "int256[]"},
+		{"uint[3]", "uint256[3]"},
+		{"int[3]", "int256[3]"},
+		{"uint[][2]", "uint256[][2]"},
+		{"int[2][]", "int256[2][]"},
+		{"uint[2][3]", "uint256[2][3]"},
+		{"int[03]", "int256[3]"},
+		{"uint8[]", "uint8[]"},
+		{"address[2]", "address[2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			typ, err := GetType(tt.typeName)
+			if err != nil {
+				t.Fatalf("GetType(%q) error = %v", tt.typeName, err)
+			}
+			if got := typ.GetCanonicalName(); got != tt.want {
+				t.Errorf("GetCanonicalName() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArrayType_AliasSelectorMatchesCanonical(t *testing.T) {
+	aliased, err := ParseABI([]byte(`[{"type":"function","name":"Vote","inputs":[{"name":"ids","type":"uint[]"},{"name":"weights","type":"int[3]"}]}]`))
+	if err != nil {
+		t.Fatalf("ParseABI(aliased) error = %v", err)
+	}
+	canonical, err := ParseABI([]byte(`[{"type":"function","name":"Vote","inputs":[{"name":"ids","type":"uint256[]"},{"name":"weights","type":"int256[3]"}]}]`))
+	if err != nil {
+		t.Fatalf("ParseABI(canonical) error = %v", err)
+	}
+	got, _ := aliased.Method("Vote")
+	want, _ := canonical.Method("Vote")
+	if !bytes.Equal(got.Selector, want.Selector) {
+		t.Errorf("aliased selector = %x, want %x", got.Selector, want.Selector)
+	}
+}
+
 func TestDynamicArrayType_IsDynamicType(t *testing.T) {
 	dat, _ := NewDynamicArrayType("uint256[]")
 	if !dat.IsDynamicType() {
@@ -3144,6 +3231,200 @@ func TestGetType_Arrays(t *testing.T) {
 	}
 }
 
+func TestGetType_IgnoresSurroundingWhitespace(t *testing.T) {
+	tests := []struct {
+		typeName string
+		wantName string
+	}{
+		{"uint256 ", "uint256"},
+		{"  address", "address"},
+		{"\tstring\n", "string"},
+		{" uint256[3] ", "uint256[3]"},
+		{"uint256 [ 3 ]", "uint256[3]"},
+		{"hash [ ]", "hash[]"},
+		{"address[ ] [2]", "address[][2]"},
+	}
+	for _, tt := range tests {
+		abiType, err := GetType(tt.typeName)
+		if err != nil {
+			t.Errorf("GetType(%q) error = %v", tt.typeName, err)
+			continue
+		}
+		if abiType.GetName() != tt.wantName {
+			t.Errorf("GetType(%q).GetName() = %q, want %q", tt.typeName, abiType.GetName(), tt.wantName)
+		}
+	}
+
+	direct, err := NewStaticArrayType(" uint256 [2] ")
+	if err != nil || direct.GetName() != "uint256[2]" {
+		t.Errorf("NewStaticArrayType() = %v, %v; want uint256[2]", direct, err)
+	}
+}
+
+func TestGetType_RejectsMalformedNames(t *testing.T) {
+	for _, typeName := range []string{"", "   ", "uint 256", "uint256[ 3 2 ]", "address[] x", "bytes 32"} {
+		_, err := GetType(typeName)
+		if err == nil {
+			t.Errorf("GetType(%q) error = nil, want error", typeName)
+			continue
+		}
+		if !strings.Contains(err.Error(), fmt.Sprintf("%q", typeName)) {
+			t.Errorf("GetType(%q) error = %q, want it to quote the input", typeName, err)
+		}
+	}
+}
+
+func TestGetType_ReturnsCachedInstance(t *testing.T) {
+	first, err := GetType("uint256[]")
+	if err != nil {
+		t.Fatalf("GetType() error = %v", err)
+	}
+	second, err := GetType("uint256[]")
+	if err != nil {
+		t.Fatalf("GetType() error = %v", err)
+	}
+	if first != second {
+		t.Error("GetType() returned a different instance for a repeated type name")
+	}
+
+	element, err := GetType("uint256")
+	if err != nil {
+		t.Fatalf("GetType() error = %v", err)
+	}
+	if first.(*DynamicArrayType).GetElementType() != element {
+		t.Error("dynamic array element type was not shared with the cache")
+	}
+}
+
+func TestGetType_DoesNotCacheErrors(t *testing.T) {
+	for i := 0; i < 2; i++ {
+		if _, err := GetType("uint7[]"); err == nil {
+			t.Fatalf("GetType() call %d error = nil, want error", i)
+		}
+	}
+	typeCacheMu.Lock()
+	_, ok := typeCache["uint7[]"]
+	typeCacheMu.Unlock()
+	if ok {
+		t.Error("invalid type name was stored in the cache")
+	}
+}
+
+func TestGetType_CachesByCanonicalName(t *testing.T) {
+	aliases := [][]string{
+		{"hash[]", "hash []", " hash[ ] "},
+		{"uint256[]", "uint[]", "uint [ ]"},
+		{"int256", "int", " int256 "},
+		{"uint256[2][]", "uint[2][]"},
+	}
+	for _, names := range aliases {
+		first, err := GetType(names[0])
+		if err != nil {
+			t.Fatalf("GetType(%q) error = %v", names[0], err)
+		}
+		for _, typeName := range names[1:] {
+			abiType, err := GetType(typeName)
+			if err != nil {
+				t.Fatalf("GetType(%q) error = %v", typeName, err)
+			}
+			if abiType != first {
+				t.Errorf("GetType(%q) returned a different instance than GetType(%q)", typeName, names[0])
+			}
+		}
+	}
+	typeCacheMu.Lock()
+	defer typeCacheMu.Unlock()
+	for key, abiType := range typeCache {
+		if key != abiType.GetCanonicalName() {
+			t.Errorf("cache key %q is not the canonical name %q", key, abiType.GetCanonicalName())
+		}
+	}
+}
+
+func TestGetType_CacheIsBounded(t *testing.T) {
+	typeCacheMu.Lock()
+	saved := typeCache
+	typeCache = make(map[string]AbiType)
+	typeCacheMu.Unlock()
+	defer func() {
+		typeCacheMu.Lock()
+		typeCache = saved
+		typeCacheMu.Unlock()
+	}()
+
+	for i := 1; i <= maxCachedTypes+100; i++ {
+		typeName := fmt.Sprintf("uint8[%d]", i)
+		abiType, err := GetType(typeName)
+		if err != nil {
+			t.Fatalf("GetType(%q) error = %v", typeName, err)
+		}
+		if abiType.GetName() != typeName {
+			t.Fatalf("GetType(%q).GetName() = %q", typeName, abiType.GetName())
+		}
+	}
+	typeCacheMu.Lock()
+	size := len(typeCache)
+	typeCacheMu.Unlock()
+	if size != maxCachedTypes {
+		t.Errorf("cache holds %d types, want %d", size, maxCachedTypes)
+	}
+
+	// Types added after the cache filled up are still cached.
+	last := fmt.Sprintf("uint8[%d]", maxCachedTypes+100)
+	first, _ := GetType(last)
+	if second, _ := GetType(last); first != second {
+		t.Errorf("GetType(%q) returned a different instance once the cache was full", last)
+	}
+}
+
+func TestGetType_ConcurrentAccess(t *testing.T) {
+	names := []string{"uint256", "address[]", "hash[2]", "string[]", "tokenStandard"}
+	done := make(chan AbiType, 64)
+	for i := 0; i < cap(done); i++ {
+		go func(name string) {
+			abiType, err := GetType(name)
+			if err != nil {
+				t.Errorf("GetType(%q) error = %v", name, err)
+			}
+			done <- abiType
+		}(names[i%len(names)])
+	}
+	seen := make(map[string]AbiType)
+	for i := 0; i < cap(done); i++ {
+		abiType := <-done
+		if abiType == nil {
+			continue
+		}
+		if previous, ok := seen[abiType.GetName()]; ok && previous != abiType {
+			t.Errorf("GetType(%q) returned distinct instances", abiType.GetName())
+		}
+		seen[abiType.GetName()] = abiType
+	}
+}
+
+var benchmarkTypeNames = []string{
+	"uint256", "uint64", "int8", "bool", "address", "hash", "tokenStandard",
+	"string", "bytes", "bytes32", "uint256[]", "address[]", "hash[3]", "string[]",
+}
+
+func BenchmarkGetType_Cached(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := GetType(benchmarkTypeNames[i%len(benchmarkTypeNames)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetType_Uncached(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseType(benchmarkTypeNames[i%len(benchmarkTypeNames)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 // ==================== FunctionType Tests ====================
 
 func TestNewFunctionType(t *testing.T) {
@@ -3242,12 +3523,49 @@ func TestFunctionType_Encode(t *testing.T) {
 
 func TestFunctionType_Decode(t *testing.T) {
 	ft, _ := NewFunctionType()
+	selector, _ := hex.DecodeString("0102030405060708090a0b0c0d0e0f101112131415161718")
 
-	// Decode should always return an error (unimplemented)
-	encoded := make([]byte, 32)
-	_, err := ft.Decode(encoded, 0)
-	if err == nil {
-		t.Error("FunctionType.Decode() should return error (unimplemented)")
+	tests := []struct {
+		name    string
+		encoded string
+		offset  int
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "selector",
+			encoded: "0102030405060708090a0b0c0d0e0f1011121314151617180000000000000000",
+			want:    selector,
+		},
+		{
+			name:    "selector at offset",
+			encoded: strings.Repeat("ff", 32) + "0102030405060708090a0b0c0d0e0f1011121314151617180000000000000000",
+			offset:  32,
+			want:    selector,
+		},
+		{
+			name:    "non-zero padding",
+			encoded: "0102030405060708090a0b0c0d0e0f1011121314151617180000000000000001",
+			wantErr: true,
+		},
+		{
+			name:    "short input",
+			encoded: "0102030405060708090a0b0c0d0e0f101112131415161718",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, _ := hex.DecodeString(tt.encoded)
+			got, err := ft.Decode(encoded, tt.offset)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FunctionType.Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !bytes.Equal(got.([]byte), tt.want) {
+				t.Errorf("FunctionType.Decode() = %x, want %x", got, tt.want)
+			}
+		})
 	}
 }
 
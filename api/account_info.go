@@ -0,0 +1,73 @@
+package api
+
+import (
+	"math/big"
+
+	"github.com/0x3639/znn-sdk-go/utils"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// AccountInfo is the account state returned by LedgerApi.GetAccountInfoByAddress.
+//
+// It embeds the node's api.AccountInfo, so Address, AccountHeight, and
+// BalanceInfoMap are available directly, and adds balance accessors that
+// return zero for tokens the account does not hold instead of requiring an
+// ok-check on the map. It marshals to JSON in the node's format, with amounts
+// as decimal strings, whether encoded as a value or a pointer.
+//
+// Example:
+//
+//	info, err := client.LedgerApi.GetAccountInfoByAddress(address)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("ZNN: %s\n", info.BalanceFormatted(types.ZnnTokenStandard, 8))
+//	fmt.Printf("QSR base units: %s\n", info.QsrBalance())
+type AccountInfo struct {
+	api.AccountInfo
+}
+
+// Balance returns the account balance of a token in base units.
+//
+// Parameters:
+//   - zts: Token standard to look up
+//
+// Returns the balance, or zero when the account holds none of the token. The
+// returned value is a copy and may be modified by the caller.
+func (ai *AccountInfo) Balance(zts types.ZenonTokenStandard) *big.Int {
+	if ai == nil {
+		return big.NewInt(0)
+	}
+	entry, ok := ai.BalanceInfoMap[zts]
+	if !ok || entry == nil || entry.Balance == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Set(entry.Balance)
+}
+
+// ZnnBalance returns the ZNN balance in base units, or zero if none is held.
+func (ai *AccountInfo) ZnnBalance() *big.Int {
+	return ai.Balance(types.ZnnTokenStandard)
+}
+
+// QsrBalance returns the QSR balance in base units, or zero if none is held.
+func (ai *AccountInfo) QsrBalance() *big.Int {
+	return ai.Balance(types.QsrTokenStandard)
+}
+
+// BalanceFormatted returns a token balance as a human-readable decimal string.
+//
+// Parameters:
+//   - zts: Token standard to look up
+//   - decimals: Number of decimal places of the token (8 for ZNN and QSR)
+//
+// Returns the balance formatted by utils.AddDecimals, for example "1.5" for
+// 150000000 base units with 8 decimals, or "0" when the token is absent.
+//
+// Example:
+//
+//	fmt.Printf("%s ZNN\n", info.BalanceFormatted(types.ZnnTokenStandard, 8))
+func (ai *AccountInfo) BalanceFormatted(zts types.ZenonTokenStandard, decimals int) string {
+	return utils.AddDecimals(ai.Balance(zts), decimals)
+}
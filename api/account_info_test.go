@@ -0,0 +1,76 @@
+package api
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+const accountInfoResponse = `{
+	"address": "z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7",
+	"accountHeight": 7,
+	"balanceInfoMap": {
+		"zts1znnxxxxxxxxxxxxx9z4ulx": {
+			"token": {"name": "Zenon Coin", "symbol": "ZNN", "domain": "zenon.network", "totalSupply": "0", "decimals": 8,
+				"owner": "z1qxemdeddedxt0kenxxxxxxxxxxxxxxxxh9amk0", "tokenStandard": "zts1znnxxxxxxxxxxxxx9z4ulx",
+				"maxSupply": "0", "isBurnable": true, "isMintable": true, "isUtility": true},
+			"balance": "150000000"
+		}
+	}
+}`
+
+func TestGetAccountInfoByAddressBalanceAccessors(t *testing.T) {
+	caller := &jsonResultCaller{response: accountInfoResponse}
+	info, err := NewLedgerApi(caller).GetAccountInfoByAddress(types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7"))
+	if err != nil {
+		t.Fatalf("GetAccountInfoByAddress() error = %v", err)
+	}
+	if info.AccountHeight != 7 {
+		t.Fatalf("AccountHeight = %d, want 7", info.AccountHeight)
+	}
+	if got := info.ZnnBalance(); got.Cmp(big.NewInt(150000000)) != 0 {
+		t.Fatalf("ZnnBalance() = %s, want 150000000", got)
+	}
+	if got := info.QsrBalance(); got.Sign() != 0 {
+		t.Fatalf("QsrBalance() = %s, want 0 for an absent token", got)
+	}
+	if got := info.BalanceFormatted(types.ZnnTokenStandard, 8); got != "1.5" {
+		t.Fatalf("BalanceFormatted(ZNN) = %q, want 1.5", got)
+	}
+	if got := info.BalanceFormatted(types.QsrTokenStandard, 8); got != "0" {
+		t.Fatalf("BalanceFormatted(QSR) = %q, want 0", got)
+	}
+}
+
+func TestAccountInfoBalanceReturnsCopy(t *testing.T) {
+	info := &AccountInfo{AccountInfo: api.AccountInfo{
+		BalanceInfoMap: map[types.ZenonTokenStandard]*api.BalanceInfo{
+			types.QsrTokenStandard: {Balance: big.NewInt(42)},
+		},
+	}}
+	info.QsrBalance().SetInt64(0)
+	if got := info.QsrBalance(); got.Int64() != 42 {
+		t.Fatalf("QsrBalance() = %s after mutating a returned value, want 42", got)
+	}
+}
+
+func TestAccountInfoBalanceHandlesMissingEntries(t *testing.T) {
+	var nilInfo *AccountInfo
+	if got := nilInfo.ZnnBalance(); got.Sign() != 0 {
+		t.Fatalf("nil ZnnBalance() = %s, want 0", got)
+	}
+	info := &AccountInfo{AccountInfo: api.AccountInfo{
+		BalanceInfoMap: map[types.ZenonTokenStandard]*api.BalanceInfo{
+			types.ZnnTokenStandard: nil,
+			types.QsrTokenStandard: {},
+		},
+	}}
+	if got := info.ZnnBalance(); got.Sign() != 0 {
+		t.Fatalf("ZnnBalance() with nil entry = %s, want 0", got)
+	}
+	if got := info.QsrBalance(); got.Sign() != 0 {
+		t.Fatalf("QsrBalance() with nil balance = %s, want 0", got)
+	}
+}
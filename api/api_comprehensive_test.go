@@ -188,6 +188,21 @@ func TestLedgerTemplatesContainCallerValues(t *testing.T) {
 	}
 }
 
+func TestSendTemplateCheckedRejectsNonPositiveAmounts(t *testing.T) {
+	ledger := NewLedgerApi(nil)
+	address := types.ParseAddressPanic("z1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsggv2f")
+
+	for _, amount := range []*big.Int{nil, big.NewInt(0), big.NewInt(-1)} {
+		if block, err := ledger.SendTemplateChecked(address, types.ZnnTokenStandard, amount, nil); !errors.Is(err, ErrNonPositiveAmount) || block != nil {
+			t.Errorf("SendTemplateChecked(%v) = %v, %v; want ErrNonPositiveAmount", amount, block, err)
+		}
+	}
+	block, err := ledger.SendTemplateChecked(address, types.ZnnTokenStandard, big.NewInt(1), nil)
+	if err != nil || block.Amount.Cmp(big.NewInt(1)) != 0 || block.ToAddress != address {
+		t.Fatalf("SendTemplateChecked(1) = %+v, %v", block, err)
+	}
+}
+
 func TestStatsMethodsUseCanonicalWireCalls(t *testing.T) {
 	caller := new(recordingCaller)
 	stats := NewStatsApi(caller)
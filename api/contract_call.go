@@ -0,0 +1,106 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0x3639/znn-sdk-go/abi"
+	"github.com/0x3639/znn-sdk-go/embedded"
+	"github.com/zenon-network/go-zenon/chain/nom"
+)
+
+// ErrCallRejected is returned by CallContract when a preflight check shows
+// the node would reject the block.
+var ErrCallRejected = errors.New("contract call would be rejected")
+
+// CallContract checks, without publishing, whether a contract call block
+// would be accepted.
+//
+// Zenon nodes have no RPC that evaluates a contract call without publishing
+// it. Embedded contract methods return no data, so there is also no call
+// result to read. CallContract therefore runs the common preflight checks
+// locally, in order, and stops at the first failure:
+//
+//  1. Parameters: for an embedded contract, Data must be empty or select a
+//     method of that contract and decode against its ABI
+//  2. Balance: the sender must hold Amount of TokenStandard
+//  3. Plasma: the sender's plasma must cover the block, or the block must
+//     carry a valid PoW nonce, as checked by CheckSubmittable
+//
+// Parameters:
+//   - block: Unsigned send block, such as a template from an embedded
+//     contract API. Address must be set (Zenon.PrepareBlock and the autofill
+//     step set it from the signer).
+//
+// Returns nil data and a nil error when every check passes. Otherwise
+// returns an error wrapping ErrCallRejected together with the reason:
+// ErrInsufficientBalance, ErrNotSubmittable for missing plasma or PoW, or the
+// ABI decoding error. RPC failures are returned unwrapped.
+//
+// Example:
+//
+//	template := client.PlasmaApi.Fuse(beneficiary, amount)
+//	template.Address = myAddress
+//	_, err := client.LedgerApi.CallContract(template)
+//	switch {
+//	case errors.Is(err, api.ErrNotSubmittable):
+//	    // everything else passed; generate PoW or fuse plasma first
+//	case err != nil:
+//	    return err
+//	}
+//
+// Note: Contract-specific rules enforced by the node (for example that a
+// Pillar name is free, or that a stake has expired) are not checked. Node
+// state can also change between the check and publishing.
+func (la *LedgerApi) CallContract(block *nom.AccountBlock) ([]byte, error) {
+	if block == nil {
+		return nil, fmt.Errorf("%w: nil block", ErrCallRejected)
+	}
+	if !block.IsSendBlock() {
+		return nil, fmt.Errorf("%w: block type %d is not a send block", ErrCallRejected, block.BlockType)
+	}
+
+	if err := checkContractParameters(block); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCallRejected, err)
+	}
+
+	if block.Amount != nil && block.Amount.Sign() > 0 {
+		info, err := la.GetAccountInfoByAddress(block.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account info: %w", err)
+		}
+		if balance := info.Balance(block.TokenStandard); balance.Cmp(block.Amount) < 0 {
+			return nil, fmt.Errorf("%w: %w: %s holds %s of %s, call sends %s",
+				ErrCallRejected, ErrInsufficientBalance, block.Address, balance, block.TokenStandard, block.Amount)
+		}
+	}
+
+	if err := la.CheckSubmittable(block); err != nil {
+		if errors.Is(err, ErrNotSubmittable) {
+			return nil, fmt.Errorf("%w: %w", ErrCallRejected, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// checkContractParameters verifies that a block sent to an embedded contract
+// calls one of its methods with arguments that decode against its ABI.
+// Blocks sent to other addresses and blocks without data pass.
+func checkContractParameters(block *nom.AccountBlock) error {
+	if len(block.Data) == 0 {
+		return nil
+	}
+	call, err := embedded.DescribeBlock(block)
+	switch {
+	case errors.Is(err, embedded.ErrNotEmbeddedContract):
+		return nil
+	case err != nil:
+		return err
+	case call == nil && len(block.Data) < abi.EncodedSignLength:
+		return fmt.Errorf("data is %d bytes, shorter than a method selector", len(block.Data))
+	case call == nil:
+		return fmt.Errorf("selector %x is not a method of the contract at %s", block.Data[:abi.EncodedSignLength], block.ToAddress)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/embedded"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// methodResultCaller answers each method with a fixed JSON response and
+// records the methods called.
+type methodResultCaller struct {
+	responses map[string]string
+	methods   []string
+}
+
+func (c *methodResultCaller) Call(result interface{}, method string, _ ...interface{}) error {
+	c.methods = append(c.methods, method)
+	response, ok := c.responses[method]
+	if !ok {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	return json.Unmarshal([]byte(response), result)
+}
+
+const (
+	plasmaCovered = `{"availablePlasma":105000,"basePlasma":52500,"requiredDifficulty":0}`
+	plasmaMissing = `{"availablePlasma":0,"basePlasma":52500,"requiredDifficulty":78750000}`
+)
+
+// stakeCallBlock returns a 1-month stake of amount ZNN base units from the
+// account described by accountInfoResponse, which holds 150000000.
+func stakeCallBlock(t *testing.T, amount int64) *nom.AccountBlock {
+	t.Helper()
+	data, err := embedded.Stake.EncodeFunction("Stake", []interface{}{int64(embedded.StakeTimeMinSec)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &nom.AccountBlock{
+		BlockType:     nom.BlockTypeUserSend,
+		Address:       types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7"),
+		ToAddress:     types.StakeContract,
+		TokenStandard: types.ZnnTokenStandard,
+		Amount:        big.NewInt(amount),
+		Data:          data,
+	}
+}
+
+func TestCallContractPassesPreflight(t *testing.T) {
+	caller := &methodResultCaller{responses: map[string]string{
+		"ledger.getAccountInfoByAddress":                accountInfoResponse,
+		"embedded.plasma.getRequiredPoWForAccountBlock": plasmaCovered,
+	}}
+	data, err := NewLedgerApi(caller).CallContract(stakeCallBlock(t, 100000000))
+	if err != nil || data != nil {
+		t.Fatalf("CallContract() = %x, %v; want nil, nil", data, err)
+	}
+	if len(caller.methods) != 2 {
+		t.Errorf("methods = %v, want balance and plasma queries", caller.methods)
+	}
+}
+
+func TestCallContractRejections(t *testing.T) {
+	unknownSelector := stakeCallBlock(t, 100000000)
+	unknownSelector.Data = append([]byte{0xde, 0xad, 0xbe, 0xef}, unknownSelector.Data[4:]...)
+	truncated := stakeCallBlock(t, 100000000)
+	truncated.Data = truncated.Data[:4+16]
+	receive := stakeCallBlock(t, 0)
+	receive.BlockType = nom.BlockTypeUserReceive
+
+	tests := []struct {
+		name    string
+		block   *nom.AccountBlock
+		plasma  string
+		wantErr error
+		queries int
+	}{
+		{name: "nil block", block: nil, wantErr: ErrCallRejected},
+		{name: "receive block", block: receive, wantErr: ErrCallRejected},
+		{name: "unknown method", block: unknownSelector, wantErr: ErrCallRejected},
+		{name: "truncated arguments", block: truncated, wantErr: ErrCallRejected},
+		{name: "insufficient balance", block: stakeCallBlock(t, 200000000), wantErr: ErrInsufficientBalance, queries: 1},
+		{name: "no plasma and no PoW", block: stakeCallBlock(t, 100000000), plasma: plasmaMissing, wantErr: ErrNotSubmittable, queries: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plasma := tt.plasma
+			if plasma == "" {
+				plasma = plasmaCovered
+			}
+			caller := &methodResultCaller{responses: map[string]string{
+				"ledger.getAccountInfoByAddress":                accountInfoResponse,
+				"embedded.plasma.getRequiredPoWForAccountBlock": plasma,
+			}}
+			_, err := NewLedgerApi(caller).CallContract(tt.block)
+			if !errors.Is(err, ErrCallRejected) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CallContract() error = %v, want %v wrapping %v", err, ErrCallRejected, tt.wantErr)
+			}
+			if len(caller.methods) != tt.queries {
+				t.Errorf("methods = %v, want %d queries", caller.methods, tt.queries)
+			}
+		})
+	}
+}
+
+func TestCallContractSkipsParameterCheckForAccounts(t *testing.T) {
+	block := stakeCallBlock(t, 100000000)
+	block.ToAddress = types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")
+	block.Data = []byte("memo")
+	caller := &methodResultCaller{responses: map[string]string{
+		"ledger.getAccountInfoByAddress":                accountInfoResponse,
+		"embedded.plasma.getRequiredPoWForAccountBlock": plasmaCovered,
+	}}
+	if _, err := NewLedgerApi(caller).CallContract(block); err != nil {
+		t.Fatalf("CallContract() error = %v", err)
+	}
+}
+
+func TestCallContractPropagatesQueryErrors(t *testing.T) {
+	caller := &methodResultCaller{responses: map[string]string{}}
+	_, err := NewLedgerApi(caller).CallContract(stakeCallBlock(t, 100000000))
+	if err == nil || errors.Is(err, ErrCallRejected) {
+		t.Fatalf("CallContract() error = %v, want the RPC error unwrapped from ErrCallRejected", err)
+	}
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0x3639/znn-sdk-go/api/embedded"
+	"github.com/0x3639/znn-sdk-go/pow"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// CostEstimate summarizes what publishing a block will cost its sender.
+//
+// Zenon charges no fees: a block is paid for either with plasma from fused
+// QSR or with a proof-of-work nonce.
+type CostEstimate struct {
+	// RequiredPlasma is the plasma the block needs
+	RequiredPlasma uint64
+	// AvailablePlasma is the plasma the account has right now
+	AvailablePlasma uint64
+	// RequiredDifficulty is the PoW difficulty the node asks for, zero when
+	// plasma covers the block
+	RequiredDifficulty uint64
+	// PlasmaCovers reports whether the block is feeless without PoW
+	PlasmaCovers bool
+	// EstimatedPoWDuration is the expected PoW time at pow.DefaultHashRate,
+	// zero when plasma covers the block
+	EstimatedPoWDuration time.Duration
+	// Strategy is the recommendation from pow.RecommendStrategy
+	Strategy pow.Strategy
+}
+
+// Summary returns a one-line description of the estimate suitable for a
+// confirmation prompt.
+func (c *CostEstimate) Summary() string {
+	switch c.Strategy.Kind {
+	case pow.StrategyUsePlasma:
+		return "This transaction is feeless (plasma covers it)"
+	case pow.StrategyGeneratePoW:
+		return fmt.Sprintf("This transaction will require ~%s of computation", c.EstimatedPoWDuration.Round(time.Second))
+	default:
+		return fmt.Sprintf("This transaction needs %d plasma (available %d); fuse more QSR", c.RequiredPlasma, c.AvailablePlasma)
+	}
+}
+
+// EstimateCost asks the node what publishing block from address would cost.
+//
+// The node reports the plasma the block needs, the plasma the account has, and
+// the PoW difficulty that makes up the shortfall. The PoW duration is estimated
+// with pow.EstimatePoWDuration at pow.DefaultHashRate; actual runs vary widely
+// around it.
+//
+// Parameters:
+//   - block: Block to estimate; BlockType, ToAddress, and Data are used
+//   - address: Sender whose plasma pays for the block
+//
+// Returns the estimate or the RPC error if the requirement could not be
+// queried.
+//
+// Example:
+//
+//	estimate, err := client.LedgerApi.EstimateCost(block, keyPair.Address)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(estimate.Summary())
+//	// "This transaction is feeless (plasma covers it)" or
+//	// "This transaction will require ~32s of computation"
+//
+// Note: The estimate uses node state at call time. Plasma consumed by other
+// blocks before publishing can change the outcome.
+func (la *LedgerApi) EstimateCost(block *nom.AccountBlock, address types.Address) (*CostEstimate, error) {
+	if block == nil {
+		return nil, errors.New("nil block")
+	}
+
+	required, err := embedded.NewPlasmaApi(la.client).GetRequiredPoWForAccountBlock(embedded.GetRequiredParam{
+		Address:   address,
+		BlockType: block.BlockType,
+		ToAddress: block.ToAddress,
+		Data:      block.Data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query required PoW: %w", err)
+	}
+
+	estimate := &CostEstimate{
+		RequiredPlasma:     required.BasePlasma,
+		AvailablePlasma:    required.AvailablePlasma,
+		RequiredDifficulty: required.RequiredDifficulty,
+		PlasmaCovers:       required.RequiredDifficulty == 0,
+	}
+	if estimate.PlasmaCovers {
+		estimate.Strategy = pow.Strategy{Kind: pow.StrategyUsePlasma}
+		return estimate, nil
+	}
+	estimate.EstimatedPoWDuration = pow.EstimatePoWDuration(required.RequiredDifficulty, 0)
+	estimate.Strategy = pow.RecommendStrategy(required.AvailablePlasma, required.BasePlasma, required.RequiredDifficulty)
+	return estimate, nil
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0x3639/znn-sdk-go/pow"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name         string
+		plasma       string
+		want         CostEstimate
+		wantDuration time.Duration
+		wantSummary  string
+	}{
+		{
+			name:   "plasma covers",
+			plasma: plasmaCovered,
+			want: CostEstimate{
+				RequiredPlasma:  52500,
+				AvailablePlasma: 105000,
+				PlasmaCovers:    true,
+				Strategy:        pow.Strategy{Kind: pow.StrategyUsePlasma},
+			},
+			wantSummary: "This transaction is feeless (plasma covers it)",
+		},
+		{
+			name:   "needs PoW",
+			plasma: plasmaMissing,
+			want: CostEstimate{
+				RequiredPlasma:       52500,
+				RequiredDifficulty:   78750000,
+				EstimatedPoWDuration: 78750 * time.Millisecond,
+				Strategy: pow.Strategy{
+					Kind:              pow.StrategyGeneratePoW,
+					EstimatedDuration: 78750 * time.Millisecond,
+				},
+			},
+			wantSummary: "This transaction will require ~1m19s of computation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller := &methodResultCaller{responses: map[string]string{
+				"embedded.plasma.getRequiredPoWForAccountBlock": tt.plasma,
+			}}
+			block := &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, ToAddress: types.PlasmaContract}
+			got, err := NewLedgerApi(caller).EstimateCost(block, types.PlasmaContract)
+			if err != nil {
+				t.Fatalf("EstimateCost() error = %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("EstimateCost() = %+v, want %+v", *got, tt.want)
+			}
+			if s := got.Summary(); s != tt.wantSummary {
+				t.Errorf("Summary() = %q, want %q", s, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestEstimateCostErrors(t *testing.T) {
+	caller := &methodResultCaller{responses: map[string]string{}}
+	if _, err := NewLedgerApi(caller).EstimateCost(nil, types.PlasmaContract); err == nil {
+		t.Error("EstimateCost(nil) error = nil, want error")
+	}
+	block := &nom.AccountBlock{BlockType: nom.BlockTypeUserSend}
+	if _, err := NewLedgerApi(caller).EstimateCost(block, types.PlasmaContract); err == nil {
+		t.Errorf("EstimateCost() error = %v, want RPC error", err)
+	}
+}
@@ -13,7 +13,7 @@
 //
 //	// Query account information
 //	info, err := client.LedgerApi.GetAccountInfoByAddress(address)
-//	fmt.Printf("Balance: %s ZNN\n", info.Znn())
+//	fmt.Printf("Balance: %s ZNN\n", info.BalanceFormatted(types.ZnnTokenStandard, 8))
 //
 //	// Get current blockchain height
 //	momentum, err := client.LedgerApi.GetFrontierMomentum()
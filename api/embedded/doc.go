@@ -50,8 +50,8 @@
 // Example - Staking ZNN:
 //
 //	// Create stake transaction template
-//	template := client.StakeApi.Stake(
-//	    durationInDays,
+//	template, err := client.StakeApi.Stake(
+//	    durationInSec, // whole months of 30 days, 1 to 12
 //	    amount,
 //	)
 //
@@ -100,7 +100,7 @@
 //
 //	// Stake 100 ZNN for 30 days
 //	amount := big.NewInt(100 * 100000000)
-//	template := client.StakeApi.Stake(30, amount)
+//	template, err := client.StakeApi.Stake(2592000, amount) // 30 days in seconds
 //
 //	// Later, collect rewards
 //	rewardTemplate := client.StakeApi.CollectReward()
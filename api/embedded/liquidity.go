@@ -29,6 +29,15 @@ func (sa *LiquidityApi) GetUncollectedReward(address types.Address) (*Uncollecte
 	return ans, nil
 }
 
+// GetFrontierRewardByPage retrieves a paginated history of liquidity staking
+// rewards for an address, one entry per epoch.
+//
+// Parameters:
+//   - address: Address holding the liquidity stake
+//   - pageIndex: Page number (0-indexed)
+//   - pageSize: Number of entries per page (at most 1024)
+//
+// Returns paginated reward history or an error.
 func (sa *LiquidityApi) GetFrontierRewardByPage(address types.Address, pageIndex, pageSize uint32) (*RewardHistoryList, error) {
 	if err := rpcvalidation.ValidateLimit("embedded.liquidity.getFrontierRewardByPage", "pageSize", uint64(pageSize), rpcvalidation.MaxPageSize); err != nil {
 		return nil, err
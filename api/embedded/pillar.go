@@ -1,17 +1,30 @@
 package embedded
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 
+	sdkembedded "github.com/0x3639/znn-sdk-go/embedded"
 	"github.com/0x3639/znn-sdk-go/internal/rpcvalidation"
 	"github.com/0x3639/znn-sdk-go/transport"
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
 	"github.com/zenon-network/go-zenon/vm/constants"
 	"github.com/zenon-network/go-zenon/vm/embedded/definition"
 )
 
+var (
+	// ErrPillarNotFound is returned by GetByName and DelegateChecked when no
+	// active Pillar has the requested name.
+	ErrPillarNotFound = errors.New("pillar not found")
+	// ErrPillarRevoked is returned by DelegateChecked when the named Pillar
+	// has been revoked and no longer accepts delegations.
+	ErrPillarRevoked = errors.New("pillar is revoked")
+)
+
 type PillarApi struct {
 	client transport.Caller
 }
@@ -77,6 +90,16 @@ func (pa *PillarApi) GetUncollectedReward(address types.Address) (*UncollectedRe
 	return ans, nil
 }
 
+// GetFrontierRewardByPage retrieves a paginated history of the Pillar rewards
+// paid to an address, one entry per epoch with its ZNN and QSR amounts. Use
+// GetUncollectedReward for what can be collected now.
+//
+// Parameters:
+//   - address: Address the rewards were paid to
+//   - pageIndex: Page number (0-indexed)
+//   - pageSize: Number of entries per page (at most 1024)
+//
+// Returns paginated reward history or an error.
 func (pa *PillarApi) GetFrontierRewardByPage(address types.Address, pageIndex, pageSize uint32) (*RewardHistoryList, error) {
 	if err := rpcvalidation.ValidateLimit("embedded.pillar.getFrontierRewardByPage", "pageSize", uint64(pageSize), rpcvalidation.MaxPageSize); err != nil {
 		return nil, err
@@ -107,11 +130,32 @@ func (pa *PillarApi) GetByOwner(address types.Address) ([]*PillarInfo, error) {
 	return ans, nil
 }
 
+// GetByName retrieves a single active Pillar by its exact name.
+//
+// The lookup runs on the node, so validating a user-supplied name does not
+// require paging through GetAll.
+//
+// Parameters:
+//   - name: Pillar name (case-sensitive)
+//
+// Returns the Pillar's information, an error wrapping ErrPillarNotFound when
+// no active Pillar has that name, or the RPC error.
+//
+// Example:
+//
+//	pillar, err := client.PillarApi.GetByName("MyPillar")
+//	if errors.Is(err, embedded.ErrPillarNotFound) {
+//	    fmt.Println("unknown pillar")
+//	}
 func (pa *PillarApi) GetByName(name string) (*PillarInfo, error) {
-	ans := new(PillarInfo)
-	if err := pa.client.Call(ans, "embedded.pillar.getByName", name); err != nil {
+	// The node answers null for unknown names, which leaves ans nil
+	var ans *PillarInfo
+	if err := pa.client.Call(&ans, "embedded.pillar.getByName", name); err != nil {
 		return nil, err
 	}
+	if ans == nil {
+		return nil, fmt.Errorf("%w: %q", ErrPillarNotFound, name)
+	}
 	return ans, nil
 }
 
@@ -153,6 +197,74 @@ func (pa *PillarApi) GetPillarsHistoryByEpoch(epoch uint64, pageIndex, pageSize
 	return ans, nil
 }
 
+// CheckRegistrationRequirements checks that an address holds the ZNN and QSR
+// a Pillar registration needs, before Register is built and published.
+//
+// It reads the account's balances, the QSR already deposited in the Pillar
+// contract, and the current QSR registration cost from the node. The ZNN
+// requirement is sdkembedded.PillarRegisterZnnAmount; the QSR cost starts at
+// sdkembedded.PillarRegisterQsrAmount and rises as Pillars are registered, so
+// the node's GetQsrRegistrationCost is used. Checking first gives the user a
+// clear shortfall instead of a node rejection after PoW.
+//
+// Parameters:
+//   - address: Account that would register the Pillar
+//
+// Returns the readiness report, or the first RPC error.
+//
+// Example:
+//
+//	readiness, err := client.PillarApi.CheckRegistrationRequirements(address)
+//	if err != nil {
+//	    return err
+//	}
+//	if !readiness.Ready() {
+//	    return errors.New(readiness.Summary()) // "You need 500 more QSR to register a Pillar"
+//	}
+//
+// Note: Balances can change between the check and publishing. Unreceived
+// blocks are not counted; receive them first.
+func (pa *PillarApi) CheckRegistrationRequirements(address types.Address) (*RegistrationReadiness, error) {
+	info := new(api.AccountInfo)
+	if err := pa.client.Call(info, "ledger.getAccountInfoByAddress", address.String()); err != nil {
+		return nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+	deposited, err := pa.GetDepositedQsr(address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deposited QSR: %w", err)
+	}
+	cost, err := pa.GetQsrRegistrationCost()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get QSR registration cost: %w", err)
+	}
+
+	balance := func(zts types.ZenonTokenStandard) *big.Int {
+		if entry, ok := info.BalanceInfoMap[zts]; ok && entry != nil && entry.Balance != nil {
+			return new(big.Int).Set(entry.Balance)
+		}
+		return big.NewInt(0)
+	}
+	shortfall := func(required, available *big.Int) *big.Int {
+		if available.Cmp(required) >= 0 {
+			return big.NewInt(0)
+		}
+		return new(big.Int).Sub(required, available)
+	}
+
+	readiness := &RegistrationReadiness{
+		ZnnBalance:   balance(types.ZnnTokenStandard),
+		QsrBalance:   balance(types.QsrTokenStandard),
+		DepositedQsr: amountOrZero(deposited),
+		RequiredZnn:  new(big.Int).Set(sdkembedded.PillarRegisterZnnAmount),
+		RequiredQsr:  amountOrZero(cost),
+	}
+	readiness.ZnnShortfall = shortfall(readiness.RequiredZnn, readiness.ZnnBalance)
+	readiness.QsrShortfall = shortfall(readiness.RequiredQsr, new(big.Int).Add(readiness.QsrBalance, readiness.DepositedQsr))
+	readiness.HasSufficientZnn = readiness.ZnnShortfall.Sign() == 0
+	readiness.HasSufficientQsr = readiness.QsrShortfall.Sign() == 0
+	return readiness, nil
+}
+
 // Contract calls
 
 // Register creates a transaction template to register a new Pillar.
@@ -286,6 +398,40 @@ func (pa *PillarApi) Delegate(name string) *nom.AccountBlock {
 	}
 }
 
+// DelegateChecked creates a Delegate transaction template after confirming
+// that the Pillar exists and has not been revoked.
+//
+// Delegate builds a template for any name, so a typo only surfaces as an
+// opaque rejection from the node after signing and PoW. DelegateChecked looks
+// the Pillar up with GetByName first and fails locally instead. Use Delegate
+// when the name has already been validated.
+//
+// Parameters:
+//   - name: Name of the Pillar to delegate to
+//
+// Returns an unsigned AccountBlock template, or an error wrapping
+// ErrPillarNotFound or ErrPillarRevoked, or the lookup error.
+//
+// Example:
+//
+//	template, err := client.PillarApi.DelegateChecked("MyFavoritePillar")
+//	if errors.Is(err, embedded.ErrPillarNotFound) {
+//	    log.Fatal("no such pillar")
+//	}
+func (pa *PillarApi) DelegateChecked(name string) (*nom.AccountBlock, error) {
+	pillar, err := pa.GetByName(name)
+	if errors.Is(err, ErrPillarNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up pillar %q: %w", name, err)
+	}
+	if pillar.RevokeTimestamp != 0 {
+		return nil, fmt.Errorf("%w: %q", ErrPillarRevoked, name)
+	}
+	return pa.Delegate(name), nil
+}
+
 func (pa *PillarApi) Undelegate() *nom.AccountBlock {
 	return &nom.AccountBlock{
 		BlockType:     nom.BlockTypeUserSend,
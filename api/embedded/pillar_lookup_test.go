@@ -0,0 +1,169 @@
+package embedded_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/api/embedded"
+	"github.com/0x3639/znn-sdk-go/rpc_client"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+func TestPillarApi_DelegateChecked(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		result  interface{}
+		wantErr error
+	}{
+		{name: "active", result: map[string]interface{}{"name": "Alpha", "weight": "100"}},
+		{name: "missing", result: nil, wantErr: embedded.ErrPillarNotFound},
+		{name: "revoked", result: map[string]interface{}{"name": "Alpha", "revokeTimestamp": 1700000000, "weight": "0"}, wantErr: embedded.ErrPillarRevoked},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mock := rpc_client.NewMockClient().On("embedded.pillar.getByName", test.result)
+			pillars := embedded.NewPillarApi(mock)
+
+			block, err := pillars.DelegateChecked("Alpha")
+			if test.wantErr != nil {
+				if !errors.Is(err, test.wantErr) || block != nil {
+					t.Fatalf("DelegateChecked() = %v, %v; want %v", block, err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DelegateChecked() error = %v", err)
+			}
+			want := definition.ABIPillars.PackMethodPanic(definition.DelegateMethodName, "Alpha")
+			if !bytes.Equal(block.Data, want) {
+				t.Errorf("Data = %x, want %x", block.Data, want)
+			}
+			if calls := mock.CallsTo("embedded.pillar.getByName"); len(calls) != 1 || calls[0].Params[0] != "Alpha" {
+				t.Errorf("getByName calls = %+v", calls)
+			}
+		})
+	}
+}
+
+func TestPillarApi_DelegateChecked_LookupError(t *testing.T) {
+	nodeErr := errors.New("node unavailable")
+	pillars := embedded.NewPillarApi(rpc_client.NewMockClient().OnError("embedded.pillar.getByName", nodeErr))
+	if _, err := pillars.DelegateChecked("Alpha"); !errors.Is(err, nodeErr) {
+		t.Errorf("DelegateChecked() error = %v, want the lookup error", err)
+	}
+}
+
+func TestPillarApi_GetByNameDecodesPillarInfo(t *testing.T) {
+	mock := rpc_client.NewMockClient().On("embedded.pillar.getByName", json.RawMessage(`{
+		"name": "Alpha",
+		"rank": 3,
+		"type": 1,
+		"ownerAddress": "z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7",
+		"producerAddress": "z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz",
+		"withdrawAddress": "z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7",
+		"giveMomentumRewardPercentage": 20,
+		"giveDelegateRewardPercentage": 80,
+		"isRevocable": false,
+		"revokeCooldown": 86400,
+		"revokeTimestamp": 0,
+		"currentStats": {"producedMomentums": 10, "expectedMomentums": 12},
+		"weight": "123456789012345678901234"
+	}`))
+	pillar, err := embedded.NewPillarApi(mock).GetByName("Alpha")
+	if err != nil {
+		t.Fatalf("GetByName() error = %v", err)
+	}
+
+	weight, _ := new(big.Int).SetString("123456789012345678901234", 10)
+	if pillar.Name != "Alpha" || pillar.Rank != 3 || pillar.Type != 1 ||
+		pillar.OwnerAddress != types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7") ||
+		pillar.ProducerAddress != types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz") ||
+		pillar.GiveMomentumRewardPercentage != 20 || pillar.GiveDelegateRewardPercentage != 80 ||
+		pillar.RevokeCooldown != 86400 || pillar.Weight.Cmp(weight) != 0 {
+		t.Errorf("GetByName() = %+v", pillar)
+	}
+	if pillar.CurrentStats == nil || pillar.CurrentStats.ProducedMomentums != 10 || pillar.CurrentStats.ExpectedMomentums != 12 {
+		t.Errorf("CurrentStats = %+v", pillar.CurrentStats)
+	}
+}
+
+func TestPillarApi_GetByNameNotFound(t *testing.T) {
+	pillars := embedded.NewPillarApi(rpc_client.NewMockClient().On("embedded.pillar.getByName", nil))
+	pillar, err := pillars.GetByName("Missing")
+	if !errors.Is(err, embedded.ErrPillarNotFound) || pillar != nil {
+		t.Errorf("GetByName() = %v, %v; want ErrPillarNotFound", pillar, err)
+	}
+}
+
+func TestPillarApi_CheckRegistrationRequirements(t *testing.T) {
+	accountInfo := func(znn, qsr string) json.RawMessage {
+		return json.RawMessage(`{"address":"z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7","accountHeight":3,"balanceInfoMap":{` +
+			`"zts1znnxxxxxxxxxxxxx9z4ulx":{"token":{"decimals":8},"balance":"` + znn + `"},` +
+			`"zts1qsrxxxxxxxxxxxxxmrhjll":{"token":{"decimals":8},"balance":"` + qsr + `"}}}`)
+	}
+	for _, test := range []struct {
+		name             string
+		znn, qsr         string
+		deposited        string
+		wantZnnShortfall int64
+		wantQsrShortfall int64
+		wantSummary      string
+	}{
+		{
+			name:             "under-funded",
+			znn:              "1000000000000",  // 10,000 ZNN
+			qsr:              "10000000000000", // 100,000 QSR
+			deposited:        "4950000000000",  // 49,500 QSR
+			wantZnnShortfall: 500000000000,
+			wantQsrShortfall: 50000000000,
+			wantSummary:      "You need 5000 more ZNN and 500 more QSR to register a Pillar",
+		},
+		{
+			name:        "over-funded",
+			znn:         "2000000000000",
+			qsr:         "20000000000000",
+			deposited:   "0",
+			wantSummary: "This address can register a Pillar",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			address := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+			mock := rpc_client.NewMockClient().
+				On("ledger.getAccountInfoByAddress", accountInfo(test.znn, test.qsr)).
+				On("embedded.pillar.getDepositedQsr", test.deposited).
+				On("embedded.pillar.getQsrRegistrationCost", "15000000000000")
+
+			readiness, err := embedded.NewPillarApi(mock).CheckRegistrationRequirements(address)
+			if err != nil {
+				t.Fatalf("CheckRegistrationRequirements() error = %v", err)
+			}
+			if got := readiness.ZnnShortfall.Int64(); got != test.wantZnnShortfall {
+				t.Errorf("ZnnShortfall = %d, want %d", got, test.wantZnnShortfall)
+			}
+			if got := readiness.QsrShortfall.Int64(); got != test.wantQsrShortfall {
+				t.Errorf("QsrShortfall = %d, want %d", got, test.wantQsrShortfall)
+			}
+			wantReady := test.wantZnnShortfall == 0 && test.wantQsrShortfall == 0
+			if readiness.Ready() != wantReady || readiness.HasSufficientZnn != (test.wantZnnShortfall == 0) {
+				t.Errorf("Ready() = %v, HasSufficientZnn = %v", readiness.Ready(), readiness.HasSufficientZnn)
+			}
+			if got := readiness.Summary(); got != test.wantSummary {
+				t.Errorf("Summary() = %q, want %q", got, test.wantSummary)
+			}
+			if calls := mock.CallsTo("ledger.getAccountInfoByAddress"); len(calls) != 1 || calls[0].Params[0] != address.String() {
+				t.Errorf("getAccountInfoByAddress calls = %+v", calls)
+			}
+		})
+	}
+}
+
+func TestPillarApi_CheckRegistrationRequirements_RPCError(t *testing.T) {
+	nodeErr := errors.New("node unavailable")
+	mock := rpc_client.NewMockClient().OnError("ledger.getAccountInfoByAddress", nodeErr)
+	if _, err := embedded.NewPillarApi(mock).CheckRegistrationRequirements(types.PillarContract); !errors.Is(err, nodeErr) {
+		t.Errorf("CheckRegistrationRequirements() error = %v, want the node error", err)
+	}
+}
@@ -2,10 +2,13 @@ package embedded
 
 import (
 	"bytes"
+	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common/types"
+	nodeembedded "github.com/zenon-network/go-zenon/rpc/api/embedded"
 	"github.com/zenon-network/go-zenon/vm/embedded/definition"
 )
 
@@ -67,3 +70,49 @@ func TestPillarApi_Revoke_DifferentNamesProduceDifferentEncodings(t *testing.T)
 		t.Error("Revoke encodings should differ when the name differs")
 	}
 }
+
+// TestGetFrontierRewardByPageDecodesEntries decodes a reward history page as
+// go-zenon serializes it, through each contract API that exposes one.
+func TestGetFrontierRewardByPageDecodesEntries(t *testing.T) {
+	large, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	page, err := json.Marshal(&nodeembedded.RewardHistoryList{
+		Count: 3,
+		List: []*nodeembedded.RewardHistoryEntry{
+			{Epoch: 812, Znn: big.NewInt(4370000000), Qsr: big.NewInt(0)},
+			{Epoch: 811, Znn: big.NewInt(0), Qsr: large},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	caller := &sporkListCaller{response: string(page)}
+	address := types.ParseAddressPanic("z1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsggv2f")
+
+	for name, get := range map[string]func() (*RewardHistoryList, error){
+		"pillar": func() (*RewardHistoryList, error) { return NewPillarApi(caller).GetFrontierRewardByPage(address, 0, 2) },
+		"sentinel": func() (*RewardHistoryList, error) {
+			return NewSentinelApi(caller).GetFrontierRewardByPage(address, 0, 2)
+		},
+		"stake": func() (*RewardHistoryList, error) { return NewStakeApi(caller).GetFrontierRewardByPage(address, 0, 2) },
+		"liquidity": func() (*RewardHistoryList, error) {
+			return NewLiquidityApi(caller).GetFrontierRewardByPage(address, 0, 2)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			history, err := get()
+			if err != nil {
+				t.Fatalf("GetFrontierRewardByPage() error = %v", err)
+			}
+			if history.Count != 3 || len(history.List) != 2 {
+				t.Fatalf("history = count %d with %d entries, want 3 and 2", history.Count, len(history.List))
+			}
+			first, second := history.List[0], history.List[1]
+			if first.Epoch != 812 || first.ZnnAmount.Cmp(big.NewInt(4370000000)) != 0 || first.QsrAmount.Sign() != 0 {
+				t.Errorf("first entry = epoch %d, %s ZNN, %s QSR", first.Epoch, first.ZnnAmount, first.QsrAmount)
+			}
+			if second.Epoch != 811 || second.ZnnAmount.Sign() != 0 || second.QsrAmount.Cmp(large) != 0 {
+				t.Errorf("second entry = epoch %d, %s ZNN, %s QSR", second.Epoch, second.ZnnAmount, second.QsrAmount)
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@ package embedded
 import (
 	"encoding/json"
 	"math/big"
+	"strings"
 
+	sdkembedded "github.com/0x3639/znn-sdk-go/embedded"
+	"github.com/0x3639/znn-sdk-go/utils"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
 )
@@ -245,3 +248,56 @@ func (d *DelegationInfo) UnmarshalJSON(data []byte) error {
 func (d *DelegationInfo) IsPillarActive() bool {
 	return d.Status == 1
 }
+
+// RegistrationReadiness reports whether an address can fund a Pillar
+// registration, as returned by PillarApi.CheckRegistrationRequirements.
+//
+// Registration sends RequiredZnn with the Register call and consumes
+// RequiredQsr from QSR deposited in the Pillar contract. QSR still in the
+// account counts towards the requirement because it can be deposited first
+// with DepositQsr.
+//
+// All amounts are in base units (1 ZNN = 10^8). A shortfall is zero when the
+// requirement is met.
+type RegistrationReadiness struct {
+	// ZnnBalance is the account's available ZNN
+	ZnnBalance *big.Int
+	// QsrBalance is the account's available QSR, not yet deposited
+	QsrBalance *big.Int
+	// DepositedQsr is the QSR already deposited in the Pillar contract
+	DepositedQsr *big.Int
+	// RequiredZnn is the ZNN sent with the registration
+	RequiredZnn *big.Int
+	// RequiredQsr is the QSR the registration currently consumes
+	RequiredQsr *big.Int
+	// ZnnShortfall is the ZNN still missing
+	ZnnShortfall *big.Int
+	// QsrShortfall is the QSR still missing, counting balance and deposit
+	QsrShortfall *big.Int
+	// HasSufficientZnn reports whether the ZNN requirement is met
+	HasSufficientZnn bool
+	// HasSufficientQsr reports whether the QSR requirement is met
+	HasSufficientQsr bool
+}
+
+// Ready reports whether both the ZNN and QSR requirements are met.
+func (r *RegistrationReadiness) Ready() bool {
+	return r.HasSufficientZnn && r.HasSufficientQsr
+}
+
+// Summary returns a one-line description of what is missing, suitable for
+// showing to the user, for example "You need 500 more QSR to register a
+// Pillar".
+func (r *RegistrationReadiness) Summary() string {
+	var missing []string
+	if !r.HasSufficientZnn {
+		missing = append(missing, utils.AddDecimals(r.ZnnShortfall, sdkembedded.CoinDecimals)+" more ZNN")
+	}
+	if !r.HasSufficientQsr {
+		missing = append(missing, utils.AddDecimals(r.QsrShortfall, sdkembedded.CoinDecimals)+" more QSR")
+	}
+	if len(missing) == 0 {
+		return "This address can register a Pillar"
+	}
+	return "You need " + strings.Join(missing, " and ") + " to register a Pillar"
+}
@@ -1,13 +1,17 @@
 package embedded
 
 import (
+	"fmt"
 	"math/big"
 
+	sdkembedded "github.com/0x3639/znn-sdk-go/embedded"
 	"github.com/0x3639/znn-sdk-go/internal/rpcvalidation"
+	"github.com/0x3639/znn-sdk-go/pow"
 	"github.com/0x3639/znn-sdk-go/transport"
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common"
 	"github.com/zenon-network/go-zenon/common/types"
+	nodeapi "github.com/zenon-network/go-zenon/rpc/api"
 	"github.com/zenon-network/go-zenon/vm/embedded/definition"
 )
 
@@ -55,6 +59,33 @@ func (pa *PlasmaApi) Get(address types.Address) (*PlasmaInfo, error) {
 	return ans, nil
 }
 
+// GetEntriesByAddress lists the plasma fusions funded by an address.
+//
+// Each entry carries the fusion id needed by Cancel, the beneficiary that
+// receives the plasma, the fused QSR amount, and the momentum height after
+// which the fusion may be cancelled.
+//
+// Parameters:
+//   - address: Address that fused the QSR
+//   - pageIndex: Zero-based page number
+//   - pageSize: Entries per page (maximum 1024)
+//
+// Returns the page of entries, with the address's total fused QSR and entry
+// count, or an error if pageSize is too large or the RPC fails.
+//
+// Example:
+//
+//	entries, err := client.PlasmaApi.GetEntriesByAddress(address, 0, 10)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	frontier, _ := client.LedgerApi.GetFrontierMomentum()
+//	for _, entry := range entries.List {
+//	    if frontier.Height >= entry.ExpirationHeight {
+//	        template := client.PlasmaApi.Cancel(entry.Id)
+//	        // Sign and publish to reclaim entry.QsrAmount
+//	    }
+//	}
 func (pa *PlasmaApi) GetEntriesByAddress(address types.Address, pageIndex, pageSize uint32) (*FusionEntryList, error) {
 	if err := rpcvalidation.ValidateLimit("embedded.plasma.getEntriesByAddress", "pageSize", uint64(pageSize), rpcvalidation.MaxPageSize); err != nil {
 		return nil, err
@@ -76,7 +107,122 @@ func (pa *PlasmaApi) GetPlasmaByQsr(qsrAmount *big.Int) *big.Int {
 	if qsrAmount == nil {
 		return big.NewInt(0)
 	}
-	return new(big.Int).Mul(qsrAmount, big.NewInt(2100))
+	return new(big.Int).Mul(qsrAmount, big.NewInt(sdkembedded.PlasmaPerFusionUnit))
+}
+
+// QsrToPlasma returns the plasma an account receives for a fused QSR amount.
+//
+// It applies the protocol's conversion: the amount is split into whole
+// fusion units of sdkembedded.CostPerFusionUnit (1 QSR), each worth
+// sdkembedded.PlasmaPerFusionUnit plasma, and the total is capped at
+// sdkembedded.MaxFusionPlasmaForAccount. Unlike GetPlasmaByQsr, qsr is in base
+// units. Pure local helper — no RPC call.
+//
+// Parameters:
+//   - qsr: Total QSR fused for the account, in base units (1 QSR = 10^8)
+//
+// Returns the plasma, or 0 for a nil or non-positive amount.
+//
+// Example:
+//
+//	plasma := client.PlasmaApi.QsrToPlasma(big.NewInt(10 * sdkembedded.OneQsr)) // 21000
+func (pa *PlasmaApi) QsrToPlasma(qsr *big.Int) uint64 {
+	if qsr == nil || qsr.Sign() <= 0 {
+		return 0
+	}
+	units := new(big.Int).Quo(qsr, big.NewInt(sdkembedded.CostPerFusionUnit))
+	if units.Cmp(big.NewInt(sdkembedded.MaxFusionUnitsPerAccount)) >= 0 {
+		return sdkembedded.MaxFusionPlasmaForAccount
+	}
+	return units.Uint64() * sdkembedded.PlasmaPerFusionUnit
+}
+
+// PlasmaToQsr returns the smallest QSR amount whose fusion gives at least the
+// requested plasma.
+//
+// The result is a whole number of fusion units, so QsrToPlasma of it is
+// never less than plasma. Targets above sdkembedded.MaxFusionPlasmaForAccount
+// cannot be reached by fusion and return the amount for that maximum. Pure
+// local helper — no RPC call.
+//
+// Parameters:
+//   - plasma: Target plasma for the account
+//
+// Returns the QSR amount in base units. A single Fuse call needs at least
+// sdkembedded.FuseMinQsrAmount, so raise smaller results to that minimum when
+// recommending a fuse amount.
+//
+// Example:
+//
+//	qsr := client.PlasmaApi.PlasmaToQsr(3 * 21000) // 30 QSR
+//	if qsr.Cmp(sdkembedded.FuseMinQsrAmount) < 0 {
+//	    qsr = new(big.Int).Set(sdkembedded.FuseMinQsrAmount)
+//	}
+//	template := client.PlasmaApi.Fuse(address, qsr)
+func (pa *PlasmaApi) PlasmaToQsr(plasma uint64) *big.Int {
+	plasma = min(plasma, sdkembedded.MaxFusionPlasmaForAccount)
+	units := (plasma + sdkembedded.PlasmaPerFusionUnit - 1) / sdkembedded.PlasmaPerFusionUnit
+	return new(big.Int).Mul(new(big.Int).SetUint64(units), big.NewInt(sdkembedded.CostPerFusionUnit))
+}
+
+// EstimateReceiveCost estimates the plasma needed to receive an address's
+// pending blocks.
+//
+// It lists unreceived blocks page by page, up to maxBlocks of them, and sums
+// pow.PlasmaForAccountBlock over a receive block for each one. The result is
+// the base plasma the receives consume; when the account's available plasma
+// (see Get) is lower, the shortfall must be covered by fusing more QSR or by
+// generating PoW.
+//
+// Parameters:
+//   - address: Account whose pending blocks would be received
+//   - maxBlocks: Most blocks to consider; must be positive
+//
+// Returns the total plasma estimate, how many blocks it covers, or an error
+// when maxBlocks is not positive or listing fails. blocksConsidered is less
+// than maxBlocks when fewer blocks are pending, and never more than the 500
+// blocks a node lists for one address.
+//
+// Example:
+//
+//	cost, n, err := client.PlasmaApi.EstimateReceiveCost(address, 50)
+//	if err != nil {
+//	    return err
+//	}
+//	info, err := client.PlasmaApi.Get(address)
+//	if err != nil {
+//	    return err
+//	}
+//	if info.CurrentPlasma < cost {
+//	    fmt.Printf("Receiving %d blocks needs ~%d plasma; fuse %s more QSR\n",
+//	        n, cost, utils.AddDecimals(client.PlasmaApi.PlasmaToQsr(cost-info.CurrentPlasma), 8))
+//	}
+//
+// Note: Blocks sent to the account after the list is read are not included.
+func (pa *PlasmaApi) EstimateReceiveCost(address types.Address, maxBlocks int) (totalPlasma uint64, blocksConsidered int, err error) {
+	if maxBlocks <= 0 {
+		return 0, 0, fmt.Errorf("maxBlocks must be positive, got %d", maxBlocks)
+	}
+
+	pending, _, err := rpcvalidation.CollectUnreceived(func(pageIndex, pageSize uint32) (*nodeapi.AccountBlockList, error) {
+		page := new(nodeapi.AccountBlockList)
+		if err := pa.client.Call(page, "ledger.getUnreceivedBlocksByAddress", address.String(), pageIndex, pageSize); err != nil {
+			return nil, err
+		}
+		return page, nil
+	}, maxBlocks)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, block := range pending {
+		receive := &nom.AccountBlock{
+			BlockType:     nom.BlockTypeUserReceive,
+			Address:       address,
+			FromBlockHash: block.Hash,
+		}
+		totalPlasma += pow.PlasmaForAccountBlock(receive)
+	}
+	return totalPlasma, len(pending), nil
 }
 
 // GetRequiredPoWForAccountBlock calculates the PoW difficulty required for a transaction
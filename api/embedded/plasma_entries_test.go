@@ -0,0 +1,81 @@
+package embedded_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/api/embedded"
+	"github.com/0x3639/znn-sdk-go/rpc_client"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+func TestPlasmaApi_GetEntriesByAddressDecodesFusionEntries(t *testing.T) {
+	address := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	mock := rpc_client.NewMockClient().On("embedded.plasma.getEntriesByAddress", json.RawMessage(`{
+		"qsrAmount": "15000000000",
+		"count": 2,
+		"list": [
+			{
+				"qsrAmount": "10000000000",
+				"beneficiary": "z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7",
+				"expirationHeight": 1234567,
+				"id": "0101010101010101010101010101010101010101010101010101010101010101"
+			},
+			{
+				"qsrAmount": "5000000000",
+				"beneficiary": "z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz",
+				"expirationHeight": 1240000,
+				"id": "0202020202020202020202020202020202020202020202020202020202020202"
+			}
+		]
+	}`))
+
+	entries, err := embedded.NewPlasmaApi(mock).GetEntriesByAddress(address, 0, 10)
+	if err != nil {
+		t.Fatalf("GetEntriesByAddress() error = %v", err)
+	}
+	if entries.Count != 2 || len(entries.List) != 2 || entries.QsrAmount.Cmp(big.NewInt(15000000000)) != 0 {
+		t.Fatalf("entries = count %d, %d listed, total %s", entries.Count, len(entries.List), entries.QsrAmount)
+	}
+	second := entries.List[1]
+	if second.Id != types.HexToHashPanic("0202020202020202020202020202020202020202020202020202020202020202") {
+		t.Errorf("Id = %s", second.Id)
+	}
+	if second.Beneficiary.String() != "z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz" {
+		t.Errorf("Beneficiary = %s", second.Beneficiary)
+	}
+	if second.QsrAmount.Cmp(big.NewInt(5000000000)) != 0 {
+		t.Errorf("QsrAmount = %s, want 5000000000", second.QsrAmount)
+	}
+	if second.ExpirationHeight != 1240000 {
+		t.Errorf("ExpirationHeight = %d, want 1240000", second.ExpirationHeight)
+	}
+
+	calls := mock.CallsTo("embedded.plasma.getEntriesByAddress")
+	if len(calls) != 1 || calls[0].Params[0] != address.String() {
+		t.Errorf("calls = %+v", calls)
+	}
+	if _, err := embedded.NewPlasmaApi(mock).GetEntriesByAddress(address, 0, 1025); err == nil {
+		t.Error("GetEntriesByAddress() expected an error for an oversized page")
+	}
+}
+
+func TestPlasmaApi_CancelUsesCancelFuseSelector(t *testing.T) {
+	id := types.HexToHashPanic("0101010101010101010101010101010101010101010101010101010101010101")
+	block := embedded.NewPlasmaApi(nil).Cancel(id)
+
+	want := definition.ABIPlasma.PackMethodPanic(definition.CancelFuseMethodName, id)
+	if !bytes.Equal(block.Data, want) {
+		t.Fatalf("Data = %x, want %x", block.Data, want)
+	}
+	selector := definition.ABIPlasma.Methods[definition.CancelFuseMethodName].Id()
+	if !bytes.Equal(block.Data[:4], selector) {
+		t.Errorf("selector = %x, want CancelFuse %x", block.Data[:4], selector)
+	}
+	if block.ToAddress != types.PlasmaContract || block.Amount.Sign() != 0 {
+		t.Errorf("Cancel() = to %s amount %s, want plasma contract and zero", block.ToAddress, block.Amount)
+	}
+}
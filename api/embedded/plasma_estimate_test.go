@@ -0,0 +1,100 @@
+package embedded_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/api/embedded"
+	sdkembedded "github.com/0x3639/znn-sdk-go/embedded"
+	"github.com/0x3639/znn-sdk-go/rpc_client"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	nodeapi "github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// unreceivedMailbox answers ledger.getUnreceivedBlocksByAddress the way a node
+// does for an address with pending send blocks: every page is cut from one list
+// of at most 500 blocks, Count is that list's length, More is only set once the
+// mailbox holds 500, and page indexes of 10 or more are rejected.
+func unreceivedMailbox(pending int) rpc_client.MockHandler {
+	listed := min(pending, 500)
+	return func(params []interface{}) (interface{}, error) {
+		pageIndex, pageSize := int(params[1].(uint32)), int(params[2].(uint32))
+		if pageIndex >= 10 {
+			return nil, errors.New("page index param too big")
+		}
+		page := &nodeapi.AccountBlockList{Count: listed, More: pending >= 500}
+		for i := min(pageIndex*pageSize, listed); i < min((pageIndex+1)*pageSize, listed); i++ {
+			page.List = append(page.List, &nodeapi.AccountBlock{AccountBlock: nom.AccountBlock{
+				BlockType:     nom.BlockTypeUserSend,
+				Hash:          types.HexToHashPanic(fmt.Sprintf("%064x", i+1)),
+				TokenStandard: types.ZnnTokenStandard,
+				Amount:        big.NewInt(1),
+			}})
+		}
+		return page, nil
+	}
+}
+
+func TestPlasmaApi_EstimateReceiveCost(t *testing.T) {
+	address := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	tests := []struct {
+		name      string
+		pending   int
+		maxBlocks int
+		wantCount int
+		wantPages int
+	}{
+		{name: "nothing pending", pending: 0, maxBlocks: 50, wantCount: 0, wantPages: 1},
+		{name: "all on one page", pending: 3, maxBlocks: 50, wantCount: 3, wantPages: 1},
+		{name: "across pages", pending: 70, maxBlocks: 100, wantCount: 70, wantPages: 2},
+		{name: "capped", pending: 120, maxBlocks: 60, wantCount: 60, wantPages: 2},
+		{name: "cap on page boundary", pending: 120, maxBlocks: 50, wantCount: 50, wantPages: 1},
+		{name: "full page ends the list", pending: 100, maxBlocks: 200, wantCount: 100, wantPages: 2},
+		{name: "more than the node lists", pending: 800, maxBlocks: 1000, wantCount: 500, wantPages: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := rpc_client.NewMockClient().OnFunc("ledger.getUnreceivedBlocksByAddress", unreceivedMailbox(tt.pending))
+
+			total, considered, err := embedded.NewPlasmaApi(mock).EstimateReceiveCost(address, tt.maxBlocks)
+			if err != nil {
+				t.Fatalf("EstimateReceiveCost() error = %v", err)
+			}
+			if considered != tt.wantCount {
+				t.Errorf("blocksConsidered = %d, want %d", considered, tt.wantCount)
+			}
+			if want := uint64(tt.wantCount) * sdkembedded.AccountBlockBasePlasma; total != want {
+				t.Errorf("totalPlasma = %d, want %d", total, want)
+			}
+			calls := mock.CallsTo("ledger.getUnreceivedBlocksByAddress")
+			if len(calls) != tt.wantPages {
+				t.Fatalf("pages fetched = %d, want %d", len(calls), tt.wantPages)
+			}
+			if calls[0].Params[0] != address.String() {
+				t.Errorf("address param = %v, want %s", calls[0].Params[0], address)
+			}
+		})
+	}
+}
+
+func TestPlasmaApi_EstimateReceiveCostErrors(t *testing.T) {
+	address := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	mock := rpc_client.NewMockClient()
+	for _, maxBlocks := range []int{0, -1} {
+		if _, _, err := embedded.NewPlasmaApi(mock).EstimateReceiveCost(address, maxBlocks); err == nil {
+			t.Errorf("EstimateReceiveCost(maxBlocks=%d) expected an error", maxBlocks)
+		}
+	}
+	if len(mock.Calls()) != 0 {
+		t.Errorf("invalid maxBlocks reached the node %d times", len(mock.Calls()))
+	}
+
+	nodeErr := errors.New("node unavailable")
+	failing := rpc_client.NewMockClient().OnError("ledger.getUnreceivedBlocksByAddress", nodeErr)
+	if _, _, err := embedded.NewPlasmaApi(failing).EstimateReceiveCost(address, 10); !errors.Is(err, nodeErr) {
+		t.Errorf("EstimateReceiveCost() error = %v, want the listing error", err)
+	}
+}
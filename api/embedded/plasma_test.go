@@ -3,6 +3,8 @@ package embedded
 import (
 	"math/big"
 	"testing"
+
+	sdkembedded "github.com/0x3639/znn-sdk-go/embedded"
 )
 
 func TestPlasmaApi_GetPlasmaByQsr(t *testing.T) {
@@ -28,3 +30,58 @@ func TestPlasmaApi_GetPlasmaByQsr(t *testing.T) {
 		})
 	}
 }
+
+func TestPlasmaApi_QsrToPlasma(t *testing.T) {
+	api := NewPlasmaApi(nil)
+	oneQsr := big.NewInt(sdkembedded.OneQsr)
+
+	tests := []struct {
+		name string
+		qsr  *big.Int
+		want uint64
+	}{
+		{name: "nil", qsr: nil, want: 0},
+		{name: "negative", qsr: big.NewInt(-1), want: 0},
+		{name: "below one unit", qsr: big.NewInt(sdkembedded.OneQsr - 1), want: 0},
+		{name: "1 QSR", qsr: oneQsr, want: 2100},
+		{name: "minimum fuse", qsr: sdkembedded.FuseMinQsrAmount, want: 21000},
+		{name: "partial unit rounds down", qsr: big.NewInt(10*sdkembedded.OneQsr + sdkembedded.OneQsr/2), want: 21000},
+		{name: "account maximum", qsr: new(big.Int).Mul(oneQsr, big.NewInt(5000)), want: 10_500_000},
+		{name: "above account maximum", qsr: new(big.Int).Mul(oneQsr, big.NewInt(1_000_000)), want: 10_500_000},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := api.QsrToPlasma(tc.qsr); got != tc.want {
+				t.Errorf("QsrToPlasma(%v) = %d, want %d", tc.qsr, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlasmaApi_PlasmaToQsr(t *testing.T) {
+	api := NewPlasmaApi(nil)
+
+	tests := []struct {
+		name   string
+		plasma uint64
+		want   int64
+	}{
+		{name: "zero", plasma: 0, want: 0},
+		{name: "one plasma needs a whole unit", plasma: 1, want: 1 * sdkembedded.OneQsr},
+		{name: "exact unit", plasma: 2100, want: 1 * sdkembedded.OneQsr},
+		{name: "one base block", plasma: 21000, want: 10 * sdkembedded.OneQsr},
+		{name: "rounds up", plasma: 21001, want: 11 * sdkembedded.OneQsr},
+		{name: "capped at account maximum", plasma: 20_000_000, want: 5000 * sdkembedded.OneQsr},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := api.PlasmaToQsr(tc.plasma)
+			if got.Cmp(big.NewInt(tc.want)) != 0 {
+				t.Fatalf("PlasmaToQsr(%d) = %s, want %d", tc.plasma, got, tc.want)
+			}
+			if tc.plasma <= sdkembedded.MaxFusionPlasmaForAccount && api.QsrToPlasma(got) < tc.plasma {
+				t.Errorf("QsrToPlasma(PlasmaToQsr(%d)) = %d, want at least %d", tc.plasma, api.QsrToPlasma(got), tc.plasma)
+			}
+		})
+	}
+}
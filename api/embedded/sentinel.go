@@ -1,8 +1,10 @@
 package embedded
 
 import (
+	"fmt"
 	"math/big"
 
+	sdkembedded "github.com/0x3639/znn-sdk-go/embedded"
 	"github.com/0x3639/znn-sdk-go/internal/rpcvalidation"
 	"github.com/0x3639/znn-sdk-go/transport"
 	"github.com/zenon-network/go-zenon/chain/nom"
@@ -22,6 +24,20 @@ func NewSentinelApi(client transport.Caller) *SentinelApi {
 	}
 }
 
+// GetByOwner retrieves the Sentinel registered by an address.
+//
+// Parameters:
+//   - address: Owner address of the Sentinel
+//
+// Returns the Sentinel's registration details, or an error.
+//
+// Example:
+//
+//	sentinel, err := client.SentinelApi.GetByOwner(address)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Active: %t, revocable: %t\n", sentinel.Active, sentinel.IsRevocable)
 func (sa *SentinelApi) GetByOwner(address types.Address) (*SentinelInfo, error) {
 	ans := new(SentinelInfo)
 	if err := sa.client.Call(ans, "embedded.sentinel.getByOwner", address.String()); err != nil {
@@ -30,6 +46,21 @@ func (sa *SentinelApi) GetByOwner(address types.Address) (*SentinelInfo, error)
 	return ans, nil
 }
 
+// GetAllActive retrieves a paginated list of active Sentinels.
+//
+// Parameters:
+//   - pageIndex: Page number (0-indexed)
+//   - pageSize: Number of Sentinels per page (maximum 1024)
+//
+// Returns the Sentinel list or an error.
+//
+// Example:
+//
+//	sentinels, err := client.SentinelApi.GetAllActive(0, 50)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Active sentinels: %d\n", sentinels.Count)
 func (sa *SentinelApi) GetAllActive(pageIndex, pageSize uint32) (*SentinelInfoList, error) {
 	if err := rpcvalidation.ValidateLimit("embedded.sentinel.getAllActive", "pageSize", uint64(pageSize), rpcvalidation.MaxPageSize); err != nil {
 		return nil, err
@@ -57,6 +88,15 @@ func (sa *SentinelApi) GetUncollectedReward(address types.Address) (*Uncollected
 	return ans, nil
 }
 
+// GetFrontierRewardByPage retrieves a paginated history of an address's
+// Sentinel rewards, one entry per epoch.
+//
+// Parameters:
+//   - address: Sentinel owner address
+//   - pageIndex: Page number (0-indexed)
+//   - pageSize: Number of entries per page (at most 1024)
+//
+// Returns paginated reward history or an error.
 func (sa *SentinelApi) GetFrontierRewardByPage(address types.Address, pageIndex, pageSize uint32) (*RewardHistoryList, error) {
 	if err := rpcvalidation.ValidateLimit("embedded.sentinel.getFrontierRewardByPage", "pageSize", uint64(pageSize), rpcvalidation.MaxPageSize); err != nil {
 		return nil, err
@@ -103,6 +143,56 @@ func (sa *SentinelApi) Register() *nom.AccountBlock {
 	}
 }
 
+// ValidateRegistration checks locally that an account can fund a Sentinel
+// registration before Register is built and published.
+//
+// Registration sends SentinelRegisterZnnAmount ZNN with the Register call and
+// consumes SentinelRegisterQsrAmount QSR previously deposited with DepositQsr.
+//
+// Parameters:
+//   - znnBalance: The account's available ZNN balance
+//   - depositedQsr: QSR already deposited in the Sentinel contract (see
+//     GetDepositedQsr)
+//
+// Returns nil when both amounts meet the requirements, or an error naming the
+// shortfall.
+//
+// Example:
+//
+//	deposited, err := client.SentinelApi.GetDepositedQsr(address)
+//	if err != nil {
+//	    return err
+//	}
+//	if err := client.SentinelApi.ValidateRegistration(info.ZnnBalance(), deposited); err != nil {
+//	    return err
+//	}
+//	template := client.SentinelApi.Register()
+func (sa *SentinelApi) ValidateRegistration(znnBalance, depositedQsr *big.Int) error {
+	if znnBalance == nil || znnBalance.Cmp(sdkembedded.SentinelRegisterZnnAmount) < 0 {
+		return fmt.Errorf("sentinel registration requires %s ZNN base units, have %s",
+			sdkembedded.SentinelRegisterZnnAmount, amountOrZero(znnBalance))
+	}
+	if depositedQsr == nil || depositedQsr.Cmp(sdkembedded.SentinelRegisterQsrAmount) < 0 {
+		return fmt.Errorf("sentinel registration requires %s deposited QSR base units, have %s",
+			sdkembedded.SentinelRegisterQsrAmount, amountOrZero(depositedQsr))
+	}
+	return nil
+}
+
+func amountOrZero(amount *big.Int) *big.Int {
+	if amount == nil {
+		return common.Big0
+	}
+	return amount
+}
+
+// Revoke creates a transaction template that revokes the caller's Sentinel.
+//
+// Revocation is only possible while GetByOwner reports IsRevocable. The ZNN
+// collateral is returned to the owner and the QSR can then be withdrawn with
+// WithdrawQsr.
+//
+// Returns an unsigned AccountBlock template ready for signing and publishing.
 func (sa *SentinelApi) Revoke() *nom.AccountBlock {
 	return &nom.AccountBlock{
 		BlockType:     nom.BlockTypeUserSend,
@@ -133,6 +223,10 @@ func (sa *SentinelApi) WithdrawQsr() *nom.AccountBlock {
 	}
 }
 
+// CollectReward creates a transaction template that collects the Sentinel's
+// uncollected ZNN and QSR rewards (see GetUncollectedReward).
+//
+// Returns an unsigned AccountBlock template ready for signing and publishing.
 func (sa *SentinelApi) CollectReward() *nom.AccountBlock {
 	return &nom.AccountBlock{
 		BlockType:     nom.BlockTypeUserSend,
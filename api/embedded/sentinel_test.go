@@ -0,0 +1,86 @@
+package embedded
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdkembedded "github.com/0x3639/znn-sdk-go/embedded"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/constants"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+func TestSentinelRegisterConstantsMatchProtocol(t *testing.T) {
+	if sdkembedded.SentinelRegisterZnnAmount.Cmp(constants.SentinelZnnRegisterAmount) != 0 {
+		t.Errorf("SentinelRegisterZnnAmount = %s, protocol requires %s", sdkembedded.SentinelRegisterZnnAmount, constants.SentinelZnnRegisterAmount)
+	}
+	if sdkembedded.SentinelRegisterQsrAmount.Cmp(constants.SentinelQsrDepositAmount) != 0 {
+		t.Errorf("SentinelRegisterQsrAmount = %s, protocol requires %s", sdkembedded.SentinelRegisterQsrAmount, constants.SentinelQsrDepositAmount)
+	}
+}
+
+func TestSentinelApi_ValidateRegistration(t *testing.T) {
+	api := NewSentinelApi(nil)
+	znn := sdkembedded.SentinelRegisterZnnAmount
+	qsr := sdkembedded.SentinelRegisterQsrAmount
+	less := func(amount *big.Int) *big.Int { return new(big.Int).Sub(amount, big.NewInt(1)) }
+
+	tests := []struct {
+		name      string
+		znn, qsr  *big.Int
+		wantError string
+	}{
+		{name: "exact requirements", znn: znn, qsr: qsr},
+		{name: "surplus", znn: new(big.Int).Mul(znn, big.NewInt(2)), qsr: new(big.Int).Mul(qsr, big.NewInt(2))},
+		{name: "short ZNN", znn: less(znn), qsr: qsr, wantError: "ZNN"},
+		{name: "short QSR", znn: znn, qsr: less(qsr), wantError: "deposited QSR"},
+		{name: "nil balance", znn: nil, qsr: qsr, wantError: "have 0"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := api.ValidateRegistration(test.znn, test.qsr)
+			if test.wantError == "" {
+				if err != nil {
+					t.Fatalf("ValidateRegistration() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantError) {
+				t.Fatalf("ValidateRegistration() error = %v, want substring %q", err, test.wantError)
+			}
+		})
+	}
+}
+
+func TestSentinelApi_Templates(t *testing.T) {
+	api := NewSentinelApi(nil)
+
+	register := api.Register()
+	if register.ToAddress != types.SentinelContract || register.TokenStandard != types.ZnnTokenStandard {
+		t.Errorf("Register() routing = %s %s", register.ToAddress, register.TokenStandard)
+	}
+	if register.Amount.Cmp(sdkembedded.SentinelRegisterZnnAmount) != 0 {
+		t.Errorf("Register() amount = %s, want %s", register.Amount, sdkembedded.SentinelRegisterZnnAmount)
+	}
+
+	tests := []struct {
+		name   string
+		data   []byte
+		method string
+	}{
+		{"register", register.Data, definition.RegisterSentinelMethodName},
+		{"revoke", api.Revoke().Data, definition.RevokeSentinelMethodName},
+		{"collect reward", api.CollectReward().Data, definition.CollectRewardMethodName},
+	}
+	for _, test := range tests {
+		want := definition.ABISentinel.PackMethodPanic(test.method)
+		if !bytes.Equal(test.data, want) {
+			t.Errorf("%s data = %x, want %x", test.name, test.data, want)
+		}
+	}
+	if amount := api.CollectReward().Amount; amount.Sign() != 0 {
+		t.Errorf("CollectReward() amount = %s, want 0", amount)
+	}
+}
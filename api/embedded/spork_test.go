@@ -2,6 +2,8 @@ package embedded
 
 import (
 	"bytes"
+	"encoding/hex"
+	"encoding/json"
 	"testing"
 
 	"github.com/zenon-network/go-zenon/chain/nom"
@@ -75,3 +77,51 @@ func TestSporkApi_ActivateSpork(t *testing.T) {
 		t.Errorf("decoded id = %s, want %s", decoded.Id.String(), id.String())
 	}
 }
+
+// TestSporkApi_ActivateSporkSelector pins the call data to the known selector
+// of ActivateSpork(hash), the first four bytes of its SHA3-256 signature hash.
+func TestSporkApi_ActivateSporkSelector(t *testing.T) {
+	id := types.HexToHashPanic("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+	data := NewSporkApi(nil).ActivateSpork(id).Data
+
+	if len(data) != 4+32 {
+		t.Fatalf("len(Data) = %d, want 36", len(data))
+	}
+	if got := hex.EncodeToString(data[:4]); got != "25c54e96" {
+		t.Errorf("selector = %s, want 25c54e96", got)
+	}
+	if !bytes.Equal(data[4:], id.Bytes()) {
+		t.Errorf("argument = %x, want %x", data[4:], id.Bytes())
+	}
+}
+
+type sporkListCaller struct {
+	response string
+}
+
+func (c *sporkListCaller) Call(result interface{}, _ string, _ ...interface{}) error {
+	return json.Unmarshal([]byte(c.response), result)
+}
+
+func TestSporkApi_GetAllDecodesSporks(t *testing.T) {
+	caller := &sporkListCaller{response: `{"count":2,"list":[
+		{"id":"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef","name":"htlc","description":"Enables HTLCs","activated":true,"enforcementHeight":1200},
+		{"id":"fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210","name":"bridge","description":"Enables the bridge","activated":false,"enforcementHeight":0}
+	]}`}
+
+	sporks, err := NewSporkApi(caller).GetAll(0, 10)
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+	if sporks.Count != 2 || len(sporks.List) != 2 {
+		t.Fatalf("GetAll() = %+v", sporks)
+	}
+	first := sporks.List[0]
+	if first.Id != types.HexToHashPanic("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef") ||
+		first.Name != "htlc" || !first.Activated || first.EnforcementHeight != 1200 {
+		t.Errorf("first spork = %+v", first)
+	}
+	if second := sporks.List[1]; second.Name != "bridge" || second.Activated || second.EnforcementHeight != 0 {
+		t.Errorf("second spork = %+v", second)
+	}
+}
@@ -1,8 +1,10 @@
 package embedded
 
 import (
+	"fmt"
 	"math/big"
 
+	sdkembedded "github.com/0x3639/znn-sdk-go/embedded"
 	"github.com/0x3639/znn-sdk-go/internal/rpcvalidation"
 	"github.com/0x3639/znn-sdk-go/transport"
 	"github.com/zenon-network/go-zenon/chain/nom"
@@ -67,10 +69,7 @@ func (sa *StakeApi) GetUncollectedReward(address types.Address) (*UncollectedRew
 //   - Analyzing staking performance
 //   - Generating reward reports
 //
-// Each entry includes:
-//   - ZNN and QSR amounts collected
-//   - Timestamp of collection
-//   - Momentum height when collected
+// Each entry includes the epoch and the ZNN and QSR rewarded in it.
 //
 // Parameters:
 //   - address: Address to query reward history for
@@ -88,8 +87,8 @@ func (sa *StakeApi) GetUncollectedReward(address types.Address) (*UncollectedRew
 //
 //	fmt.Printf("Total reward collections: %d\n", history.Count)
 //	for _, entry := range history.List {
-//	    fmt.Printf("Collected: %s ZNN, %s QSR at momentum %d\n",
-//	        entry.Znn, entry.Qsr, entry.Height)
+//	    fmt.Printf("Epoch %d: %s ZNN, %s QSR\n",
+//	        entry.Epoch, entry.ZnnAmount, entry.QsrAmount)
 //	}
 func (sa *StakeApi) GetFrontierRewardByPage(address types.Address, pageIndex, pageSize uint32) (*RewardHistoryList, error) {
 	if err := rpcvalidation.ValidateLimit("embedded.stake.getFrontierRewardByPage", "pageSize", uint64(pageSize), rpcvalidation.MaxPageSize); err != nil {
@@ -158,12 +157,12 @@ func (sa *StakeApi) GetEntriesByAddress(address types.Address, pageIndex, pageSi
 // you earn both ZNN and QSR rewards proportional to the amount and duration.
 //
 // Staking parameters:
-//   - Minimum amount: 1 ZNN (10^8 base units)
-//   - Duration options (in seconds):
+//   - Minimum amount: 1 ZNN (10^8 base units, embedded.StakeMinZnnAmount)
+//   - Duration: a whole number of 30-day months from 1 to 12, in seconds:
 //   - 1 month: 2592000 (30 days)
 //   - 3 months: 7776000 (90 days)
 //   - 6 months: 15552000 (180 days)
-//   - 12 months: 31536000 (365 days)
+//   - 12 months: 31104000 (360 days)
 //   - Longer durations = higher rewards
 //   - Can stake multiple times with different durations
 //
@@ -177,41 +176,53 @@ func (sa *StakeApi) GetEntriesByAddress(address types.Address, pageIndex, pageSi
 //   - durationInSec: Stake duration in seconds (must match valid options above)
 //   - amount: Amount of ZNN to stake (in base units: 1 ZNN = 10^8)
 //
-// Returns an unsigned AccountBlock template ready for processing.
+// Returns an unsigned AccountBlock template ready for processing, or an error
+// describing the problem when the duration or amount would be rejected by the
+// stake contract. Both are checked locally with embedded.ValidateStakeDuration
+// and embedded.ValidateStakeAmount.
 //
 // Example - Stake for 1 month:
 //
 //	amount := big.NewInt(100 * 100000000)  // Stake 100 ZNN
 //	duration := int64(2592000)             // 1 month in seconds
 //
-//	template := client.StakeApi.Stake(duration, amount)
+//	template, err := client.StakeApi.Stake(duration, amount)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
 //	// Sign and publish transaction
 //
 // Example - Stake for maximum rewards (12 months):
 //
 //	amount := big.NewInt(1000 * 100000000) // Stake 1000 ZNN
-//	duration := int64(31536000)            // 12 months = highest rewards
+//	duration := int64(31104000)            // 12 months = highest rewards
 //
-//	template := client.StakeApi.Stake(duration, amount)
+//	template, err := client.StakeApi.Stake(duration, amount)
 //	// Process through transaction pipeline
 //
 // Example - Multiple stake entries:
 //
 //	// Diversify by creating multiple stakes with different durations
-//	stake1 := client.StakeApi.Stake(2592000, big.NewInt(100*100000000))  // 1 month
-//	stake2 := client.StakeApi.Stake(15552000, big.NewInt(500*100000000)) // 6 months
+//	stake1, _ := client.StakeApi.Stake(2592000, big.NewInt(100*100000000))  // 1 month
+//	stake2, _ := client.StakeApi.Stake(15552000, big.NewInt(500*100000000)) // 6 months
 //	// Each creates a separate entry with different expiration times
 //
 // Note: Staked ZNN is locked and cannot be withdrawn until the duration expires.
 // Plan your liquidity needs accordingly.
-func (sa *StakeApi) Stake(durationInSec int64, amount *big.Int) *nom.AccountBlock {
+func (sa *StakeApi) Stake(durationInSec int64, amount *big.Int) (*nom.AccountBlock, error) {
+	if err := sdkembedded.ValidateStakeDuration(durationInSec); err != nil {
+		return nil, fmt.Errorf("invalid stake: %w", err)
+	}
+	if err := sdkembedded.ValidateStakeAmount(amount); err != nil {
+		return nil, fmt.Errorf("invalid stake: %w", err)
+	}
 	return &nom.AccountBlock{
 		BlockType:     nom.BlockTypeUserSend,
 		ToAddress:     types.StakeContract,
 		TokenStandard: types.ZnnTokenStandard,
 		Amount:        amount,
 		Data:          definition.ABIStake.PackMethodPanic(definition.StakeMethodName, durationInSec),
-	}
+	}, nil
 }
 
 // Cancel creates a transaction template to cancel an expired stake and reclaim ZNN.
@@ -315,7 +326,7 @@ func (sa *StakeApi) Cancel(id types.Hash) *nom.AccountBlock {
 //	    // Collect rewards
 //	    collectTemplate := client.StakeApi.CollectReward()
 //	    // After collection confirms, restake the ZNN
-//	    stakeTemplate := client.StakeApi.Stake(31536000, rewards.Znn)
+//	    stakeTemplate, err := client.StakeApi.Stake(31104000, rewards.Znn)
 //	}
 //
 // Note: Collection requires a small amount of PoW/plasma. Ensure you have sufficient
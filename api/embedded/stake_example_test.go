@@ -88,7 +88,10 @@ func Example_stakeFor1Month() {
 	amount := big.NewInt(100 * 100000000)
 	duration := int64(2592000) // 30 days in seconds
 
-	template := client.StakeApi.Stake(duration, amount)
+	template, err := client.StakeApi.Stake(duration, amount)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	fmt.Println("1-Month Stake Created")
 	fmt.Printf("Amount: %s ZNN\n", amount)
@@ -114,13 +117,15 @@ func Example_stakeFor12Months() {
 
 	// Stake 1000 ZNN for 12 months (maximum duration)
 	amount := big.NewInt(1000 * 100000000)
-	duration := int64(31536000) // 365 days in seconds
+	duration := int64(31104000) // 12 × 30 days in seconds
 
-	_ = client.StakeApi.Stake(duration, amount)
+	if _, err := client.StakeApi.Stake(duration, amount); err != nil {
+		log.Fatal(err)
+	}
 
 	fmt.Println("12-Month Stake Created")
 	fmt.Printf("Amount: %s ZNN\n", amount)
-	fmt.Printf("Duration: %d seconds (365 days)\n", duration)
+	fmt.Printf("Duration: %d seconds (360 days)\n", duration)
 
 	fmt.Println("\nMaximum rewards strategy:")
 	fmt.Println("- Highest reward multiplier")
@@ -143,17 +148,17 @@ func Example_diversifiedStaking() {
 	fmt.Println("Creating multiple stakes with different durations:")
 
 	// Short-term stake (1 month)
-	_ = client.StakeApi.Stake(2592000, big.NewInt(100*100000000))
+	_, _ = client.StakeApi.Stake(2592000, big.NewInt(100*100000000))
 	fmt.Println("1. Short-term: 100 ZNN for 1 month")
 	fmt.Println("   - Quick liquidity return")
 
 	// Medium-term stake (6 months)
-	_ = client.StakeApi.Stake(15552000, big.NewInt(300*100000000))
+	_, _ = client.StakeApi.Stake(15552000, big.NewInt(300*100000000))
 	fmt.Println("\n2. Medium-term: 300 ZNN for 6 months")
 	fmt.Println("   - Balanced rewards/liquidity")
 
 	// Long-term stake (12 months)
-	_ = client.StakeApi.Stake(31536000, big.NewInt(600*100000000))
+	_, _ = client.StakeApi.Stake(31104000, big.NewInt(600*100000000))
 	fmt.Println("\n3. Long-term: 600 ZNN for 12 months")
 	fmt.Println("   - Maximum rewards")
 
@@ -312,7 +317,7 @@ func Example_compoundRewards() {
 		_ = client.StakeApi.CollectReward()
 
 		fmt.Println("Step 2: Restake collected ZNN")
-		_ = client.StakeApi.Stake(31536000, rewards.ZnnAmount)
+		_, _ = client.StakeApi.Stake(31104000, rewards.ZnnAmount)
 
 		fmt.Println("\nCompounding benefits:")
 		fmt.Println("- Rewards earn rewards")
@@ -347,11 +352,11 @@ func Example_stakingDurationComparison() {
 		{"1 Month", 2592000, 30},
 		{"3 Months", 7776000, 90},
 		{"6 Months", 15552000, 180},
-		{"12 Months", 31536000, 365},
+		{"12 Months", 31104000, 360},
 	}
 
 	for i, d := range durations {
-		_ = client.StakeApi.Stake(d.seconds, amount)
+		_, _ = client.StakeApi.Stake(d.seconds, amount)
 
 		fmt.Printf("%d. %s (%d days)\n", i+1, d.name, d.days)
 		fmt.Printf("   Duration: %d seconds\n", d.seconds)
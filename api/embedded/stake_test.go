@@ -0,0 +1,51 @@
+package embedded
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	sdkembedded "github.com/0x3639/znn-sdk-go/embedded"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/vm/embedded/definition"
+)
+
+func TestStakeApi_StakeValidatesLocally(t *testing.T) {
+	stake := NewStakeApi(nil)
+	oneZnn := big.NewInt(sdkembedded.OneZnn)
+
+	tests := []struct {
+		name     string
+		duration int64
+		amount   *big.Int
+		wantErr  string
+	}{
+		{name: "valid", duration: sdkembedded.StakeTimeMinSec, amount: oneZnn},
+		{name: "amount below minimum", duration: sdkembedded.StakeTimeMinSec, amount: big.NewInt(sdkembedded.OneZnn - 1), wantErr: "stake amount"},
+		{name: "nil amount", duration: sdkembedded.StakeTimeMinSec, amount: nil, wantErr: "stake amount"},
+		{name: "duration too short", duration: 86400, amount: oneZnn, wantErr: "stake duration"},
+		{name: "duration not whole months", duration: 31536000, amount: oneZnn, wantErr: "stake duration"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block, err := stake.Stake(tt.duration, tt.amount)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) || block != nil {
+					t.Fatalf("Stake() = %v, %v; want error mentioning %q", block, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Stake() error = %v", err)
+			}
+			if block.ToAddress != types.StakeContract || block.TokenStandard != types.ZnnTokenStandard || block.Amount.Cmp(tt.amount) != 0 {
+				t.Errorf("Stake() = %+v", block)
+			}
+			want := definition.ABIStake.PackMethodPanic(definition.StakeMethodName, tt.duration)
+			if string(block.Data) != string(want) {
+				t.Errorf("Data = %x, want %x", block.Data, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package embedded
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/0x3639/znn-sdk-go/utils"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// DefaultTokenCacheTTL is how long a TokenCache keeps token metadata when no
+// TTL is given to NewTokenCache.
+const DefaultTokenCacheTTL = time.Hour
+
+type tokenCacheEntry struct {
+	token   *Token
+	expires time.Time
+}
+
+// TokenCache looks up ZTS metadata through TokenApi.GetByZts and keeps it for
+// a fixed time, so amounts can be formatted with each token's own decimals
+// instead of an assumed 8.
+//
+// A TokenCache is safe for concurrent use.
+type TokenCache struct {
+	api *TokenApi
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[types.ZenonTokenStandard]tokenCacheEntry
+}
+
+// NewTokenCache creates a TokenCache backed by tokenApi.
+//
+// Parameters:
+//   - tokenApi: API used to fetch tokens that are not cached
+//   - ttl: How long fetched metadata is reused; zero or negative uses
+//     DefaultTokenCacheTTL
+//
+// Example:
+//
+//	tokens := embedded.NewTokenCache(client.TokenApi, 0)
+func NewTokenCache(tokenApi *TokenApi, ttl time.Duration) *TokenCache {
+	if ttl <= 0 {
+		ttl = DefaultTokenCacheTTL
+	}
+	return &TokenCache{
+		api:     tokenApi,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[types.ZenonTokenStandard]tokenCacheEntry),
+	}
+}
+
+// Get returns the token's metadata, fetching it from the node if it is not
+// cached or its entry has expired.
+//
+// Returns an error if the lookup fails or the node does not know the token.
+// Failed lookups are not cached.
+func (tc *TokenCache) Get(zts types.ZenonTokenStandard) (*Token, error) {
+	tc.mu.Lock()
+	entry, ok := tc.entries[zts]
+	tc.mu.Unlock()
+	if ok && tc.now().Before(entry.expires) {
+		return entry.token, nil
+	}
+
+	token, err := tc.api.GetByZts(zts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token %s: %w", zts, err)
+	}
+	if token == nil || token.TokenStandard != zts {
+		return nil, fmt.Errorf("token %s not found", zts)
+	}
+
+	tc.mu.Lock()
+	tc.entries[zts] = tokenCacheEntry{token: token, expires: tc.now().Add(tc.ttl)}
+	tc.mu.Unlock()
+	return token, nil
+}
+
+// Invalidate drops the cached entry for zts, if any.
+func (tc *TokenCache) Invalidate(zts types.ZenonTokenStandard) {
+	tc.mu.Lock()
+	delete(tc.entries, zts)
+	tc.mu.Unlock()
+}
+
+// FormatTokenAmount formats a base-unit amount using the token's decimals.
+//
+// Parameters:
+//   - zts: Token the amount is denominated in
+//   - raw: Amount in base units
+//
+// Returns the decimal string produced by utils.AddDecimals, or an error if the
+// token cannot be looked up.
+//
+// Example:
+//
+//	// A token with 2 decimals
+//	text, err := tokens.FormatTokenAmount(zts, big.NewInt(12345))
+//	// text == "123.45"
+func (tc *TokenCache) FormatTokenAmount(zts types.ZenonTokenStandard, raw *big.Int) (string, error) {
+	token, err := tc.Get(zts)
+	if err != nil {
+		return "", err
+	}
+	if raw == nil {
+		raw = big.NewInt(0)
+	}
+	return utils.AddDecimals(raw, int(token.Decimals)), nil
+}
+
+// ParseTokenAmount converts a decimal string to base units using the token's
+// decimals, the inverse of FormatTokenAmount.
+//
+// Returns the amount from utils.ExtractDecimals, or an error if the token
+// cannot be looked up or the amount is malformed.
+//
+// Example:
+//
+//	raw, err := tokens.ParseTokenAmount(zts, "123.45")
+func (tc *TokenCache) ParseTokenAmount(zts types.ZenonTokenStandard, amount string) (*big.Int, error) {
+	token, err := tc.Get(zts)
+	if err != nil {
+		return nil, err
+	}
+	return utils.ExtractDecimals(amount, int(token.Decimals))
+}
@@ -0,0 +1,122 @@
+package embedded
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// tokenLookupCaller answers embedded.token.getByZts from a fixed set of tokens
+// and counts the calls it receives.
+type tokenLookupCaller struct {
+	decimals map[string]uint8
+	calls    int
+	err      error
+}
+
+func (c *tokenLookupCaller) Call(result interface{}, _ string, args ...interface{}) error {
+	c.calls++
+	if c.err != nil {
+		return c.err
+	}
+	zts := args[0].(string)
+	decimals, ok := c.decimals[zts]
+	if !ok {
+		return json.Unmarshal([]byte("null"), result)
+	}
+	response := fmt.Sprintf(`{"name":"Test","symbol":"TST","domain":"","totalSupply":"0","decimals":%d,"owner":"z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7","tokenStandard":%q,"maxSupply":"0","isBurnable":false,"isMintable":false,"isUtility":false}`, decimals, zts)
+	return json.Unmarshal([]byte(response), result)
+}
+
+func TestTokenCache_FormatTokenAmountUsesTokenDecimals(t *testing.T) {
+	custom := types.NewZenonTokenStandard([]byte("custom"))
+	caller := &tokenLookupCaller{decimals: map[string]uint8{
+		custom.String():                 2,
+		types.ZnnTokenStandard.String(): 8,
+	}}
+	cache := NewTokenCache(NewTokenApi(caller), 0)
+
+	tests := []struct {
+		zts  types.ZenonTokenStandard
+		raw  *big.Int
+		want string
+	}{
+		{custom, big.NewInt(12345), "123.45"},
+		{types.ZnnTokenStandard, big.NewInt(150000000), "1.5"},
+		{custom, nil, "0"},
+	}
+	for _, tt := range tests {
+		got, err := cache.FormatTokenAmount(tt.zts, tt.raw)
+		if err != nil {
+			t.Fatalf("FormatTokenAmount(%s, %v) error = %v", tt.zts, tt.raw, err)
+		}
+		if got != tt.want {
+			t.Errorf("FormatTokenAmount(%s, %v) = %q, want %q", tt.zts, tt.raw, got, tt.want)
+		}
+	}
+
+	raw, err := cache.ParseTokenAmount(custom, "123.45")
+	if err != nil || raw.Cmp(big.NewInt(12345)) != 0 {
+		t.Errorf("ParseTokenAmount() = %v, %v, want 12345", raw, err)
+	}
+	if caller.calls != 2 {
+		t.Errorf("node calls = %d, want 2 (one per token)", caller.calls)
+	}
+}
+
+func TestTokenCache_ExpiresAndInvalidates(t *testing.T) {
+	caller := &tokenLookupCaller{decimals: map[string]uint8{types.QsrTokenStandard.String(): 8}}
+	cache := NewTokenCache(NewTokenApi(caller), time.Minute)
+	now := time.Unix(1_700_000_000, 0)
+	cache.now = func() time.Time { return now }
+
+	lookup := func() {
+		t.Helper()
+		if _, err := cache.Get(types.QsrTokenStandard); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+
+	lookup()
+	now = now.Add(59 * time.Second)
+	lookup()
+	if caller.calls != 1 {
+		t.Fatalf("calls within TTL = %d, want 1", caller.calls)
+	}
+
+	now = now.Add(time.Second)
+	lookup()
+	if caller.calls != 2 {
+		t.Fatalf("calls after TTL = %d, want 2", caller.calls)
+	}
+
+	cache.Invalidate(types.QsrTokenStandard)
+	lookup()
+	if caller.calls != 3 {
+		t.Errorf("calls after Invalidate = %d, want 3", caller.calls)
+	}
+}
+
+func TestTokenCache_Errors(t *testing.T) {
+	unknown := types.NewZenonTokenStandard([]byte("unknown"))
+	caller := &tokenLookupCaller{}
+	cache := NewTokenCache(NewTokenApi(caller), 0)
+
+	if _, err := cache.FormatTokenAmount(unknown, big.NewInt(1)); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("unknown token error = %v, want not found", err)
+	}
+
+	caller.err = errors.New("connection refused")
+	if _, err := cache.ParseTokenAmount(unknown, "1"); !errors.Is(err, caller.err) {
+		t.Errorf("lookup error = %v, want wrapped %v", err, caller.err)
+	}
+	if caller.calls != 2 {
+		t.Errorf("calls = %d, want 2 (failures are not cached)", caller.calls)
+	}
+}
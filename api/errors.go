@@ -0,0 +1,94 @@
+package api
+
+import (
+	"errors"
+	"strings"
+)
+
+// Node rejection reasons recognized by ClassifyNodeError. Errors returned by
+// PublishRawTransaction match them with errors.Is.
+var (
+	ErrInsufficientBalance     = errors.New("insufficient balance")
+	ErrInsufficientPlasma      = errors.New("insufficient plasma")
+	ErrInvalidSignature        = errors.New("invalid signature")
+	ErrInvalidPublicKey        = errors.New("public key does not match address")
+	ErrInvalidPoW              = errors.New("invalid PoW nonce or difficulty")
+	ErrInvalidBlockHash        = errors.New("block hash does not match contents")
+	ErrAccountHeightMismatch   = errors.New("account height or previous hash mismatch")
+	ErrChainIdentifierMismatch = errors.New("chain identifier mismatch")
+	ErrAlreadyReceived         = errors.New("send block already received")
+)
+
+// nodeErrorPatterns maps lower-case fragments of go-zenon's verifier and VM
+// error messages to the rejection they indicate. More specific fragments come
+// first.
+var nodeErrorPatterns = []struct {
+	fragment string
+	reason   error
+}{
+	{"insufficient balance", ErrInsufficientBalance},
+	{"not enough plasma", ErrInsufficientPlasma},
+	{"not enough totalplasma", ErrInsufficientPlasma},
+	{"plasma limit for account-block reached", ErrInsufficientPlasma},
+	{"signature is invalid", ErrInvalidSignature},
+	{"signature is missing", ErrInvalidSignature},
+	{"invalid signature", ErrInvalidSignature},
+	{"publickey doesn't correspond to the address", ErrInvalidPublicKey},
+	{"nonce/difficulty is invalid", ErrInvalidPoW},
+	{"hash is different than the one computed", ErrInvalidBlockHash},
+	{"prevheight is cemented but has different hash", ErrAccountHeightMismatch},
+	{"prevhash exists but it has a cemented block on top", ErrAccountHeightMismatch},
+	{"previous block is missing", ErrAccountHeightMismatch},
+	{"chain-identifier mismatch", ErrChainIdentifierMismatch},
+	{"from-block already received", ErrAlreadyReceived},
+}
+
+// nodeError keeps the node's original error and message while also matching
+// the classified rejection reason.
+type nodeError struct {
+	reason error
+	err    error
+}
+
+func (e *nodeError) Error() string   { return e.err.Error() }
+func (e *nodeError) Unwrap() []error { return []error{e.reason, e.err} }
+
+// ClassifyNodeError maps a known node rejection message to one of the
+// package's sentinel errors.
+//
+// The returned error has the same message as err and still unwraps to it, so
+// errors.As(err, &rpcErr) keeps working; it additionally matches the
+// rejection reason with errors.Is. Unrecognized errors, and errors that are
+// already classified, are returned unchanged. A nil error returns nil.
+//
+// Example:
+//
+//	err := client.LedgerApi.PublishRawTransaction(block)
+//	switch {
+//	case errors.Is(err, api.ErrInsufficientPlasma):
+//	    // fuse QSR or generate PoW
+//	case errors.Is(err, api.ErrAccountHeightMismatch):
+//	    // refresh the frontier and rebuild the block
+//	}
+func ClassifyNodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var classified *nodeError
+	if errors.As(err, &classified) {
+		return err
+	}
+	message := strings.ToLower(err.Error())
+	for _, pattern := range nodeErrorPatterns {
+		if strings.Contains(message, pattern.fragment) {
+			return &nodeError{reason: pattern.reason, err: err}
+		}
+	}
+	return err
+}
+
+// isNodeRejection reports whether err is a classified node rejection.
+func isNodeRejection(err error) bool {
+	var classified *nodeError
+	return errors.As(ClassifyNodeError(err), &classified)
+}
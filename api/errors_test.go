@@ -0,0 +1,70 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/transport"
+	"github.com/zenon-network/go-zenon/chain/nom"
+)
+
+func TestClassifyNodeError_GoZenonMessages(t *testing.T) {
+	tests := []struct {
+		message string
+		want    error
+	}{
+		{"insufficient balance for transfer", ErrInsufficientBalance},
+		{"not enough plasma on account", ErrInsufficientPlasma},
+		{"not enough TotalPlasma provided for account-block (PoW + Fused)", ErrInsufficientPlasma},
+		{"account-block signature is invalid", ErrInvalidSignature},
+		{"account-block publicKey doesn't correspond to the address", ErrInvalidPublicKey},
+		{"account-block nonce/difficulty is invalid", ErrInvalidPoW},
+		{"account-block hash is different than the one computed", ErrInvalidBlockHash},
+		{"account-block prevHeight is cemented but has different hash", ErrAccountHeightMismatch},
+		{"account-block previous block is missing", ErrAccountHeightMismatch},
+		{"account-block chain-identifier mismatch (belongs to another chain)", ErrChainIdentifierMismatch},
+		{"account-block from-block already received", ErrAlreadyReceived},
+	}
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			original := &transport.RPCError{Code: -32000, Message: tt.message}
+			err := ClassifyNodeError(original)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ClassifyNodeError(%q) does not match %v", tt.message, tt.want)
+			}
+			if err.Error() != tt.message {
+				t.Errorf("Error() = %q, want original message", err.Error())
+			}
+			var rpcErr *transport.RPCError
+			if !errors.As(err, &rpcErr) || rpcErr.Code != -32000 {
+				t.Errorf("classified error no longer unwraps to the RPC error")
+			}
+			if isTransientError(err) {
+				t.Errorf("classified rejection treated as transient")
+			}
+		})
+	}
+}
+
+func TestClassifyNodeError_Unrecognized(t *testing.T) {
+	if ClassifyNodeError(nil) != nil {
+		t.Error("ClassifyNodeError(nil) should be nil")
+	}
+	original := errors.New("connection refused")
+	if err := ClassifyNodeError(original); err != original {
+		t.Errorf("ClassifyNodeError() = %v, want the original error", err)
+	}
+
+	once := ClassifyNodeError(errors.New("not enough plasma on account"))
+	if twice := ClassifyNodeError(once); twice != once {
+		t.Error("classifying twice should return the same error")
+	}
+}
+
+func TestPublishRawTransaction_ClassifiesRejection(t *testing.T) {
+	caller := &jsonResultCaller{err: &transport.RPCError{Code: -32000, Message: "insufficient balance for transfer"}}
+	err := NewLedgerApi(caller).PublishRawTransaction(new(nom.AccountBlock))
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Errorf("PublishRawTransaction() error = %v, want ErrInsufficientBalance", err)
+	}
+}
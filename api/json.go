@@ -0,0 +1,119 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// =============================================================================
+// JSON Encoding - Amounts as Decimal Strings
+// =============================================================================
+
+// The node encodes every amount as a decimal string, because JSON numbers lose
+// precision above 2^53 in most clients. go-zenon's api.AccountBlock, api.Token,
+// and api.BalanceInfo already follow this when marshalled through a pointer,
+// and nom.Momentum carries no amounts. AccountInfo implements the encoding
+// itself so that it also holds for values and for balances without token
+// details.
+
+// accountInfoJSON is the wire form of AccountInfo.
+type accountInfoJSON struct {
+	Address        types.Address                                `json:"address"`
+	AccountHeight  uint64                                       `json:"accountHeight"`
+	BalanceInfoMap map[types.ZenonTokenStandard]balanceInfoJSON `json:"balanceInfoMap"`
+}
+
+// balanceInfoJSON is the wire form of one api.BalanceInfo entry.
+type balanceInfoJSON struct {
+	TokenInfo *api.Token      `json:"token"`
+	Balance   json.RawMessage `json:"balance"`
+}
+
+// MarshalJSON encodes the account info in the node's format, with every
+// balance, total supply, and max supply as a decimal string. Nil balances are
+// encoded as "0" and missing token details as null.
+func (ai AccountInfo) MarshalJSON() ([]byte, error) {
+	wire := accountInfoJSON{
+		Address:        ai.Address,
+		AccountHeight:  ai.AccountHeight,
+		BalanceInfoMap: make(map[types.ZenonTokenStandard]balanceInfoJSON, len(ai.BalanceInfoMap)),
+	}
+	for zts, entry := range ai.BalanceInfoMap {
+		if entry == nil {
+			continue
+		}
+		balance, err := json.Marshal(decimalString(entry.Balance))
+		if err != nil {
+			return nil, err
+		}
+		var token *api.Token
+		if entry.TokenInfo != nil {
+			token = &api.Token{}
+			*token = *entry.TokenInfo
+			if token.TotalSupply == nil {
+				token.TotalSupply = big.NewInt(0)
+			}
+			if token.MaxSupply == nil {
+				token.MaxSupply = big.NewInt(0)
+			}
+		}
+		wire.BalanceInfoMap[zts] = balanceInfoJSON{TokenInfo: token, Balance: balance}
+	}
+	return json.Marshal(wire)
+}
+
+// UnmarshalJSON decodes account info written by MarshalJSON or returned by
+// the node. Balances may be decimal strings or JSON numbers.
+func (ai *AccountInfo) UnmarshalJSON(data []byte) error {
+	var wire accountInfoJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	ai.Address = wire.Address
+	ai.AccountHeight = wire.AccountHeight
+	ai.BalanceInfoMap = nil
+	if wire.BalanceInfoMap != nil {
+		ai.BalanceInfoMap = make(map[types.ZenonTokenStandard]*api.BalanceInfo, len(wire.BalanceInfoMap))
+	}
+	for zts, entry := range wire.BalanceInfoMap {
+		balance, err := parseDecimalJSON(entry.Balance)
+		if err != nil {
+			return fmt.Errorf("balance of %s: %w", zts, err)
+		}
+		ai.BalanceInfoMap[zts] = &api.BalanceInfo{TokenInfo: entry.TokenInfo, Balance: balance}
+	}
+	return nil
+}
+
+// decimalString formats v in base 10, treating nil as zero.
+func decimalString(v *big.Int) string {
+	if v == nil {
+		return "0"
+	}
+	return v.String()
+}
+
+// parseDecimalJSON parses a JSON amount given as a decimal string or number.
+// A missing or null amount is zero.
+func parseDecimalJSON(raw json.RawMessage) (*big.Int, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return big.NewInt(0), nil
+	}
+	text := string(raw)
+	if raw[0] == '"' {
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return nil, err
+		}
+	}
+	value, ok := new(big.Int).SetString(text, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal amount %s", raw)
+	}
+	return value, nil
+}
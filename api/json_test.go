@@ -0,0 +1,136 @@
+package api
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+func populatedAccountInfo() AccountInfo {
+	large, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	return AccountInfo{AccountInfo: api.AccountInfo{
+		Address:       types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7"),
+		AccountHeight: 42,
+		BalanceInfoMap: map[types.ZenonTokenStandard]*api.BalanceInfo{
+			types.ZnnTokenStandard: {
+				TokenInfo: &api.Token{
+					TokenName: "Zenon Coin", TokenSymbol: "ZNN", TokenDomain: "zenon.network", Decimals: 8,
+					Owner: types.TokenContract, ZenonTokenStandard: types.ZnnTokenStandard,
+					TotalSupply: big.NewInt(19500000000000000), MaxSupply: large, IsMintable: true,
+				},
+				Balance: large,
+			},
+			types.QsrTokenStandard: {Balance: big.NewInt(5)},
+		},
+	}}
+}
+
+func TestAccountInfoJSONRoundTrip(t *testing.T) {
+	info := populatedAccountInfo()
+
+	for name, value := range map[string]interface{}{"value": info, "pointer": &info} {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(value)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			for _, want := range []string{
+				`"balance":"123456789012345678901234567890"`,
+				`"totalSupply":"19500000000000000"`,
+				`"maxSupply":"123456789012345678901234567890"`,
+				`"balance":"5"`,
+			} {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("json.Marshal() = %s, missing %s", data, want)
+				}
+			}
+
+			var decoded AccountInfo
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if decoded.Address != info.Address || decoded.AccountHeight != info.AccountHeight {
+				t.Errorf("decoded = %s at %d, want %s at %d", decoded.Address, decoded.AccountHeight, info.Address, info.AccountHeight)
+			}
+			for zts, entry := range info.BalanceInfoMap {
+				if got := decoded.Balance(zts); got.Cmp(entry.Balance) != 0 {
+					t.Errorf("decoded Balance(%s) = %s, want %s", zts, got, entry.Balance)
+				}
+			}
+			token := decoded.BalanceInfoMap[types.ZnnTokenStandard].TokenInfo
+			if token == nil || token.TokenSymbol != "ZNN" || token.MaxSupply.Cmp(info.BalanceInfoMap[types.ZnnTokenStandard].TokenInfo.MaxSupply) != 0 {
+				t.Errorf("decoded ZNN token = %+v", token)
+			}
+			if decoded.BalanceInfoMap[types.QsrTokenStandard].TokenInfo != nil {
+				t.Error("decoded QSR token should stay nil")
+			}
+		})
+	}
+}
+
+func TestAccountInfoJSONNilFieldsAndNumbers(t *testing.T) {
+	info := AccountInfo{AccountInfo: api.AccountInfo{
+		BalanceInfoMap: map[types.ZenonTokenStandard]*api.BalanceInfo{
+			types.ZnnTokenStandard: {TokenInfo: &api.Token{}},
+		},
+	}}
+	data, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if strings.Contains(string(data), "<nil>") || !strings.Contains(string(data), `"balance":"0"`) {
+		t.Errorf("json.Marshal() = %s, want nil amounts encoded as \"0\"", data)
+	}
+
+	var decoded AccountInfo
+	if err := json.Unmarshal([]byte(`{"balanceInfoMap":{"zts1znnxxxxxxxxxxxxx9z4ulx":{"token":null,"balance":150000000}}}`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(number) error = %v", err)
+	}
+	if got := decoded.ZnnBalance(); got.Cmp(big.NewInt(150000000)) != 0 {
+		t.Errorf("ZnnBalance() = %s, want 150000000", got)
+	}
+	if err := json.Unmarshal([]byte(`{"balanceInfoMap":{"zts1znnxxxxxxxxxxxxx9z4ulx":{"balance":"1.5"}}}`), &decoded); err == nil {
+		t.Error("json.Unmarshal() should reject a non-integer balance")
+	}
+}
+
+func TestAccountBlockAndMomentumJSONUseDecimalStrings(t *testing.T) {
+	block := &api.AccountBlock{AccountBlock: nom.AccountBlock{
+		BlockType:     nom.BlockTypeUserSend,
+		ToAddress:     types.PlasmaContract,
+		TokenStandard: types.QsrTokenStandard,
+		Amount:        new(big.Int).Lsh(big.NewInt(1), 70),
+	}}
+	data, err := json.Marshal(block)
+	if err != nil {
+		t.Fatalf("json.Marshal(block) error = %v", err)
+	}
+	if !strings.Contains(string(data), `"amount":"1180591620717411303424"`) {
+		t.Errorf("json.Marshal(block) = %s, want amount as a decimal string", data)
+	}
+	decodedBlock := new(api.AccountBlock)
+	if err := json.Unmarshal(data, decodedBlock); err != nil {
+		t.Fatalf("json.Unmarshal(block) error = %v", err)
+	}
+	if decodedBlock.Amount.Cmp(block.Amount) != 0 {
+		t.Errorf("decoded amount = %s, want %s", decodedBlock.Amount, block.Amount)
+	}
+
+	momentum := &api.Momentum{Momentum: &nom.Momentum{Height: 9, ChainIdentifier: 1}, Producer: types.PillarContract}
+	data, err = json.Marshal(momentum)
+	if err != nil {
+		t.Fatalf("json.Marshal(momentum) error = %v", err)
+	}
+	decodedMomentum := new(api.Momentum)
+	if err := json.Unmarshal(data, decodedMomentum); err != nil {
+		t.Fatalf("json.Unmarshal(momentum) error = %v", err)
+	}
+	if decodedMomentum.Momentum == nil || decodedMomentum.Height != 9 || decodedMomentum.Producer != types.PillarContract {
+		t.Errorf("decoded momentum = %+v", decodedMomentum)
+	}
+}
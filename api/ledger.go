@@ -1,20 +1,46 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
 	"time"
 
+	"github.com/0x3639/znn-sdk-go/api/embedded"
 	"github.com/0x3639/znn-sdk-go/internal/rpcvalidation"
 	"github.com/0x3639/znn-sdk-go/transport"
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common/types"
+	gozenonpow "github.com/zenon-network/go-zenon/pow"
 	"github.com/zenon-network/go-zenon/rpc/api"
 )
 
+// MaxUnreceivedBlocks is the most unreceived blocks a node lists for one
+// address: ten pages of 50. Blocks beyond them become visible only as earlier
+// ones are received.
+const MaxUnreceivedBlocks = int(rpcvalidation.UnreceivedMaxPageIndex * rpcvalidation.MemoryPoolPageSize)
+
+// ErrTooManyUnreceived is returned by GetAllUnreceivedBlocks and
+// SumUnreceived when the node reports that an address may have more than
+// MaxUnreceivedBlocks pending, so a complete list cannot be read.
+var ErrTooManyUnreceived = errors.New("too many unreceived blocks")
+
+// ErrNotSubmittable is returned by CheckSubmittable when a block has neither
+// enough plasma nor a valid PoW nonce to be accepted by the node.
+var ErrNotSubmittable = errors.New("block is not submittable")
+
+// ErrNonPositiveAmount is returned by SendTemplateChecked when the amount is
+// nil, zero, or negative.
+var ErrNonPositiveAmount = errors.New("amount must be positive")
+
 type LedgerApi struct {
-	client transport.Caller
+	client      transport.Caller
+	retryPolicy *RetryPolicy
+	// sleep and random are overridden in tests to check the retry schedule
+	sleep  func(time.Duration)
+	random func() float64
 }
 
 func NewLedgerApi(client transport.Caller) *LedgerApi {
@@ -39,11 +65,12 @@ func NewLedgerApi(client transport.Caller) *LedgerApi {
 // Success requires the node to return the canonical JSON null result. The
 // method returns an error if the node returns any non-null value, even when the
 // JSON-RPC envelope itself reports success. It also returns an error when the
-// transaction is rejected by the node. Common rejection reasons:
-//   - Insufficient PoW/plasma
-//   - Invalid signature
-//   - Incorrect height or previous hash
-//   - Insufficient balance
+// transaction is rejected by the node. Common rejection reasons are passed
+// through ClassifyNodeError and match these errors with errors.Is:
+//   - Insufficient PoW/plasma (ErrInsufficientPlasma, ErrInvalidPoW)
+//   - Invalid signature (ErrInvalidSignature)
+//   - Incorrect height or previous hash (ErrAccountHeightMismatch)
+//   - Insufficient balance (ErrInsufficientBalance)
 //   - Invalid contract call parameters
 //
 // Example:
@@ -61,7 +88,7 @@ func NewLedgerApi(client transport.Caller) *LedgerApi {
 func (la *LedgerApi) PublishRawTransaction(transaction *nom.AccountBlock) error {
 	var ans interface{}
 	if err := la.client.Call(&ans, "ledger.publishRawTransaction", transaction); err != nil {
-		return err
+		return ClassifyNodeError(err)
 	}
 	if ans != nil {
 		return fmt.Errorf("ledger.publishRawTransaction returned non-null success result: %v", ans)
@@ -69,6 +96,70 @@ func (la *LedgerApi) PublishRawTransaction(transaction *nom.AccountBlock) error
 	return nil
 }
 
+// CheckSubmittable performs a local preflight check that a block carries
+// enough plasma or a valid PoW nonce before it is published.
+//
+// Publishing a block with neither fails with an opaque node error. This method
+// asks the node how much PoW the block needs given the sender's current plasma
+// and reports the problem clearly instead.
+//
+// A block is submittable when:
+//   - The sender's plasma covers the block (required difficulty is zero), or
+//   - The block carries a nonce for at least the required difficulty and that
+//     nonce passes go-zenon's PoW check for the block's address and previous hash
+//
+// Parameters:
+//   - block: Autofilled block to check; Address, BlockType, ToAddress, Data,
+//     PreviousHash, Difficulty, and Nonce are used
+//
+// Returns nil when the block is submittable. Otherwise returns an error that
+// wraps ErrNotSubmittable and states the required difficulty and plasma, or the
+// RPC error if the requirement could not be queried.
+//
+// Example:
+//
+//	if err := client.LedgerApi.CheckSubmittable(block); err != nil {
+//	    // e.g. "block requires PoW difficulty 31500000 or 21000 plasma
+//	    // (available 0); neither present"
+//	    return err
+//	}
+//	err := client.LedgerApi.PublishRawTransaction(block)
+//
+// Note: The check uses node state at call time. Plasma consumed by other
+// blocks before publishing can still make the node reject the block.
+func (la *LedgerApi) CheckSubmittable(block *nom.AccountBlock) error {
+	if block == nil {
+		return fmt.Errorf("%w: nil block", ErrNotSubmittable)
+	}
+
+	required := new(embedded.GetRequiredResult)
+	param := embedded.GetRequiredParam{
+		Address:   block.Address,
+		BlockType: block.BlockType,
+		ToAddress: block.ToAddress,
+		Data:      block.Data,
+	}
+	if err := la.client.Call(required, "embedded.plasma.getRequiredPoWForAccountBlock", param); err != nil {
+		return fmt.Errorf("failed to query required PoW: %w", err)
+	}
+	if required.RequiredDifficulty == 0 {
+		return nil
+	}
+
+	if block.Nonce.Data == ([8]byte{}) || block.Difficulty == 0 {
+		return fmt.Errorf("%w: block requires PoW difficulty %d or %d plasma (available %d); neither present",
+			ErrNotSubmittable, required.RequiredDifficulty, required.BasePlasma, required.AvailablePlasma)
+	}
+	if block.Difficulty < required.RequiredDifficulty {
+		return fmt.Errorf("%w: block difficulty %d is below the required PoW difficulty %d",
+			ErrNotSubmittable, block.Difficulty, required.RequiredDifficulty)
+	}
+	if !gozenonpow.CheckPoWNonce(block) {
+		return fmt.Errorf("%w: nonce does not satisfy PoW difficulty %d", ErrNotSubmittable, block.Difficulty)
+	}
+	return nil
+}
+
 // PublishRawTransactionWithRetry publishes a transaction with automatic retry logic
 // for transient failures.
 //
@@ -79,12 +170,14 @@ func (la *LedgerApi) PublishRawTransaction(transaction *nom.AccountBlock) error
 // Retry behavior:
 //   - Retries only on transient errors (network errors, timeouts, temporary unavailability)
 //   - Does NOT retry on permanent errors (invalid signature, insufficient balance, etc.)
-//   - Uses exponential backoff: 1s, 2s, 4s, 8s, ...
-//   - Maximum backoff delay capped at 30 seconds
+//   - Delays follow the RetryPolicy set with WithRetryPolicy, or
+//     DefaultRetryPolicy: exponential backoff of up to 1s, 2s, 4s, 8s, ...
+//     capped at 30 seconds, with full jitter so concurrent clients spread out
 //
 // Parameters:
 //   - transaction: Fully prepared AccountBlock ready for submission
-//   - maxRetries: Maximum number of retry attempts (0 = no retries, just one attempt)
+//   - maxRetries: Maximum number of retry attempts (0 = no retries, just one attempt);
+//     a negative value uses the policy's MaxAttempts instead
 //
 // Returns an error if all retry attempts fail or if a permanent error is encountered.
 //
@@ -118,9 +211,14 @@ func (la *LedgerApi) PublishRawTransaction(transaction *nom.AccountBlock) error
 //   - Invalid PoW/plasma
 //   - Malformed transaction data
 func (la *LedgerApi) PublishRawTransactionWithRetry(transaction *nom.AccountBlock, maxRetries int) error {
-	var lastErr error
+	policy := la.currentRetryPolicy()
+	attempts := maxRetries + 1
+	if maxRetries < 0 {
+		attempts = max(policy.MaxAttempts, 1)
+	}
 
-	for attempt := 0; attempt <= maxRetries; attempt++ {
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
 		// Attempt to publish
 		err := la.PublishRawTransaction(transaction)
 		if err == nil {
@@ -138,30 +236,17 @@ func (la *LedgerApi) PublishRawTransactionWithRetry(transaction *nom.AccountBloc
 		}
 
 		// Check if we have retries left
-		if attempt >= maxRetries {
+		if attempt >= attempts-1 {
 			// No more retries
 			break
 		}
 
-		// Calculate backoff delay with exponential backoff
-		// 1s, 2s, 4s, 8s, 16s, 30s (capped)
-		// Cap shift amount to prevent overflow (attempt is always >= 0 in our loop)
-		shiftAmount := attempt
-		if shiftAmount > 5 {
-			shiftAmount = 5 // Cap at 2^5 = 32 seconds
-		}
-		// #nosec G115 - shiftAmount is capped to prevent overflow
-		backoff := time.Duration(1<<uint(shiftAmount)) * time.Second
-		if backoff > 30*time.Second {
-			backoff = 30 * time.Second
-		}
-
 		// Wait before retry
-		time.Sleep(backoff)
+		la.sleepFor(la.retryDelay(policy, attempt))
 	}
 
 	// All retries exhausted
-	return fmt.Errorf("transaction failed after %d attempts: %w", maxRetries+1, lastErr)
+	return fmt.Errorf("transaction failed after %d attempts: %w", attempts, lastErr)
 }
 
 // isTransientError determines if an error is transient (retry-worthy) or permanent.
@@ -182,6 +267,11 @@ func isTransientError(err error) bool {
 		return false
 	}
 
+	// Rejections recognized by ClassifyNodeError are never transient
+	if isNodeRejection(err) {
+		return false
+	}
+
 	errStr := strings.ToLower(err.Error())
 
 	// Transient network errors
@@ -318,6 +408,182 @@ func (la *LedgerApi) GetAccountBlockByHash(blockHash types.Hash) (*api.AccountBl
 	return ans, nil
 }
 
+// WaitForConfirmation polls for an account block until it is confirmed in a
+// momentum or ctx is done.
+//
+// A block counts as confirmed once the node reports a ConfirmationDetail for
+// it. Lookups that fail with a transient error (see isTransientError) or that
+// return no block yet are retried on the next tick; permanent errors are
+// returned immediately.
+//
+// Parameters:
+//   - ctx: Bounds the wait; cancel it or set a deadline to stop polling
+//   - blockHash: Hash of the published account block
+//   - pollInterval: Delay between lookups (values <= 0 default to one second)
+//
+// Returns the confirmed block. Its ConfirmationDetail carries the momentum
+// height, hash, and timestamp the block landed in. If ctx ends first, the
+// error wraps ctx.Err().
+//
+// Example:
+//
+//	if err := client.LedgerApi.PublishRawTransaction(block); err != nil {
+//	    return err
+//	}
+//	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+//	defer cancel()
+//
+//	confirmed, err := client.LedgerApi.WaitForConfirmation(ctx, block.Hash, 2*time.Second)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("Confirmed in momentum %d (%s)\n",
+//	    confirmed.ConfirmationDetail.MomentumHeight,
+//	    confirmed.ConfirmationDetail.MomentumHash)
+func (la *LedgerApi) WaitForConfirmation(ctx context.Context, blockHash types.Hash, pollInterval time.Duration) (*api.AccountBlock, error) {
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		block, err := la.GetAccountBlockByHash(blockHash)
+		switch {
+		case err != nil && !isTransientError(err):
+			return nil, err
+		case err != nil:
+			lastErr = err
+		case block != nil && block.ConfirmationDetail != nil:
+			return block, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return nil, fmt.Errorf("waiting for confirmation of %s: %w (last error: %v)", blockHash, ctx.Err(), lastErr)
+			}
+			return nil, fmt.Errorf("waiting for confirmation of %s: %w", blockHash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForBalance polls an account until its balance of a token reaches
+// minAmount or ctx is done.
+//
+// The balance is the one on the account chain, as reported by
+// GetAccountInfoByAddress: funds count once their receive block is published,
+// not while they wait unreceived. To receive pending funds while waiting, use
+// zenon.Zenon.ReceiveUntilBalance with the account's signer. Transient lookup
+// errors (see isTransientError) are retried on the next tick; permanent
+// errors are returned immediately.
+//
+// Parameters:
+//   - ctx: Bounds the wait; cancel it or set a deadline to stop polling
+//   - address: Account to watch
+//   - zts: Token standard to watch (for example types.ZnnTokenStandard)
+//   - minAmount: Balance to wait for, in base units
+//   - pollInterval: Delay between lookups (values <= 0 default to one second)
+//
+// Returns the observed balance once it is at least minAmount. If ctx ends
+// first, the error wraps ctx.Err() and the last observed balance is returned
+// alongside it (zero if none was read).
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+//	defer cancel()
+//	balance, err := client.LedgerApi.WaitForBalance(ctx, address, types.ZnnTokenStandard, big.NewInt(100000000), 2*time.Second)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("Funded with %s ZNN\n", utils.AddDecimals(balance, 8))
+func (la *LedgerApi) WaitForBalance(ctx context.Context, address types.Address, zts types.ZenonTokenStandard, minAmount *big.Int, pollInterval time.Duration) (*big.Int, error) {
+	if minAmount == nil {
+		return nil, errors.New("minAmount cannot be nil")
+	}
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	balance := big.NewInt(0)
+	var lastErr error
+	for {
+		info, err := la.GetAccountInfoByAddress(address)
+		switch {
+		case err != nil && !isTransientError(err):
+			return nil, err
+		case err != nil:
+			lastErr = err
+		default:
+			lastErr = nil
+			balance = info.Balance(zts)
+			if balance.Cmp(minAmount) >= 0 {
+				return balance, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return balance, fmt.Errorf("waiting for balance of %s: %w (last error: %v)", address, ctx.Err(), lastErr)
+			}
+			return balance, fmt.Errorf("waiting for balance of %s: %w", address, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// ErrAccountBlockNotFound is returned by GetConfirmationDepth when the node
+// does not know the block.
+var ErrAccountBlockNotFound = errors.New("account block not found")
+
+// GetConfirmationDepth returns how many momentums confirm an account block:
+// the frontier momentum height minus the height of the momentum that included
+// the block, plus one. A block in the frontier momentum has depth 1.
+//
+// Parameters:
+//   - blockHash: Hash of the account block
+//
+// Returns 0 with a nil error if the block is known but not yet in a momentum,
+// an error wrapping ErrAccountBlockNotFound if the node does not know it, or
+// the RPC error of either lookup.
+//
+// Example:
+//
+//	depth, err := client.LedgerApi.GetConfirmationDepth(block.Hash)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("%d confirmations\n", depth)
+func (la *LedgerApi) GetConfirmationDepth(blockHash types.Hash) (uint64, error) {
+	block, err := la.GetAccountBlockByHash(blockHash)
+	if err != nil {
+		return 0, err
+	}
+	if block == nil || block.Hash == types.ZeroHash {
+		return 0, fmt.Errorf("%w: %s", ErrAccountBlockNotFound, blockHash)
+	}
+	if block.ConfirmationDetail == nil {
+		return 0, nil
+	}
+
+	frontier, err := la.GetFrontierMomentum()
+	if err != nil {
+		return 0, err
+	}
+	confirmedAt := block.ConfirmationDetail.MomentumHeight
+	if frontier.Height < confirmedAt {
+		// The frontier lookup raced behind the block lookup.
+		return 1, nil
+	}
+	return frontier.Height - confirmedAt + 1, nil
+}
+
 func (la *LedgerApi) GetAccountBlocksByHeight(address types.Address, height, count uint64) (*api.AccountBlockList, error) {
 	if err := rpcvalidation.ValidateLimit("ledger.getAccountBlocksByHeight", "count", count, rpcvalidation.MaxPageSize); err != nil {
 		return nil, err
@@ -362,21 +628,17 @@ func (la *LedgerApi) GetAccountBlocksByPage(address types.Address, pageIndex, pa
 //	    log.Fatal(err)
 //	}
 //
-//	// Get ZNN balance
-//	if znnBalance, ok := info.BalanceInfoMap[types.ZnnTokenStandard]; ok {
-//	    fmt.Printf("ZNN Balance: %s\n", znnBalance.Balance)
-//	}
-//
-//	// Get QSR balance
-//	if qsrBalance, ok := info.BalanceInfoMap[types.QsrTokenStandard]; ok {
-//	    fmt.Printf("QSR Balance: %s\n", qsrBalance.Balance)
-//	}
+//	// Balances default to zero for tokens the account does not hold
+//	fmt.Printf("ZNN Balance: %s\n", info.ZnnBalance())
+//	fmt.Printf("QSR Balance: %s\n", info.QsrBalance())
+//	fmt.Printf("ZNN (display): %s\n", info.BalanceFormatted(types.ZnnTokenStandard, 8))
 //
 //	fmt.Printf("Account Height: %d\n", info.AccountHeight)
 //
-// Balance amounts are returned in base units (1 ZNN = 10^8 base units).
-func (la *LedgerApi) GetAccountInfoByAddress(address types.Address) (*api.AccountInfo, error) {
-	ans := new(api.AccountInfo)
+// Balance amounts are returned in base units (1 ZNN = 10^8 base units). The
+// raw per-token entries remain available through info.BalanceInfoMap.
+func (la *LedgerApi) GetAccountInfoByAddress(address types.Address) (*AccountInfo, error) {
+	ans := new(AccountInfo)
 	if err := la.client.Call(ans, "ledger.getAccountInfoByAddress", address.String()); err != nil {
 		return nil, err
 	}
@@ -428,6 +690,185 @@ func (la *LedgerApi) GetUnreceivedBlocksByAddress(address types.Address, pageInd
 	return ans, nil
 }
 
+// GetAllUnreceivedBlocks retrieves every unreceived block for an address by
+// paging through GetUnreceivedBlocksByAddress until the node's list is
+// exhausted.
+//
+// The node serves at most MaxUnreceivedBlocks blocks across ten pages and
+// reports the list's length in Count, so paging stops on a short page or once
+// Count is reached. Blocks are returned in the order the node lists them. A
+// block that shifts between pages while paging is included only once.
+//
+// Parameters:
+//   - address: Account address to check for unreceived blocks
+//
+// Returns all unreceived blocks, an error wrapping ErrTooManyUnreceived when
+// the node reports that more may be pending than it lists, or the RPC error.
+//
+// Example:
+//
+//	blocks, err := client.LedgerApi.GetAllUnreceivedBlocks(address)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%d blocks waiting to be received\n", len(blocks))
+func (la *LedgerApi) GetAllUnreceivedBlocks(address types.Address) ([]*api.AccountBlock, error) {
+	blocks, more, err := la.GetListedUnreceivedBlocks(address)
+	if err != nil {
+		return nil, err
+	}
+	if more {
+		return nil, fmt.Errorf("%w: %s may have more than %d", ErrTooManyUnreceived, address, MaxUnreceivedBlocks)
+	}
+	return blocks, nil
+}
+
+// GetListedUnreceivedBlocks retrieves the unreceived blocks the node lists
+// for an address, at most MaxUnreceivedBlocks, without failing when more are
+// pending.
+//
+// The node lists blocks beyond the first MaxUnreceivedBlocks only once earlier
+// ones are received, so callers that receive the listed blocks can call it
+// again until more is false.
+//
+// Parameters:
+//   - address: Account address to check for unreceived blocks
+//
+// Returns the listed blocks in the node's order, whether the node reported
+// that more may be pending, or the RPC error.
+//
+// Example:
+//
+//	blocks, more, err := client.LedgerApi.GetListedUnreceivedBlocks(address)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%d blocks listed, more pending: %v\n", len(blocks), more)
+func (la *LedgerApi) GetListedUnreceivedBlocks(address types.Address) ([]*api.AccountBlock, bool, error) {
+	return rpcvalidation.CollectUnreceived(func(pageIndex, pageSize uint32) (*api.AccountBlockList, error) {
+		return la.GetUnreceivedBlocksByAddress(address, pageIndex, pageSize)
+	}, 0)
+}
+
+// SumUnreceived returns the total amount of a token waiting to be received by
+// an address, in base units.
+//
+// Parameters:
+//   - address: Account address to check
+//   - tokenStandard: Token to total; other tokens are ignored
+//
+// Returns the pending amount (zero when nothing is pending) or an error from
+// GetAllUnreceivedBlocks.
+//
+// Example:
+//
+//	pending, err := client.LedgerApi.SumUnreceived(address, types.ZnnTokenStandard)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Pending ZNN: %s\n", utils.AddDecimals(pending, 8))
+func (la *LedgerApi) SumUnreceived(address types.Address, tokenStandard types.ZenonTokenStandard) (*big.Int, error) {
+	blocks, err := la.GetAllUnreceivedBlocks(address)
+	if err != nil {
+		return nil, err
+	}
+	total := new(big.Int)
+	for _, block := range blocks {
+		if block.TokenStandard == tokenStandard && block.Amount != nil {
+			total.Add(total, block.Amount)
+		}
+	}
+	return total, nil
+}
+
+// GetUnreceivedAboveThreshold retrieves the unreceived blocks for an address
+// whose amount is at least minAmount, skipping dust.
+//
+// Spam addresses can be flooded with tiny sends, and receiving each one costs
+// plasma or PoW. Zero-amount blocks, such as notifications from contract
+// calls, are dust by amount but may still matter; includeZeroAmount keeps them
+// regardless of the threshold.
+//
+// Parameters:
+//   - address: Account address to check for unreceived blocks
+//   - minAmount: Smallest amount to keep, in base units of each block's token;
+//     nil or zero keeps every block
+//   - includeZeroAmount: Keep zero-amount blocks even when minAmount is
+//     positive
+//
+// Returns the kept blocks in the order GetListedUnreceivedBlocks lists them,
+// whether the node reported that more may be pending than it listed, or the
+// RPC error. A dust-flooded address does not fail: the blocks worth receiving
+// among the listed ones are returned with more set.
+//
+// Example:
+//
+//	// Ignore anything below 0.01 ZNN/QSR, but keep contract notifications
+//	blocks, more, err := client.LedgerApi.GetUnreceivedAboveThreshold(address, big.NewInt(1000000), true)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%d blocks worth receiving, more pending: %v\n", len(blocks), more)
+//
+// Note: The threshold is compared in base units, so it means different
+// amounts for tokens with different decimals.
+func (la *LedgerApi) GetUnreceivedAboveThreshold(address types.Address, minAmount *big.Int, includeZeroAmount bool) ([]*api.AccountBlock, bool, error) {
+	blocks, more, err := la.GetListedUnreceivedBlocks(address)
+	if err != nil {
+		return nil, false, err
+	}
+	if minAmount == nil || minAmount.Sign() <= 0 {
+		return blocks, more, nil
+	}
+	kept := make([]*api.AccountBlock, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Amount == nil || block.Amount.Sign() == 0 {
+			if includeZeroAmount {
+				kept = append(kept, block)
+			}
+			continue
+		}
+		if block.Amount.Cmp(minAmount) >= 0 {
+			kept = append(kept, block)
+		}
+	}
+	return kept, more, nil
+}
+
+// GetEffectiveBalance returns both the confirmed balance of a token and the
+// amount of it waiting to be received, in base units.
+//
+// Parameters:
+//   - address: Account address to check
+//   - tokenStandard: Token to report
+//
+// Returns the confirmed balance from GetAccountInfoByAddress and the pending
+// total from SumUnreceived (both zero when nothing is held or pending), or
+// the first error.
+//
+// Example:
+//
+//	confirmed, pending, err := client.LedgerApi.GetEffectiveBalance(address, types.ZnnTokenStandard)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s ZNN available, %s incoming\n",
+//	    utils.AddDecimals(confirmed, 8), utils.AddDecimals(pending, 8))
+//
+// Note: The two figures come from separate requests. A block received between
+// them may be counted in both or in neither.
+func (la *LedgerApi) GetEffectiveBalance(address types.Address, tokenStandard types.ZenonTokenStandard) (confirmed, pending *big.Int, err error) {
+	info, err := la.GetAccountInfoByAddress(address)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+	pending, err = la.SumUnreceived(address, tokenStandard)
+	if err != nil {
+		return nil, nil, err
+	}
+	return info.Balance(tokenStandard), pending, nil
+}
+
 // GetFrontierMomentum retrieves the latest momentum (block) from the network.
 //
 // Momentums are the backbone of Zenon Network, similar to blocks in other blockchains.
@@ -565,6 +1006,39 @@ func (la *LedgerApi) SendTemplate(toAddress types.Address, tokenStandard types.Z
 	}
 }
 
+// SendTemplateChecked creates a SendTemplate after checking that the amount is
+// positive.
+//
+// SendTemplate accepts any amount, so a zero or negative amount only surfaces
+// as a rejection from the node after signing and PoW, or worse, publishes a
+// pointless zero-value send. SendTemplateChecked fails locally instead. Use
+// utils.IsDustAmount to also catch amounts too small to be meaningful.
+//
+// Parameters:
+//   - toAddress: Recipient address
+//   - tokenStandard: Token to send
+//   - amount: Amount in base units; it must be positive
+//   - data: Optional arbitrary data
+//
+// Returns an unsigned AccountBlock template, or an error wrapping
+// ErrNonPositiveAmount.
+//
+// Example:
+//
+//	template, err := client.LedgerApi.SendTemplateChecked(to, types.ZnnTokenStandard, amount, nil)
+//	if errors.Is(err, api.ErrNonPositiveAmount) {
+//	    return fmt.Errorf("enter an amount greater than zero")
+//	}
+//
+// Note: Contract calls that legitimately carry no tokens, such as Delegate,
+// should keep using their own templates.
+func (la *LedgerApi) SendTemplateChecked(toAddress types.Address, tokenStandard types.ZenonTokenStandard, amount *big.Int, data []byte) (*nom.AccountBlock, error) {
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("%w, got %v", ErrNonPositiveAmount, amount)
+	}
+	return la.SendTemplate(toAddress, tokenStandard, amount, data), nil
+}
+
 // ReceiveTemplate creates an unsigned transaction template for receiving tokens.
 //
 // In Zenon's dual-ledger model, receiving funds requires publishing a receive block
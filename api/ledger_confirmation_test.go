@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// sequenceCaller answers successive calls with the given steps, repeating the
+// last one once the sequence is exhausted.
+type sequenceCaller struct {
+	steps []func(result interface{}) error
+	calls int
+}
+
+func (c *sequenceCaller) Call(result interface{}, _ string, _ ...interface{}) error {
+	step := c.steps[len(c.steps)-1]
+	if c.calls < len(c.steps) {
+		step = c.steps[c.calls]
+	}
+	c.calls++
+	return step(result)
+}
+
+func unconfirmedBlock(interface{}) error { return nil }
+
+func confirmedBlock(result interface{}) error {
+	result.(*api.AccountBlock).ConfirmationDetail = &api.AccountBlockConfirmationDetail{
+		NumConfirmations: 1,
+		MomentumHeight:   42,
+	}
+	return nil
+}
+
+func TestWaitForConfirmationPollsUntilConfirmed(t *testing.T) {
+	caller := &sequenceCaller{steps: []func(interface{}) error{
+		unconfirmedBlock,
+		func(interface{}) error { return errors.New("connection refused") },
+		confirmedBlock,
+	}}
+	block, err := NewLedgerApi(caller).WaitForConfirmation(context.Background(), types.ZeroHash, time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForConfirmation() error = %v", err)
+	}
+	if block.ConfirmationDetail.MomentumHeight != 42 {
+		t.Fatalf("MomentumHeight = %d, want 42", block.ConfirmationDetail.MomentumHeight)
+	}
+	if caller.calls != 3 {
+		t.Fatalf("calls = %d, want 3", caller.calls)
+	}
+}
+
+func TestWaitForConfirmationReturnsPermanentErrors(t *testing.T) {
+	caller := &sequenceCaller{steps: []func(interface{}) error{
+		func(interface{}) error { return errors.New("invalid hash format") },
+	}}
+	_, err := NewLedgerApi(caller).WaitForConfirmation(context.Background(), types.ZeroHash, time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "invalid hash") {
+		t.Fatalf("WaitForConfirmation() error = %v, want permanent error", err)
+	}
+	if caller.calls != 1 {
+		t.Fatalf("calls = %d, want 1", caller.calls)
+	}
+}
+
+func TestWaitForConfirmationStopsOnContextDeadline(t *testing.T) {
+	caller := &sequenceCaller{steps: []func(interface{}) error{unconfirmedBlock}}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := NewLedgerApi(caller).WaitForConfirmation(ctx, types.ZeroHash, time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("WaitForConfirmation() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+// depthCaller serves one account block and a frontier momentum.
+type depthCaller struct {
+	block    *api.AccountBlock
+	frontier uint64
+}
+
+func (c *depthCaller) Call(result interface{}, method string, _ ...interface{}) error {
+	switch method {
+	case "ledger.getAccountBlockByHash":
+		if c.block != nil {
+			*result.(*api.AccountBlock) = *c.block
+		}
+	case "ledger.getFrontierMomentum":
+		result.(*api.Momentum).Momentum = &nom.Momentum{Height: c.frontier}
+	default:
+		return errors.New("unexpected method " + method)
+	}
+	return nil
+}
+
+func TestGetConfirmationDepth(t *testing.T) {
+	hash := types.HexToHashPanic("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20")
+	block := &api.AccountBlock{AccountBlock: nom.AccountBlock{Hash: hash}}
+
+	tests := []struct {
+		name        string
+		confirmedAt uint64
+		frontier    uint64
+		want        uint64
+	}{
+		{"in frontier momentum", 100, 100, 1},
+		{"six confirmations", 100, 105, 6},
+		{"frontier lags", 100, 99, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confirmed := *block
+			confirmed.ConfirmationDetail = &api.AccountBlockConfirmationDetail{MomentumHeight: tt.confirmedAt}
+			depth, err := NewLedgerApi(&depthCaller{block: &confirmed, frontier: tt.frontier}).GetConfirmationDepth(hash)
+			if err != nil {
+				t.Fatalf("GetConfirmationDepth() error = %v", err)
+			}
+			if depth != tt.want {
+				t.Errorf("GetConfirmationDepth() = %d, want %d", depth, tt.want)
+			}
+		})
+	}
+
+	depth, err := NewLedgerApi(&depthCaller{block: block, frontier: 105}).GetConfirmationDepth(hash)
+	if err != nil || depth != 0 {
+		t.Errorf("unconfirmed block: GetConfirmationDepth() = %d, %v, want 0, nil", depth, err)
+	}
+
+	if _, err := NewLedgerApi(&depthCaller{frontier: 105}).GetConfirmationDepth(hash); !errors.Is(err, ErrAccountBlockNotFound) {
+		t.Errorf("unknown block: error = %v, want ErrAccountBlockNotFound", err)
+	}
+}
+
+func accountBalance(balance int64) func(result interface{}) error {
+	return func(result interface{}) error {
+		result.(*AccountInfo).BalanceInfoMap = map[types.ZenonTokenStandard]*api.BalanceInfo{
+			types.ZnnTokenStandard: {Balance: big.NewInt(balance)},
+		}
+		return nil
+	}
+}
+
+func TestWaitForBalancePollsUntilFunded(t *testing.T) {
+	caller := &sequenceCaller{steps: []func(interface{}) error{
+		accountBalance(0),
+		func(interface{}) error { return errors.New("connection refused") },
+		accountBalance(50),
+		accountBalance(150),
+	}}
+	balance, err := NewLedgerApi(caller).WaitForBalance(context.Background(), types.ZeroAddress, types.ZnnTokenStandard, big.NewInt(100), time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForBalance() error = %v", err)
+	}
+	if balance.Int64() != 150 {
+		t.Errorf("balance = %s, want 150", balance)
+	}
+	if caller.calls != 4 {
+		t.Errorf("calls = %d, want 4", caller.calls)
+	}
+}
+
+func TestWaitForBalanceReturnsLastBalanceOnTimeout(t *testing.T) {
+	caller := &sequenceCaller{steps: []func(interface{}) error{accountBalance(50)}}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	balance, err := NewLedgerApi(caller).WaitForBalance(ctx, types.ZeroAddress, types.ZnnTokenStandard, big.NewInt(100), time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("WaitForBalance() error = %v, want context.DeadlineExceeded", err)
+	}
+	if balance.Int64() != 50 {
+		t.Errorf("balance = %s, want last observed 50", balance)
+	}
+}
@@ -26,18 +26,9 @@ func Example_queryAccountBalance() {
 		log.Fatal(err)
 	}
 
-	// Display balances
-	if znnBalance, ok := info.BalanceInfoMap[types.ZnnTokenStandard]; ok {
-		fmt.Printf("ZNN Balance: %s\n", znnBalance.Balance)
-	} else {
-		fmt.Println("ZNN Balance: 0")
-	}
-
-	if qsrBalance, ok := info.BalanceInfoMap[types.QsrTokenStandard]; ok {
-		fmt.Printf("QSR Balance: %s\n", qsrBalance.Balance)
-	} else {
-		fmt.Println("QSR Balance: 0")
-	}
+	// Display balances (zero when the token is not held)
+	fmt.Printf("ZNN Balance: %s\n", info.BalanceFormatted(types.ZnnTokenStandard, 8))
+	fmt.Printf("QSR Balance: %s\n", info.BalanceFormatted(types.QsrTokenStandard, 8))
 
 	fmt.Printf("Account Height: %d\n", info.AccountHeight)
 }
@@ -2,7 +2,13 @@ package api
 
 import (
 	"errors"
+	"math"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
 )
 
 // =============================================================================
@@ -111,3 +117,110 @@ func BenchmarkIsTransientError_Unknown(b *testing.B) {
 		isTransientError(err)
 	}
 }
+
+// =============================================================================
+// RetryPolicy Tests
+// =============================================================================
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	for _, test := range []struct {
+		retry  int
+		random float64
+		want   time.Duration
+	}{
+		{retry: 0, random: 0, want: time.Second},
+		{retry: 2, random: 0, want: 4 * time.Second},
+		{retry: 2, random: 0.5, want: 2 * time.Second},
+		{retry: 5, random: 0, want: 30 * time.Second},
+		{retry: 100, random: 0.25, want: 22500 * time.Millisecond},
+	} {
+		if got := policy.Delay(test.retry, test.random); got != test.want {
+			t.Errorf("Delay(%d, %v) = %v, want %v", test.retry, test.random, got, test.want)
+		}
+	}
+
+	partial := RetryPolicy{BaseDelay: time.Second, Multiplier: 3, Jitter: 0.5}
+	if got := partial.Delay(2, 1); got != 4500*time.Millisecond {
+		t.Errorf("Delay() with half jitter = %v, want 4.5s", got)
+	}
+	if got := (RetryPolicy{}).Delay(3, 0); got != 0 {
+		t.Errorf("zero policy Delay() = %v, want 0", got)
+	}
+
+	// Without MaxDelay the nominal delay outgrows time.Duration.
+	uncapped := RetryPolicy{BaseDelay: time.Second, Multiplier: 2}
+	for _, retry := range []int{40, 63, 1000} {
+		if got := uncapped.Delay(retry, 0); got != time.Duration(math.MaxInt64) {
+			t.Errorf("uncapped Delay(%d, 0) = %v, want the largest Duration", retry, got)
+		}
+	}
+}
+
+// retryingLedger returns a LedgerApi whose publish calls fail with a transient
+// error failures times, and which records its sleeps instead of waiting.
+func retryingLedger(failures int, sleeps *[]time.Duration) (*LedgerApi, *sequenceCaller) {
+	steps := make([]func(interface{}) error, 0, failures+1)
+	for i := 0; i < failures; i++ {
+		steps = append(steps, func(interface{}) error { return errors.New("connection refused") })
+	}
+	steps = append(steps, func(interface{}) error { return nil })
+	caller := &sequenceCaller{steps: steps}
+	ledger := NewLedgerApi(caller)
+	ledger.sleep = func(d time.Duration) { *sleeps = append(*sleeps, d) }
+	ledger.random = func() float64 { return 0.5 }
+	return ledger, caller
+}
+
+func TestPublishRawTransactionWithRetry_JitteredSchedule(t *testing.T) {
+	var sleeps []time.Duration
+	ledger, caller := retryingLedger(3, &sleeps)
+
+	if err := ledger.PublishRawTransactionWithRetry(new(nom.AccountBlock), 5); err != nil {
+		t.Fatalf("PublishRawTransactionWithRetry() error = %v", err)
+	}
+	want := []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second}
+	if !reflect.DeepEqual(sleeps, want) {
+		t.Errorf("sleeps = %v, want %v", sleeps, want)
+	}
+	if caller.calls != 4 {
+		t.Errorf("calls = %d, want 4", caller.calls)
+	}
+}
+
+func TestPublishRawTransactionWithRetry_CustomPolicy(t *testing.T) {
+	var sleeps []time.Duration
+	base, caller := retryingLedger(10, &sleeps)
+	ledger := base.WithRetryPolicy(RetryPolicy{
+		BaseDelay: 100 * time.Millisecond, MaxDelay: 250 * time.Millisecond, Multiplier: 2, MaxAttempts: 4,
+	})
+	if base.retryPolicy != nil {
+		t.Error("WithRetryPolicy() modified the original LedgerApi")
+	}
+
+	err := ledger.PublishRawTransactionWithRetry(new(nom.AccountBlock), -1)
+	if err == nil || !strings.Contains(err.Error(), "after 4 attempts") {
+		t.Fatalf("PublishRawTransactionWithRetry() error = %v, want failure after 4 attempts", err)
+	}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 250 * time.Millisecond}
+	if !reflect.DeepEqual(sleeps, want) {
+		t.Errorf("sleeps = %v, want %v", sleeps, want)
+	}
+	if caller.calls != 4 {
+		t.Errorf("calls = %d, want 4", caller.calls)
+	}
+}
+
+func TestPublishRawTransactionWithRetry_PermanentErrorDoesNotSleep(t *testing.T) {
+	var sleeps []time.Duration
+	ledger, _ := retryingLedger(0, &sleeps)
+	ledger.client = &sequenceCaller{steps: []func(interface{}) error{
+		func(interface{}) error { return errors.New("invalid signature") },
+	}}
+	if err := ledger.PublishRawTransactionWithRetry(new(nom.AccountBlock), 3); err == nil {
+		t.Fatal("PublishRawTransactionWithRetry() should fail on a permanent error")
+	}
+	if len(sleeps) != 0 {
+		t.Errorf("sleeps = %v, want none", sleeps)
+	}
+}
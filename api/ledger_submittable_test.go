@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/pow"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	gozenonpow "github.com/zenon-network/go-zenon/pow"
+)
+
+type jsonResultCaller struct {
+	response string
+	err      error
+	method   string
+}
+
+func (c *jsonResultCaller) Call(result interface{}, method string, _ ...interface{}) error {
+	c.method = method
+	if c.err != nil {
+		return c.err
+	}
+	return json.Unmarshal([]byte(c.response), result)
+}
+
+func submittableTestBlock() *nom.AccountBlock {
+	return &nom.AccountBlock{
+		BlockType:    nom.BlockTypeUserSend,
+		Address:      types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7"),
+		ToAddress:    types.PlasmaContract,
+		PreviousHash: types.HexToHashPanic("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"),
+	}
+}
+
+func TestCheckSubmittableAcceptsSufficientPlasma(t *testing.T) {
+	caller := &jsonResultCaller{response: `{"availablePlasma":21000,"basePlasma":21000,"requiredDifficulty":0}`}
+	if err := NewLedgerApi(caller).CheckSubmittable(submittableTestBlock()); err != nil {
+		t.Fatalf("CheckSubmittable() error = %v", err)
+	}
+	if caller.method != "embedded.plasma.getRequiredPoWForAccountBlock" {
+		t.Fatalf("method = %q", caller.method)
+	}
+}
+
+func TestCheckSubmittableRejectsMissingPlasmaAndPoW(t *testing.T) {
+	caller := &jsonResultCaller{response: `{"availablePlasma":0,"basePlasma":21000,"requiredDifficulty":1500}`}
+	err := NewLedgerApi(caller).CheckSubmittable(submittableTestBlock())
+	if !errors.Is(err, ErrNotSubmittable) {
+		t.Fatalf("CheckSubmittable() error = %v, want ErrNotSubmittable", err)
+	}
+	if !strings.Contains(err.Error(), "difficulty 1500 or 21000 plasma") {
+		t.Fatalf("error message = %q", err)
+	}
+}
+
+func TestCheckSubmittableValidatesNonce(t *testing.T) {
+	caller := &jsonResultCaller{response: `{"availablePlasma":0,"basePlasma":21000,"requiredDifficulty":1500}`}
+	ledger := NewLedgerApi(caller)
+
+	block := submittableTestBlock()
+	block.Difficulty = 1500
+	copy(block.Nonce.Data[:], pow.GeneratePowBytes(gozenonpow.GetAccountBlockHash(block), block.Difficulty))
+	if err := ledger.CheckSubmittable(block); err != nil {
+		t.Fatalf("CheckSubmittable() valid nonce error = %v", err)
+	}
+
+	lowDifficulty := submittableTestBlock()
+	lowDifficulty.Difficulty = 10
+	copy(lowDifficulty.Nonce.Data[:], pow.GeneratePowBytes(gozenonpow.GetAccountBlockHash(lowDifficulty), 10))
+	if err := ledger.CheckSubmittable(lowDifficulty); !errors.Is(err, ErrNotSubmittable) {
+		t.Fatalf("CheckSubmittable() low difficulty error = %v, want ErrNotSubmittable", err)
+	}
+
+	badNonce := submittableTestBlock()
+	badNonce.Difficulty = 1 << 40
+	badNonce.Nonce.Data = [8]byte{1}
+	if err := ledger.CheckSubmittable(badNonce); !errors.Is(err, ErrNotSubmittable) {
+		t.Fatalf("CheckSubmittable() invalid nonce error = %v, want ErrNotSubmittable", err)
+	}
+}
+
+func TestCheckSubmittablePropagatesQueryErrors(t *testing.T) {
+	want := errors.New("rpc unavailable")
+	err := NewLedgerApi(&jsonResultCaller{err: want}).CheckSubmittable(submittableTestBlock())
+	if !errors.Is(err, want) {
+		t.Fatalf("CheckSubmittable() error = %v, want %v", err, want)
+	}
+	if err := NewLedgerApi(nil).CheckSubmittable(nil); !errors.Is(err, ErrNotSubmittable) {
+		t.Fatalf("CheckSubmittable(nil) error = %v, want ErrNotSubmittable", err)
+	}
+}
@@ -0,0 +1,305 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/api"
+	"github.com/0x3639/znn-sdk-go/rpc_client"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	nodeapi "github.com/zenon-network/go-zenon/rpc/api"
+)
+
+var unreceivedAddress = types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+
+func unreceivedBlock(n int, zts types.ZenonTokenStandard, amount int64) *nodeapi.AccountBlock {
+	return &nodeapi.AccountBlock{AccountBlock: nom.AccountBlock{
+		BlockType:     nom.BlockTypeUserSend,
+		Hash:          types.HexToHashPanic(fmt.Sprintf("%064x", n)),
+		TokenStandard: zts,
+		Amount:        big.NewInt(amount),
+	}}
+}
+
+// unreceivedMailbox answers ledger.getUnreceivedBlocksByAddress the way
+// go-zenon does: every page is cut from one list of at most 500 blocks, Count
+// is that list's length, More is set only once the mailbox holds 500 blocks,
+// and page indices from 10 on are rejected.
+func unreceivedMailbox(blocks []*nodeapi.AccountBlock) rpc_client.MockHandler {
+	return func(params []interface{}) (interface{}, error) {
+		pageIndex, pageSize := int(params[1].(uint32)), int(params[2].(uint32))
+		if pageIndex >= 10 {
+			return nil, errors.New("page index param too big")
+		}
+		listed := blocks[:min(len(blocks), 500)]
+		start := min(pageIndex*pageSize, len(listed))
+		end := min(start+pageSize, len(listed))
+		return &nodeapi.AccountBlockList{List: listed[start:end], Count: len(listed), More: len(blocks) >= 500}, nil
+	}
+}
+
+// mixedBlocks returns n blocks alternating ZNN amounts of 10 and QSR amounts
+// of 1, numbered from 1.
+func mixedBlocks(n int) []*nodeapi.AccountBlock {
+	blocks := make([]*nodeapi.AccountBlock, n)
+	for i := range blocks {
+		if i%2 == 0 {
+			blocks[i] = unreceivedBlock(i+1, types.ZnnTokenStandard, 10)
+		} else {
+			blocks[i] = unreceivedBlock(i+1, types.QsrTokenStandard, 1)
+		}
+	}
+	return blocks
+}
+
+func TestGetAllUnreceivedBlocksPaging(t *testing.T) {
+	tests := []struct {
+		name      string
+		pending   int
+		wantPages int
+		wantZnn   int64
+		wantQsr   int64
+	}{
+		{name: "nothing pending", pending: 0, wantPages: 1},
+		{name: "one page", pending: 7, wantPages: 1, wantZnn: 40, wantQsr: 3},
+		{name: "exactly one full page", pending: 50, wantPages: 1, wantZnn: 250, wantQsr: 25},
+		{name: "across pages", pending: 120, wantPages: 3, wantZnn: 600, wantQsr: 60},
+		{name: "last listable page", pending: 499, wantPages: 10, wantZnn: 2500, wantQsr: 249},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := rpc_client.NewMockClient().OnFunc("ledger.getUnreceivedBlocksByAddress", unreceivedMailbox(mixedBlocks(tt.pending)))
+			ledger := api.NewLedgerApi(mock)
+
+			blocks, err := ledger.GetAllUnreceivedBlocks(unreceivedAddress)
+			if err != nil {
+				t.Fatalf("GetAllUnreceivedBlocks() error = %v", err)
+			}
+			if len(blocks) != tt.pending {
+				t.Fatalf("GetAllUnreceivedBlocks() = %d blocks, want %d", len(blocks), tt.pending)
+			}
+			for i, block := range blocks {
+				if want := unreceivedBlock(i+1, block.TokenStandard, 0).Hash; block.Hash != want {
+					t.Fatalf("blocks[%d].Hash = %s, want %s", i, block.Hash, want)
+				}
+			}
+			calls := mock.CallsTo("ledger.getUnreceivedBlocksByAddress")
+			if len(calls) != tt.wantPages {
+				t.Errorf("requested %d pages, want %d", len(calls), tt.wantPages)
+			}
+			if len(calls) > 0 && calls[0].Params[0] != unreceivedAddress.String() {
+				t.Errorf("address param = %v, want %s", calls[0].Params[0], unreceivedAddress)
+			}
+
+			znn, err := ledger.SumUnreceived(unreceivedAddress, types.ZnnTokenStandard)
+			if err != nil || znn.Cmp(big.NewInt(tt.wantZnn)) != 0 {
+				t.Errorf("SumUnreceived(ZNN) = %v, %v; want %d", znn, err, tt.wantZnn)
+			}
+			qsr, err := ledger.SumUnreceived(unreceivedAddress, types.QsrTokenStandard)
+			if err != nil || qsr.Cmp(big.NewInt(tt.wantQsr)) != 0 {
+				t.Errorf("SumUnreceived(QSR) = %v, %v; want %d", qsr, err, tt.wantQsr)
+			}
+		})
+	}
+}
+
+func TestGetAllUnreceivedBlocksTooMany(t *testing.T) {
+	for _, pending := range []int{api.MaxUnreceivedBlocks, 2000} {
+		mock := rpc_client.NewMockClient().OnFunc("ledger.getUnreceivedBlocksByAddress", unreceivedMailbox(mixedBlocks(pending)))
+
+		_, err := api.NewLedgerApi(mock).GetAllUnreceivedBlocks(unreceivedAddress)
+		if !errors.Is(err, api.ErrTooManyUnreceived) {
+			t.Errorf("%d pending: GetAllUnreceivedBlocks() error = %v, want ErrTooManyUnreceived", pending, err)
+		}
+		calls := mock.CallsTo("ledger.getUnreceivedBlocksByAddress")
+		if len(calls) != 10 {
+			t.Errorf("%d pending: requested %d pages, want 10", pending, len(calls))
+		}
+	}
+}
+
+func TestUnreceivedBlocksPageStopsAtLastServedIndex(t *testing.T) {
+	tests := []struct {
+		name      string
+		pending   int
+		pageSize  uint32
+		wantPages int
+		wantTotal int
+	}{
+		{"fits in a few pages", 30, 20, 2, 30},
+		{"full mailbox", 600, 50, 10, 500},
+		{"small pages cut at index 9", 600, 20, 10, 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := rpc_client.NewMockClient().OnFunc("ledger.getUnreceivedBlocksByAddress", unreceivedMailbox(mixedBlocks(tt.pending)))
+			p, err := api.NewLedgerApi(mock).GetUnreceivedBlocksPage(unreceivedAddress, 0, tt.pageSize)
+			seen := 0
+			for err == nil {
+				seen += len(p.List)
+				if !p.HasNext() {
+					break
+				}
+				p, err = p.Next(context.Background())
+			}
+			if err != nil {
+				t.Fatalf("walk error = %v", err)
+			}
+			if pages := len(mock.Calls()); pages != tt.wantPages || seen != tt.wantTotal {
+				t.Errorf("walked %d pages with %d blocks, want %d pages with %d", pages, seen, tt.wantPages, tt.wantTotal)
+			}
+			if _, err := p.Next(context.Background()); !errors.Is(err, api.ErrNoNextPage) {
+				t.Errorf("Next() on last page error = %v, want ErrNoNextPage", err)
+			}
+		})
+	}
+}
+
+func TestGetAllUnreceivedBlocksError(t *testing.T) {
+	nodeErr := errors.New("node unavailable")
+	mock := rpc_client.NewMockClient().OnError("ledger.getUnreceivedBlocksByAddress", nodeErr)
+	if _, err := api.NewLedgerApi(mock).GetAllUnreceivedBlocks(unreceivedAddress); !errors.Is(err, nodeErr) {
+		t.Errorf("GetAllUnreceivedBlocks() error = %v, want the RPC error", err)
+	}
+	if _, err := api.NewLedgerApi(mock).SumUnreceived(unreceivedAddress, types.ZnnTokenStandard); !errors.Is(err, nodeErr) {
+		t.Errorf("SumUnreceived() error = %v, want the RPC error", err)
+	}
+}
+
+func TestGetEffectiveBalance(t *testing.T) {
+	accountInfo := `{"address":"z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7","accountHeight":3,"balanceInfoMap":{` +
+		`"zts1znnxxxxxxxxxxxxx9z4ulx":{"token":{"decimals":8},"balance":"150000000"}}}`
+	mock := rpc_client.NewMockClient().
+		On("ledger.getAccountInfoByAddress", json.RawMessage(accountInfo)).
+		OnFunc("ledger.getUnreceivedBlocksByAddress", unreceivedMailbox(mixedBlocks(70)))
+	ledger := api.NewLedgerApi(mock)
+
+	confirmed, pending, err := ledger.GetEffectiveBalance(unreceivedAddress, types.ZnnTokenStandard)
+	if err != nil {
+		t.Fatalf("GetEffectiveBalance() error = %v", err)
+	}
+	if confirmed.Cmp(big.NewInt(150000000)) != 0 || pending.Cmp(big.NewInt(350)) != 0 {
+		t.Errorf("GetEffectiveBalance(ZNN) = %s, %s; want 150000000, 350", confirmed, pending)
+	}
+
+	confirmed, pending, err = ledger.GetEffectiveBalance(unreceivedAddress, types.QsrTokenStandard)
+	if err != nil {
+		t.Fatalf("GetEffectiveBalance(QSR) error = %v", err)
+	}
+	if confirmed.Sign() != 0 || pending.Cmp(big.NewInt(35)) != 0 {
+		t.Errorf("GetEffectiveBalance(QSR) = %s, %s; want 0, 35", confirmed, pending)
+	}
+}
+
+func TestGetEffectiveBalanceErrors(t *testing.T) {
+	infoErr := errors.New("connection refused")
+	mock := rpc_client.NewMockClient().OnError("ledger.getAccountInfoByAddress", infoErr)
+	if _, _, err := api.NewLedgerApi(mock).GetEffectiveBalance(unreceivedAddress, types.ZnnTokenStandard); !errors.Is(err, infoErr) {
+		t.Errorf("account info error = %v, want %v", err, infoErr)
+	}
+
+	pagesErr := errors.New("node busy")
+	mock = rpc_client.NewMockClient().
+		On("ledger.getAccountInfoByAddress", json.RawMessage(`{"address":"z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7"}`)).
+		OnError("ledger.getUnreceivedBlocksByAddress", pagesErr)
+	if _, _, err := api.NewLedgerApi(mock).GetEffectiveBalance(unreceivedAddress, types.ZnnTokenStandard); !errors.Is(err, pagesErr) {
+		t.Errorf("unreceived error = %v, want %v", err, pagesErr)
+	}
+}
+
+func TestGetUnreceivedAboveThreshold(t *testing.T) {
+	tests := []struct {
+		name              string
+		minAmount         *big.Int
+		includeZeroAmount bool
+		want              []int
+	}{
+		{name: "no threshold", minAmount: nil, want: []int{1, 2, 3, 4, 5}},
+		{name: "zero threshold", minAmount: big.NewInt(0), want: []int{1, 2, 3, 4, 5}},
+		{name: "skips dust", minAmount: big.NewInt(1000), want: []int{2, 4}},
+		{name: "keeps zero amount", minAmount: big.NewInt(1000), includeZeroAmount: true, want: []int{2, 3, 4, 5}},
+	}
+	pending := []*nodeapi.AccountBlock{
+		unreceivedBlock(1, types.ZnnTokenStandard, 1),         // dust
+		unreceivedBlock(2, types.ZnnTokenStandard, 100000000), // payment
+		unreceivedBlock(3, types.QsrTokenStandard, 0),         // contract notification
+		unreceivedBlock(4, types.QsrTokenStandard, 1000),      // exactly the threshold
+		{AccountBlock: nom.AccountBlock{Hash: types.HexToHashPanic(fmt.Sprintf("%064x", 5)), TokenStandard: types.ZnnTokenStandard}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := rpc_client.NewMockClient().OnFunc("ledger.getUnreceivedBlocksByAddress", unreceivedMailbox(pending))
+			blocks, more, err := api.NewLedgerApi(mock).GetUnreceivedAboveThreshold(unreceivedAddress, tt.minAmount, tt.includeZeroAmount)
+			if err != nil || more {
+				t.Fatalf("GetUnreceivedAboveThreshold() more = %v, error = %v", more, err)
+			}
+			if got, want := blockNumbers(blocks), tt.want; !slices.Equal(got, want) {
+				t.Errorf("GetUnreceivedAboveThreshold() = blocks %v, want %v", got, want)
+			}
+		})
+	}
+
+	nodeErr := errors.New("node unavailable")
+	mock := rpc_client.NewMockClient().OnError("ledger.getUnreceivedBlocksByAddress", nodeErr)
+	if _, _, err := api.NewLedgerApi(mock).GetUnreceivedAboveThreshold(unreceivedAddress, big.NewInt(1), false); !errors.Is(err, nodeErr) {
+		t.Errorf("GetUnreceivedAboveThreshold() error = %v, want the RPC error", err)
+	}
+}
+
+// TestGetUnreceivedAboveThresholdBehindDust checks that a payment listed after
+// more than a page of dust is still found.
+func TestGetUnreceivedAboveThresholdBehindDust(t *testing.T) {
+	var pending []*nodeapi.AccountBlock
+	for i := 1; i <= 60; i++ {
+		pending = append(pending, unreceivedBlock(i, types.ZnnTokenStandard, 1))
+	}
+	pending = append(pending, unreceivedBlock(61, types.ZnnTokenStandard, 500000000))
+	mock := rpc_client.NewMockClient().OnFunc("ledger.getUnreceivedBlocksByAddress", unreceivedMailbox(pending))
+
+	blocks, _, err := api.NewLedgerApi(mock).GetUnreceivedAboveThreshold(unreceivedAddress, big.NewInt(100000000), false)
+	if err != nil {
+		t.Fatalf("GetUnreceivedAboveThreshold() error = %v", err)
+	}
+	if got := blockNumbers(blocks); !slices.Equal(got, []int{61}) {
+		t.Errorf("GetUnreceivedAboveThreshold() = blocks %v, want [61]", got)
+	}
+	if calls := mock.CallsTo("ledger.getUnreceivedBlocksByAddress"); len(calls) != 2 {
+		t.Errorf("requested %d pages, want 2", len(calls))
+	}
+}
+
+// TestGetUnreceivedAboveThresholdFloodedMailbox checks that a payment among
+// the 500 listed blocks of a dust-flooded address is returned with more set
+// instead of an ErrTooManyUnreceived failure.
+func TestGetUnreceivedAboveThresholdFloodedMailbox(t *testing.T) {
+	var pending []*nodeapi.AccountBlock
+	for i := 1; i <= 600; i++ {
+		pending = append(pending, unreceivedBlock(i, types.ZnnTokenStandard, 1))
+	}
+	pending[299].Amount = big.NewInt(500000000)
+	mock := rpc_client.NewMockClient().OnFunc("ledger.getUnreceivedBlocksByAddress", unreceivedMailbox(pending))
+
+	blocks, more, err := api.NewLedgerApi(mock).GetUnreceivedAboveThreshold(unreceivedAddress, big.NewInt(100000000), false)
+	if err != nil {
+		t.Fatalf("GetUnreceivedAboveThreshold() error = %v", err)
+	}
+	if got := blockNumbers(blocks); !slices.Equal(got, []int{300}) || !more {
+		t.Errorf("GetUnreceivedAboveThreshold() = blocks %v, more %v; want [300], true", got, more)
+	}
+}
+
+// blockNumbers returns the number each block was created with by
+// unreceivedBlock.
+func blockNumbers(blocks []*nodeapi.AccountBlock) []int {
+	numbers := make([]int, len(blocks))
+	for i, block := range blocks {
+		numbers[i] = int(new(big.Int).SetBytes(block.Hash.Bytes()).Int64())
+	}
+	return numbers
+}
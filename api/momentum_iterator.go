@@ -0,0 +1,208 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/0x3639/znn-sdk-go/internal/rpcvalidation"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// DetailedMomentumIterator walks detailed momentums in height order, fetching
+// them from the node in chunks.
+//
+// Create one with LedgerApi.IterateDetailedMomentums and drive it like a
+// bufio.Scanner: call Next until it returns false, read each momentum with
+// Momentum, then check Err.
+//
+// By default iteration ends at the frontier momentum observed while iterating.
+// Set Follow before the first call to Next to keep waiting for new momentums
+// instead; iteration then ends only when the context is done or a request
+// fails.
+type DetailedMomentumIterator struct {
+	// Follow keeps the iterator polling for new momentums after it reaches the
+	// frontier.
+	Follow bool
+	// PollInterval is the delay between frontier checks while following.
+	// Values <= 0 default to one second.
+	PollInterval time.Duration
+
+	ledger   *LedgerApi
+	ctx      context.Context
+	chunk    uint64
+	next     uint64
+	frontier uint64
+	buffer   []*api.DetailedMomentum
+	current  *api.DetailedMomentum
+	err      error
+	done     bool
+}
+
+// IterateDetailedMomentums returns an iterator over detailed momentums from
+// startHeight up to the current frontier.
+//
+// Parameters:
+//   - ctx: Bounds the iteration; when it is done, Next returns false and Err
+//     returns ctx.Err()
+//   - startHeight: First momentum height to yield (0 is treated as 1, the
+//     genesis momentum)
+//   - chunk: Number of momentums fetched per ledger.getDetailedMomentumsByHeight
+//     request (1 to 1024)
+//
+// Returns the iterator, or an error if chunk is out of range. A startHeight
+// beyond the frontier is not an error; the iterator simply yields nothing
+// (or, with Follow set, waits for that height to be produced).
+//
+// Example - Index every momentum from height 1000 to the frontier:
+//
+//	it, err := client.LedgerApi.IterateDetailedMomentums(ctx, 1000, 100)
+//	if err != nil {
+//	    return err
+//	}
+//	for it.Next() {
+//	    dm := it.Momentum()
+//	    fmt.Printf("Momentum %d: %d blocks\n", dm.Momentum.Height, len(dm.AccountBlocks))
+//	}
+//	if err := it.Err(); err != nil {
+//	    return err
+//	}
+//
+// Example - Keep indexing new momentums as they are produced:
+//
+//	it, _ := client.LedgerApi.IterateDetailedMomentums(ctx, lastIndexed+1, 100)
+//	it.Follow = true
+//	it.PollInterval = 5 * time.Second
+//	for it.Next() {
+//	    index(it.Momentum())
+//	}
+func (la *LedgerApi) IterateDetailedMomentums(ctx context.Context, startHeight uint64, chunk uint64) (*DetailedMomentumIterator, error) {
+	if chunk == 0 {
+		return nil, errors.New("ledger.getDetailedMomentumsByHeight: count must be positive")
+	}
+	if err := rpcvalidation.ValidateLimit("ledger.getDetailedMomentumsByHeight", "count", chunk, rpcvalidation.MaxPageSize); err != nil {
+		return nil, err
+	}
+	if startHeight == 0 {
+		startHeight = 1
+	}
+	return &DetailedMomentumIterator{
+		ledger: la,
+		ctx:    ctx,
+		chunk:  chunk,
+		next:   startHeight,
+	}, nil
+}
+
+// Next advances to the next detailed momentum, fetching another chunk when
+// needed. It returns false when iteration is finished or an error occurred.
+func (it *DetailedMomentumIterator) Next() bool {
+	it.current = nil
+	for !it.done {
+		if err := it.ctx.Err(); err != nil {
+			return it.fail(err)
+		}
+		if len(it.buffer) > 0 {
+			it.current = it.buffer[0]
+			it.buffer = it.buffer[1:]
+			return true
+		}
+		if it.next > it.frontier {
+			if err := it.refreshFrontier(); err != nil {
+				return it.fail(err)
+			}
+			if it.next > it.frontier {
+				if !it.Follow {
+					it.done = true
+					return false
+				}
+				if err := it.wait(); err != nil {
+					return it.fail(err)
+				}
+				continue
+			}
+		}
+		fetched, err := it.fetch()
+		if err != nil {
+			return it.fail(err)
+		}
+		if !fetched {
+			// The node returned nothing for heights it reported as produced;
+			// stop rather than requesting the same range forever.
+			if !it.Follow {
+				it.done = true
+				return false
+			}
+			if err := it.wait(); err != nil {
+				return it.fail(err)
+			}
+		}
+	}
+	return false
+}
+
+// Momentum returns the detailed momentum at the current position. It is only
+// valid after a call to Next that returned true.
+func (it *DetailedMomentumIterator) Momentum() *api.DetailedMomentum {
+	return it.current
+}
+
+// Err returns the error that stopped iteration, or nil if it ended at the
+// frontier.
+func (it *DetailedMomentumIterator) Err() error {
+	return it.err
+}
+
+func (it *DetailedMomentumIterator) refreshFrontier() error {
+	frontier, err := it.ledger.GetFrontierMomentum()
+	if err != nil {
+		return err
+	}
+	if frontier.Momentum != nil {
+		it.frontier = frontier.Height
+	}
+	return nil
+}
+
+// fetch loads the next chunk into the buffer and reports whether any momentum
+// was added.
+func (it *DetailedMomentumIterator) fetch() (bool, error) {
+	count := it.frontier - it.next + 1
+	if count > it.chunk {
+		count = it.chunk
+	}
+	list, err := it.ledger.GetDetailedMomentumsByHeight(it.next, count)
+	if err != nil {
+		return false, err
+	}
+	for _, dm := range list.List {
+		if dm == nil || dm.Momentum == nil || dm.Momentum.Momentum == nil || dm.Momentum.Height < it.next {
+			continue
+		}
+		it.buffer = append(it.buffer, dm)
+		it.next = dm.Momentum.Height + 1
+	}
+	return len(it.buffer) > 0, nil
+}
+
+func (it *DetailedMomentumIterator) wait() error {
+	interval := it.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	select {
+	case <-it.ctx.Done():
+		return it.ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (it *DetailedMomentumIterator) fail(err error) bool {
+	it.err = err
+	it.done = true
+	it.current = nil
+	return false
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// momentumChainCaller serves a chain of momentums 1..frontier, growing the
+// frontier by grow after every frontier lookup.
+type momentumChainCaller struct {
+	frontier uint64
+	grow     uint64
+	requests [][2]uint64
+}
+
+func (c *momentumChainCaller) Call(result interface{}, method string, args ...interface{}) error {
+	switch method {
+	case "ledger.getFrontierMomentum":
+		result.(*api.Momentum).Momentum = &nom.Momentum{Height: c.frontier}
+		c.frontier += c.grow
+	case "ledger.getDetailedMomentumsByHeight":
+		height, count := args[0].(uint64), args[1].(uint64)
+		c.requests = append(c.requests, [2]uint64{height, count})
+		list := result.(*api.DetailedMomentumList)
+		for h := height; h < height+count && h <= c.frontier; h++ {
+			list.List = append(list.List, &api.DetailedMomentum{
+				Momentum: &api.Momentum{Momentum: &nom.Momentum{Height: h}},
+			})
+		}
+		list.Count = len(list.List)
+	case "ledger.getMomentumsByHeight":
+		height, count := args[0].(uint64), args[1].(uint64)
+		c.requests = append(c.requests, [2]uint64{height, count})
+		list := result.(*api.MomentumList)
+		for h := height; h < height+count && h <= c.frontier; h++ {
+			list.List = append(list.List, &api.Momentum{Momentum: &nom.Momentum{Height: h}})
+		}
+		list.Count = int(c.frontier)
+	default:
+		return errors.New("unexpected method " + method)
+	}
+	return nil
+}
+
+func collectHeights(t *testing.T, it *DetailedMomentumIterator, limit int) []uint64 {
+	t.Helper()
+	var heights []uint64
+	for len(heights) < limit && it.Next() {
+		heights = append(heights, it.Momentum().Momentum.Height)
+	}
+	return heights
+}
+
+func TestIterateDetailedMomentumsStopsAtFrontier(t *testing.T) {
+	caller := &momentumChainCaller{frontier: 10}
+	it, err := NewLedgerApi(caller).IterateDetailedMomentums(context.Background(), 3, 4)
+	if err != nil {
+		t.Fatalf("IterateDetailedMomentums() error = %v", err)
+	}
+	heights := collectHeights(t, it, 100)
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v", it.Err())
+	}
+	if len(heights) != 8 || heights[0] != 3 || heights[7] != 10 {
+		t.Fatalf("heights = %v, want 3..10", heights)
+	}
+	want := [][2]uint64{{3, 4}, {7, 4}}
+	if len(caller.requests) != len(want) {
+		t.Fatalf("requests = %v, want %v", caller.requests, want)
+	}
+	for i := range want {
+		if caller.requests[i] != want[i] {
+			t.Fatalf("requests = %v, want %v", caller.requests, want)
+		}
+	}
+	if it.Next() {
+		t.Fatal("Next() = true after iteration finished")
+	}
+}
+
+func TestIterateDetailedMomentumsBeyondFrontierYieldsNothing(t *testing.T) {
+	caller := &momentumChainCaller{frontier: 5}
+	it, err := NewLedgerApi(caller).IterateDetailedMomentums(context.Background(), 50, 10)
+	if err != nil {
+		t.Fatalf("IterateDetailedMomentums() error = %v", err)
+	}
+	if it.Next() {
+		t.Fatalf("Next() = true, yielded height %d", it.Momentum().Momentum.Height)
+	}
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v, want nil", it.Err())
+	}
+	if len(caller.requests) != 0 {
+		t.Fatalf("requests = %v, want none", caller.requests)
+	}
+}
+
+func TestIterateDetailedMomentumsFollowsNewMomentums(t *testing.T) {
+	caller := &momentumChainCaller{frontier: 2, grow: 1}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	it, err := NewLedgerApi(caller).IterateDetailedMomentums(ctx, 0, 10)
+	if err != nil {
+		t.Fatalf("IterateDetailedMomentums() error = %v", err)
+	}
+	it.Follow = true
+	it.PollInterval = time.Millisecond
+
+	heights := collectHeights(t, it, 6)
+	for i, height := range heights {
+		if height != uint64(i+1) {
+			t.Fatalf("heights = %v, want 1..6", heights)
+		}
+	}
+	if len(heights) != 6 {
+		t.Fatalf("heights = %v, want 1..6", heights)
+	}
+}
+
+func TestIterateDetailedMomentumsFollowStopsOnContext(t *testing.T) {
+	caller := &momentumChainCaller{frontier: 1}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	it, err := NewLedgerApi(caller).IterateDetailedMomentums(ctx, 1, 10)
+	if err != nil {
+		t.Fatalf("IterateDetailedMomentums() error = %v", err)
+	}
+	it.Follow = true
+	it.PollInterval = time.Millisecond
+
+	collectHeights(t, it, 100)
+	if !errors.Is(it.Err(), context.DeadlineExceeded) {
+		t.Fatalf("Err() = %v, want context.DeadlineExceeded", it.Err())
+	}
+}
+
+func TestIterateDetailedMomentumsValidatesChunk(t *testing.T) {
+	ledger := NewLedgerApi(&momentumChainCaller{})
+	for _, chunk := range []uint64{0, 1025} {
+		if _, err := ledger.IterateDetailedMomentums(context.Background(), 1, chunk); err == nil {
+			t.Errorf("IterateDetailedMomentums(chunk=%d) error = nil", chunk)
+		}
+	}
+}
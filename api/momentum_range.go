@@ -0,0 +1,78 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/0x3639/znn-sdk-go/internal/rpcvalidation"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// GetMomentumsByRange returns the momentums from fromHeight to toHeight
+// inclusive, in height order.
+//
+// The range is fetched with as many ledger.getMomentumsByHeight requests of at
+// most 1024 momentums as it needs. toHeight is clamped to the frontier
+// momentum read at the start of the call, so a range reaching past the
+// frontier returns what exists; a range starting past it returns an empty
+// list.
+//
+// Parameters:
+//   - fromHeight: First momentum height (0 is treated as 1, the genesis
+//     momentum)
+//   - toHeight: Last momentum height, at least fromHeight
+//
+// Returns the momentums with Count set to the number returned, an error if
+// fromHeight is greater than toHeight, or the RPC error.
+//
+// Example:
+//
+//	list, err := client.LedgerApi.GetMomentumsByRange(1000, 4999)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, m := range list.List {
+//	    fmt.Printf("Momentum %d: %d blocks\n", m.Height, len(m.Content))
+//	}
+func (la *LedgerApi) GetMomentumsByRange(fromHeight, toHeight uint64) (*api.MomentumList, error) {
+	if fromHeight > toHeight {
+		return nil, fmt.Errorf("invalid momentum range: fromHeight %d is greater than toHeight %d", fromHeight, toHeight)
+	}
+	if fromHeight == 0 {
+		fromHeight = 1
+	}
+
+	frontier, err := la.GetFrontierMomentum()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get frontier momentum: %w", err)
+	}
+	if frontier.Momentum != nil && toHeight > frontier.Height {
+		toHeight = frontier.Height
+	}
+
+	result := &api.MomentumList{List: []*api.Momentum{}}
+	for next := fromHeight; next <= toHeight; {
+		count := toHeight - next + 1
+		if count > rpcvalidation.MaxPageSize {
+			count = rpcvalidation.MaxPageSize
+		}
+		list, err := la.GetMomentumsByHeight(next, count)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get momentums %d-%d: %w", next, next+count-1, err)
+		}
+		start := next
+		for _, m := range list.List {
+			if m == nil || m.Momentum == nil || m.Height < next || m.Height > toHeight {
+				continue
+			}
+			result.List = append(result.List, m)
+			next = m.Height + 1
+		}
+		if next == start {
+			// The node returned nothing for heights below the frontier; stop
+			// rather than requesting the same range forever.
+			break
+		}
+	}
+	result.Count = len(result.List)
+	return result, nil
+}
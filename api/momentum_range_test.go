@@ -0,0 +1,73 @@
+package api
+
+import "testing"
+
+func TestGetMomentumsByRange(t *testing.T) {
+	tests := []struct {
+		name         string
+		from, to     uint64
+		frontier     uint64
+		wantFirst    uint64
+		wantLast     uint64
+		wantRequests [][2]uint64
+	}{
+		{
+			name: "spans chunks", from: 100, to: 2600, frontier: 5000,
+			wantFirst: 100, wantLast: 2600,
+			wantRequests: [][2]uint64{{100, 1024}, {1124, 1024}, {2148, 453}},
+		},
+		{
+			name: "clamped to frontier", from: 0, to: 2000, frontier: 1500,
+			wantFirst: 1, wantLast: 1500,
+			wantRequests: [][2]uint64{{1, 1024}, {1025, 476}},
+		},
+		{
+			name: "single momentum", from: 7, to: 7, frontier: 10,
+			wantFirst: 7, wantLast: 7,
+			wantRequests: [][2]uint64{{7, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller := &momentumChainCaller{frontier: tt.frontier}
+			list, err := NewLedgerApi(caller).GetMomentumsByRange(tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("GetMomentumsByRange() error = %v", err)
+			}
+			if want := int(tt.wantLast - tt.wantFirst + 1); len(list.List) != want || list.Count != want {
+				t.Fatalf("got %d momentums (Count %d), want %d", len(list.List), list.Count, want)
+			}
+			for i, m := range list.List {
+				if m.Height != tt.wantFirst+uint64(i) {
+					t.Fatalf("List[%d].Height = %d, want %d", i, m.Height, tt.wantFirst+uint64(i))
+				}
+			}
+			if len(caller.requests) != len(tt.wantRequests) {
+				t.Fatalf("requests = %v, want %v", caller.requests, tt.wantRequests)
+			}
+			for i := range tt.wantRequests {
+				if caller.requests[i] != tt.wantRequests[i] {
+					t.Fatalf("requests = %v, want %v", caller.requests, tt.wantRequests)
+				}
+			}
+		})
+	}
+}
+
+func TestGetMomentumsByRangeBeyondFrontier(t *testing.T) {
+	caller := &momentumChainCaller{frontier: 10}
+	list, err := NewLedgerApi(caller).GetMomentumsByRange(20, 30)
+	if err != nil {
+		t.Fatalf("GetMomentumsByRange() error = %v", err)
+	}
+	if len(list.List) != 0 || len(caller.requests) != 0 {
+		t.Fatalf("got %d momentums after %v, want none", len(list.List), caller.requests)
+	}
+}
+
+func TestGetMomentumsByRangeRejectsReversedRange(t *testing.T) {
+	caller := &momentumChainCaller{frontier: 10}
+	if _, err := NewLedgerApi(caller).GetMomentumsByRange(5, 4); err == nil {
+		t.Fatal("GetMomentumsByRange(5, 4) error = nil, want error")
+	}
+}
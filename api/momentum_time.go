@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0x3639/znn-sdk-go/transport"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// jsonRPCMethodNotFound is the JSON-RPC error code for an unknown method.
+const jsonRPCMethodNotFound = -32601
+
+// FindMomentumHeightByTime returns the height of the momentum whose timestamp
+// is nearest to t.
+//
+// It asks the node for the last momentum before t with
+// ledger.getMomentumBeforeTime and then compares it with the following
+// momentum. Nodes that do not implement that method are searched instead by
+// bisecting heights with ledger.getMomentumsByHeight, which takes about
+// log2(frontier height) requests.
+//
+// Parameters:
+//   - ctx: Bounds the search; it is checked between requests
+//   - t: Time to look up; only whole seconds are compared, as momentum
+//     timestamps have one-second resolution
+//
+// Returns the nearest height, preferring the earlier momentum when two are
+// equally near. Times at or before genesis return 1 and times at or after the
+// frontier momentum return the frontier height. Returns an error if a request
+// fails or ctx is done.
+//
+// Example:
+//
+//	start, err := client.LedgerApi.FindMomentumHeightByTime(ctx, time.Now().Add(-24*time.Hour))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("Momentum height 24h ago: %d\n", start)
+func (la *LedgerApi) FindMomentumHeightByTime(ctx context.Context, t time.Time) (uint64, error) {
+	target := t.Unix()
+
+	frontier, err := la.GetFrontierMomentum()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get frontier momentum: %w", err)
+	}
+	if frontier.Momentum == nil {
+		return 0, errors.New("node returned no frontier momentum")
+	}
+	if target >= momentumTime(frontier) {
+		return frontier.Height, nil
+	}
+
+	genesis, err := la.momentumAt(ctx, 1)
+	if err != nil {
+		return 0, err
+	}
+	if target <= momentumTime(genesis) {
+		return 1, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	before, err := la.GetMomentumBeforeTime(target)
+	switch {
+	case isMethodNotFound(err):
+		return la.searchMomentumHeight(ctx, target, frontier.Height)
+	case err != nil:
+		return 0, fmt.Errorf("failed to get momentum before %d: %w", target, err)
+	case before.Momentum == nil:
+		return 1, nil
+	}
+	return la.nearestMomentumHeight(ctx, target, before.Height)
+}
+
+// searchMomentumHeight bisects [1, frontierHeight] for the last momentum at
+// or before target, then picks the nearer of it and its successor. The
+// genesis momentum is known to be at or before target and the frontier after
+// it.
+func (la *LedgerApi) searchMomentumHeight(ctx context.Context, target int64, frontierHeight uint64) (uint64, error) {
+	low, high := uint64(1), frontierHeight
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		momentum, err := la.momentumAt(ctx, mid)
+		if err != nil {
+			return 0, err
+		}
+		if momentumTime(momentum) <= target {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return la.nearestMomentumHeight(ctx, target, low)
+}
+
+// nearestMomentumHeight returns height or height+1, whichever momentum is
+// nearer to target, given that the momentum at height is not after target.
+func (la *LedgerApi) nearestMomentumHeight(ctx context.Context, target int64, height uint64) (uint64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	list, err := la.GetMomentumsByHeight(height, 2)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get momentums at height %d: %w", height, err)
+	}
+	if len(list.List) == 0 || list.List[0] == nil || list.List[0].Momentum == nil {
+		return 0, fmt.Errorf("node returned no momentum at height %d", height)
+	}
+	if len(list.List) < 2 || list.List[1] == nil || list.List[1].Momentum == nil {
+		return height, nil
+	}
+	if momentumTime(list.List[1])-target < target-momentumTime(list.List[0]) {
+		return height + 1, nil
+	}
+	return height, nil
+}
+
+// momentumAt fetches the momentum at height, checking ctx first.
+func (la *LedgerApi) momentumAt(ctx context.Context, height uint64) (*api.Momentum, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	list, err := la.GetMomentumsByHeight(height, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get momentum at height %d: %w", height, err)
+	}
+	if len(list.List) == 0 || list.List[0] == nil || list.List[0].Momentum == nil {
+		return nil, fmt.Errorf("node returned no momentum at height %d", height)
+	}
+	return list.List[0], nil
+}
+
+// momentumTime returns a momentum's timestamp in Unix seconds.
+func momentumTime(momentum *api.Momentum) int64 {
+	// #nosec G115 -- momentum timestamps are Unix seconds, far below MaxInt64
+	return int64(momentum.TimestampUnix)
+}
+
+// isMethodNotFound reports whether err says the node does not implement the
+// requested method.
+func isMethodNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr *transport.RPCError
+	if errors.As(err, &rpcErr) && rpcErr.Code == jsonRPCMethodNotFound {
+		return true
+	}
+	return strings.Contains(err.Error(), "does not exist/is not available")
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0x3639/znn-sdk-go/transport"
+)
+
+// timedMomentumCaller serves a chain whose momentum at height h has
+// timestamp timestamps[h-1].
+type timedMomentumCaller struct {
+	timestamps   []int64
+	noBeforeTime bool
+	methods      []string
+}
+
+func (c *timedMomentumCaller) momentumJSON(height int) string {
+	return fmt.Sprintf(`{"height":%d,"timestamp":%d}`, height, c.timestamps[height-1])
+}
+
+func (c *timedMomentumCaller) Call(result interface{}, method string, args ...interface{}) error {
+	c.methods = append(c.methods, method)
+	var response string
+	switch method {
+	case "ledger.getFrontierMomentum":
+		response = c.momentumJSON(len(c.timestamps))
+	case "ledger.getMomentumBeforeTime":
+		if c.noBeforeTime {
+			return &transport.RPCError{Code: -32601, Message: "the method ledger_getMomentumBeforeTime does not exist/is not available"}
+		}
+		response = "null"
+		for height := len(c.timestamps); height >= 1; height-- {
+			if c.timestamps[height-1] < args[0].(int64) {
+				response = c.momentumJSON(height)
+				break
+			}
+		}
+	case "ledger.getMomentumsByHeight":
+		height, count := int(args[0].(uint64)), int(args[1].(uint64))
+		var list []string
+		for h := height; h < height+count && h <= len(c.timestamps); h++ {
+			list = append(list, c.momentumJSON(h))
+		}
+		response = fmt.Sprintf(`{"list":[%s],"count":%d}`, strings.Join(list, ","), len(c.timestamps))
+	default:
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	return json.Unmarshal([]byte(response), result)
+}
+
+func (c *timedMomentumCaller) count(method string) int {
+	n := 0
+	for _, m := range c.methods {
+		if m == method {
+			n++
+		}
+	}
+	return n
+}
+
+// unevenTimestamps returns a chain of 1000 momentums starting at 1000 with
+// gaps of 10s, 20s, and 30s in turn, so heights are not a linear function of
+// time.
+func unevenTimestamps() []int64 {
+	timestamps := []int64{1000}
+	for i := 1; i < 1000; i++ {
+		timestamps = append(timestamps, timestamps[i-1]+int64(10*(i%3+1)))
+	}
+	return timestamps
+}
+
+func TestFindMomentumHeightByTime(t *testing.T) {
+	timestamps := unevenTimestamps()
+	for _, noBeforeTime := range []bool{false, true} {
+		caller := &timedMomentumCaller{timestamps: timestamps, noBeforeTime: noBeforeTime}
+		ledger := NewLedgerApi(caller)
+		frontier := uint64(len(timestamps))
+
+		tests := []struct {
+			name string
+			at   int64
+			want uint64
+		}{
+			{"before genesis", 0, 1},
+			{"at genesis", timestamps[0], 1},
+			{"exact", timestamps[499], 500},
+			{"nearer to earlier", timestamps[499] + 1, 500},
+			{"nearer to later", timestamps[500] - 1, 501},
+			{"tie prefers earlier", (timestamps[700] + timestamps[701]) / 2, 701},
+			{"second momentum", timestamps[1], 2},
+			{"before frontier", timestamps[998] + 1, frontier - 1},
+			{"at frontier", timestamps[999], frontier},
+			{"after frontier", timestamps[999] + 3600, frontier},
+		}
+		for _, tt := range tests {
+			got, err := ledger.FindMomentumHeightByTime(context.Background(), time.Unix(tt.at, 0))
+			if err != nil {
+				t.Fatalf("noBeforeTime=%v %s: error = %v", noBeforeTime, tt.name, err)
+			}
+			if got != tt.want {
+				t.Errorf("noBeforeTime=%v %s: height = %d, want %d", noBeforeTime, tt.name, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestFindMomentumHeightByTimeBisectsWithoutBeforeTime(t *testing.T) {
+	timestamps := unevenTimestamps()
+	caller := &timedMomentumCaller{timestamps: timestamps, noBeforeTime: true}
+	if _, err := NewLedgerApi(caller).FindMomentumHeightByTime(context.Background(), time.Unix(timestamps[321], 0)); err != nil {
+		t.Fatalf("FindMomentumHeightByTime() error = %v", err)
+	}
+	// genesis + ~log2(1000) probes + the final neighbour comparison
+	if got := caller.count("ledger.getMomentumsByHeight"); got > 13 {
+		t.Errorf("getMomentumsByHeight calls = %d, want at most 13", got)
+	}
+}
+
+func TestFindMomentumHeightByTimeErrors(t *testing.T) {
+	caller := &timedMomentumCaller{timestamps: unevenTimestamps(), noBeforeTime: true}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewLedgerApi(caller).FindMomentumHeightByTime(ctx, time.Unix(5000, 0)); !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled context error = %v, want context.Canceled", err)
+	}
+
+	failing := &jsonResultCaller{err: errors.New("connection refused")}
+	if _, err := NewLedgerApi(failing).FindMomentumHeightByTime(context.Background(), time.Unix(5000, 0)); err == nil {
+		t.Error("expected an error when the frontier cannot be read")
+	}
+}
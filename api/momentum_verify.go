@@ -0,0 +1,98 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0x3639/znn-sdk-go/utils"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+var (
+	// ErrMomentumHashMismatch is returned by VerifyDetailedMomentum when a
+	// momentum's Hash is not the hash of its header and content.
+	ErrMomentumHashMismatch = errors.New("momentum hash mismatch")
+
+	// ErrMomentumContentMismatch is returned by VerifyDetailedMomentum when
+	// the account blocks do not match the headers the momentum commits to.
+	ErrMomentumContentMismatch = errors.New("momentum content does not match its account blocks")
+)
+
+// VerifyDetailedMomentum checks that a detailed momentum fetched from a node
+// is a consistent set: the momentum's Hash commits to its content, each
+// account block passes utils.VerifyAccountBlock, and the blocks are exactly
+// the ones listed in the momentum's content.
+//
+// The check needs no further RPC calls. It does not verify the momentum's
+// producer signature or that the momentum is part of the canonical chain.
+//
+// Parameters:
+//   - dm: Detailed momentum, for example from GetDetailedMomentumsByHeight
+//
+// Returns nil when everything is consistent. Otherwise the error names the
+// first block that fails, wrapping the reason (such as
+// utils.ErrBlockHashMismatch or crypto.ErrInvalidSignature), or wraps
+// ErrMomentumHashMismatch or ErrMomentumContentMismatch.
+//
+// Example:
+//
+//	list, err := client.LedgerApi.GetDetailedMomentumsByHeight(height, 1)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, dm := range list.List {
+//	    if err := client.LedgerApi.VerifyDetailedMomentum(dm); err != nil {
+//	        return fmt.Errorf("momentum %d failed verification: %w", height, err)
+//	    }
+//	}
+func (la *LedgerApi) VerifyDetailedMomentum(dm *api.DetailedMomentum) error {
+	if dm == nil || dm.Momentum == nil || dm.Momentum.Momentum == nil {
+		return errors.New("detailed momentum has no momentum")
+	}
+	momentum := dm.Momentum.Momentum
+	if computed := momentum.ComputeHash(); computed != momentum.Hash {
+		return fmt.Errorf("%w: momentum %d (%s) hashes to %s", ErrMomentumHashMismatch, momentum.Height, momentum.Hash, computed)
+	}
+
+	committed := make(map[types.AccountHeader]bool, len(momentum.Content))
+	for _, header := range momentum.Content {
+		if header != nil {
+			committed[*header] = true
+		}
+	}
+
+	// Blocks of a contract batch may appear both on their own and as
+	// descendants of the batch's receive block.
+	delivered := make(map[types.AccountHeader]bool, len(dm.AccountBlocks))
+	var record func(block *nom.AccountBlock)
+	record = func(block *nom.AccountBlock) {
+		delivered[block.Header()] = true
+		for _, descendant := range block.DescendantBlocks {
+			if descendant != nil {
+				record(descendant)
+			}
+		}
+	}
+
+	for i, block := range dm.AccountBlocks {
+		if block == nil {
+			return fmt.Errorf("%w: account block %d is missing", ErrMomentumContentMismatch, i)
+		}
+		if err := utils.VerifyAccountBlock(&block.AccountBlock); err != nil {
+			return fmt.Errorf("account block %d (%s): %w", i, block.Hash, err)
+		}
+		if !committed[block.Header()] {
+			return fmt.Errorf("%w: account block %d (%s) is not in momentum %d", ErrMomentumContentMismatch, i, block.Hash, momentum.Height)
+		}
+		record(&block.AccountBlock)
+	}
+
+	for _, header := range momentum.Content {
+		if header != nil && !delivered[*header] {
+			return fmt.Errorf("%w: block %s of %s is missing", ErrMomentumContentMismatch, header.Hash, header.Address)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/crypto"
+	"github.com/0x3639/znn-sdk-go/utils"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+func signedTestBlock(t *testing.T, seed byte, height uint64) *api.AccountBlock {
+	t.Helper()
+	privateKey := ed25519.NewKeyFromSeed(append(make([]byte, 31), seed))
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	address, err := crypto.AddressFromPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := nom.AccountBlock{
+		Version:         1,
+		ChainIdentifier: 1,
+		BlockType:       nom.BlockTypeUserSend,
+		Height:          height,
+		Address:         address,
+		ToAddress:       types.PlasmaContract,
+		Amount:          big.NewInt(int64(seed) * 100),
+		TokenStandard:   types.ZnnTokenStandard,
+		PublicKey:       publicKey,
+	}
+	block.Hash = block.ComputeHash()
+	block.Signature = ed25519.Sign(privateKey, block.Hash.Bytes())
+	return &api.AccountBlock{AccountBlock: block}
+}
+
+func testDetailedMomentum(t *testing.T) *api.DetailedMomentum {
+	t.Helper()
+	blocks := []*api.AccountBlock{signedTestBlock(t, 1, 5), signedTestBlock(t, 2, 9)}
+	nomBlocks := []*nom.AccountBlock{&blocks[0].AccountBlock, &blocks[1].AccountBlock}
+	momentum := &nom.Momentum{
+		Version:         1,
+		ChainIdentifier: 1,
+		Height:          42,
+		TimestampUnix:   1700000000,
+		Content:         nom.NewMomentumContent(nomBlocks),
+	}
+	momentum.Hash = momentum.ComputeHash()
+	return &api.DetailedMomentum{
+		Momentum:      &api.Momentum{Momentum: momentum},
+		AccountBlocks: blocks,
+	}
+}
+
+func TestVerifyDetailedMomentum(t *testing.T) {
+	ledger := NewLedgerApi(nil)
+
+	if err := ledger.VerifyDetailedMomentum(testDetailedMomentum(t)); err != nil {
+		t.Fatalf("VerifyDetailedMomentum() on consistent momentum error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		tamper func(dm *api.DetailedMomentum)
+		want   error
+	}{
+		{
+			name:   "tampered block",
+			tamper: func(dm *api.DetailedMomentum) { dm.AccountBlocks[1].Amount = big.NewInt(1) },
+			want:   utils.ErrBlockHashMismatch,
+		},
+		{
+			name: "forged signature",
+			tamper: func(dm *api.DetailedMomentum) {
+				dm.AccountBlocks[0].Signature = append([]byte(nil), dm.AccountBlocks[1].Signature...)
+			},
+			want: crypto.ErrInvalidSignature,
+		},
+		{
+			name:   "missing block",
+			tamper: func(dm *api.DetailedMomentum) { dm.AccountBlocks = dm.AccountBlocks[:1] },
+			want:   ErrMomentumContentMismatch,
+		},
+		{
+			name: "extra block",
+			tamper: func(dm *api.DetailedMomentum) {
+				dm.AccountBlocks = append(dm.AccountBlocks, signedTestBlock(t, 3, 1))
+			},
+			want: ErrMomentumContentMismatch,
+		},
+		{
+			name:   "tampered content",
+			tamper: func(dm *api.DetailedMomentum) { dm.Momentum.Content = dm.Momentum.Content[:1] },
+			want:   ErrMomentumHashMismatch,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dm := testDetailedMomentum(t)
+			test.tamper(dm)
+			if err := ledger.VerifyDetailedMomentum(dm); !errors.Is(err, test.want) {
+				t.Errorf("VerifyDetailedMomentum() error = %v, want %v", err, test.want)
+			}
+		})
+	}
+
+	if err := ledger.VerifyDetailedMomentum(&api.DetailedMomentum{}); err == nil {
+		t.Error("VerifyDetailedMomentum() without a momentum should fail")
+	}
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0x3639/znn-sdk-go/internal/rpcvalidation"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// ErrNoNextPage is returned by Page.Next when called on the last page.
+var ErrNoNextPage = errors.New("no next page")
+
+// Page is one page of a paginated ledger query together with the page math
+// needed to walk the rest of it.
+//
+// Create one with GetAccountBlocksPage, GetMomentumsPage, or
+// GetUnreceivedBlocksPage, read List, then call Next while HasNext reports
+// true:
+//
+//	p, err := client.LedgerApi.GetAccountBlocksPage(address, 0, 50)
+//	for err == nil {
+//	    for _, block := range p.List {
+//	        process(block)
+//	    }
+//	    if !p.HasNext() {
+//	        break
+//	    }
+//	    p, err = p.Next(ctx)
+//	}
+//	if err != nil {
+//	    return err
+//	}
+type Page[T any] struct {
+	// List holds the items on this page.
+	List []T
+	// Index is the 0-based index of this page.
+	Index uint32
+	// Size is the page size requested for every page in the walk.
+	Size uint32
+
+	total int
+	// lastIndex, when non-zero, is the highest page index the node serves.
+	lastIndex uint32
+	fetch     func(pageIndex, pageSize uint32) (*Page[T], error)
+}
+
+// Total returns the total number of items across all pages, as reported by
+// the node with this page.
+func (p *Page[T]) Total() int {
+	return p.total
+}
+
+// HasNext reports whether another page follows this one. It is false once
+// this page reaches Total or the last page index the node serves, and also
+// when this page came back empty so that a node reporting an inconsistent
+// total cannot cause an endless walk.
+func (p *Page[T]) HasNext() bool {
+	if len(p.List) == 0 || p.Size == 0 || p.total <= 0 {
+		return false
+	}
+	if p.lastIndex != 0 && p.Index >= p.lastIndex {
+		return false
+	}
+	return (uint64(p.Index)+1)*uint64(p.Size) < uint64(p.total)
+}
+
+// NextPageIndex returns the index of the page following this one.
+func (p *Page[T]) NextPageIndex() uint32 {
+	return p.Index + 1
+}
+
+// Next fetches the page following this one with the same page size.
+//
+// Returns ErrNoNextPage when HasNext is false, ctx.Err() when ctx is already
+// done, or the RPC error.
+func (p *Page[T]) Next(ctx context.Context) (*Page[T], error) {
+	if !p.HasNext() {
+		return nil, ErrNoNextPage
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return p.fetch(p.NextPageIndex(), p.Size)
+}
+
+// GetAccountBlocksPage is GetAccountBlocksByPage returning a Page that can
+// fetch the pages after it.
+func (la *LedgerApi) GetAccountBlocksPage(address types.Address, pageIndex, pageSize uint32) (*Page[*api.AccountBlock], error) {
+	list, err := la.GetAccountBlocksByPage(address, pageIndex, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Page[*api.AccountBlock]{
+		List:  list.List,
+		Index: pageIndex,
+		Size:  pageSize,
+		total: list.Count,
+		fetch: func(pageIndex, pageSize uint32) (*Page[*api.AccountBlock], error) {
+			return la.GetAccountBlocksPage(address, pageIndex, pageSize)
+		},
+	}, nil
+}
+
+// GetMomentumsPage is GetMomentumsByPage returning a Page that can fetch the
+// pages after it.
+func (la *LedgerApi) GetMomentumsPage(pageIndex, pageSize uint32) (*Page[*api.Momentum], error) {
+	list, err := la.GetMomentumsByPage(pageIndex, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Page[*api.Momentum]{
+		List:  list.List,
+		Index: pageIndex,
+		Size:  pageSize,
+		total: list.Count,
+		fetch: la.GetMomentumsPage,
+	}, nil
+}
+
+// GetUnreceivedBlocksPage is GetUnreceivedBlocksByAddress returning a Page
+// that can fetch the pages after it.
+//
+// The node serves page indices 0 through 9 only, so HasNext is false on page
+// 9 even when Total reports more blocks.
+//
+// Receiving blocks removes them from the node's list and shifts later blocks
+// to earlier pages, so receive only after the walk is complete, or use
+// GetAllUnreceivedBlocks.
+func (la *LedgerApi) GetUnreceivedBlocksPage(address types.Address, pageIndex, pageSize uint32) (*Page[*api.AccountBlock], error) {
+	list, err := la.GetUnreceivedBlocksByAddress(address, pageIndex, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Page[*api.AccountBlock]{
+		List:      list.List,
+		Index:     pageIndex,
+		Size:      pageSize,
+		total:     list.Count,
+		lastIndex: uint32(rpcvalidation.UnreceivedMaxPageIndex - 1),
+		fetch: func(pageIndex, pageSize uint32) (*Page[*api.AccountBlock], error) {
+			return la.GetUnreceivedBlocksPage(address, pageIndex, pageSize)
+		},
+	}, nil
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// threePageCaller serves seven account blocks in pages of the requested size
+// for ledger.getAccountBlocksByPage.
+type threePageCaller struct {
+	requested []uint32
+}
+
+func (c *threePageCaller) Call(result interface{}, method string, args ...interface{}) error {
+	if method != "ledger.getAccountBlocksByPage" {
+		return errors.New("unexpected method " + method)
+	}
+	pageIndex, pageSize := args[1].(uint32), args[2].(uint32)
+	c.requested = append(c.requested, pageIndex)
+	const total = 7
+	list := result.(*api.AccountBlockList)
+	list.Count = total
+	for i := pageIndex * pageSize; i < (pageIndex+1)*pageSize && i < total; i++ {
+		list.List = append(list.List, &api.AccountBlock{AccountBlock: nom.AccountBlock{Height: uint64(i + 1)}})
+	}
+	return nil
+}
+
+func TestPageWalksThreePages(t *testing.T) {
+	caller := &threePageCaller{}
+	ledger := NewLedgerApi(caller)
+	address := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+
+	p, err := ledger.GetAccountBlocksPage(address, 0, 3)
+	if err != nil {
+		t.Fatalf("GetAccountBlocksPage() error = %v", err)
+	}
+
+	var heights []uint64
+	var hasNext []bool
+	for {
+		if p.Total() != 7 {
+			t.Errorf("page %d Total() = %d, want 7", p.Index, p.Total())
+		}
+		for _, block := range p.List {
+			heights = append(heights, block.Height)
+		}
+		hasNext = append(hasNext, p.HasNext())
+		if !p.HasNext() {
+			break
+		}
+		if p.NextPageIndex() != p.Index+1 {
+			t.Errorf("NextPageIndex() = %d, want %d", p.NextPageIndex(), p.Index+1)
+		}
+		if p, err = p.Next(context.Background()); err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+	}
+
+	if len(heights) != 7 || heights[0] != 1 || heights[6] != 7 {
+		t.Errorf("heights = %v, want 1..7", heights)
+	}
+	if want := []bool{true, true, false}; len(hasNext) != 3 || hasNext[0] != want[0] || hasNext[1] != want[1] || hasNext[2] != want[2] {
+		t.Errorf("HasNext() per page = %v, want %v", hasNext, want)
+	}
+	if len(caller.requested) != 3 {
+		t.Errorf("requested pages %v, want [0 1 2]", caller.requested)
+	}
+
+	if _, err := p.Next(context.Background()); !errors.Is(err, ErrNoNextPage) {
+		t.Errorf("Next() on last page error = %v, want ErrNoNextPage", err)
+	}
+}
+
+func TestPageNextHonorsContext(t *testing.T) {
+	ledger := NewLedgerApi(&threePageCaller{})
+	address := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+
+	p, err := ledger.GetAccountBlocksPage(address, 0, 3)
+	if err != nil {
+		t.Fatalf("GetAccountBlocksPage() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := p.Next(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Next() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestPageHasNextStopsOnEmptyPage(t *testing.T) {
+	p := &Page[*api.Momentum]{Index: 0, Size: 10, total: 100}
+	if p.HasNext() {
+		t.Error("HasNext() = true for an empty page")
+	}
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy controls how PublishRawTransactionWithRetry spaces its attempts.
+//
+// The nominal delay before retry n (counting from 0) is
+// BaseDelay * Multiplier^n, capped at MaxDelay. Jitter then subtracts a random
+// share of up to that fraction of the delay, so clients that failed together
+// do not retry together. A Jitter of 1 is "full jitter": each delay is drawn
+// uniformly between zero and the nominal delay.
+type RetryPolicy struct {
+	// BaseDelay is the nominal delay before the first retry
+	BaseDelay time.Duration
+	// MaxDelay caps the nominal delay; zero means no cap
+	MaxDelay time.Duration
+	// Multiplier scales the delay after each retry; values below 1 are
+	// treated as 1
+	Multiplier float64
+	// Jitter is the fraction of each delay that is randomized, from 0 (none)
+	// to 1 (full jitter); values outside that range are clamped
+	Jitter float64
+	// MaxAttempts is the total number of attempts, including the first,
+	// used when PublishRawTransactionWithRetry is given a negative maxRetries
+	MaxAttempts int
+}
+
+// DefaultRetryPolicy returns the policy used by LedgerApi unless replaced with
+// WithRetryPolicy: delays of 1s, 2s, 4s, ... capped at 30s, with full jitter,
+// and four attempts.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		BaseDelay:   time.Second,
+		MaxDelay:    30 * time.Second,
+		Multiplier:  2,
+		Jitter:      1,
+		MaxAttempts: 4,
+	}
+}
+
+// Delay returns the wait before retry number retry (0 for the first retry).
+//
+// Parameters:
+//   - retry: Zero-based retry number
+//   - random: A value in [0, 1) that selects the jittered delay; pass
+//     rand.Float64() in production and a fixed value in tests
+//
+// Example:
+//
+//	policy := api.DefaultRetryPolicy()
+//	policy.Delay(2, 0)   // 4s: no jitter subtracted
+//	policy.Delay(2, 0.5) // 2s: half of the full-jitter range
+func (p RetryPolicy) Delay(retry int, random float64) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+	multiplier := math.Max(p.Multiplier, 1)
+	nominal := float64(p.BaseDelay) * math.Pow(multiplier, float64(max(retry, 0)))
+	if p.MaxDelay > 0 && nominal > float64(p.MaxDelay) {
+		nominal = float64(p.MaxDelay)
+	}
+	jitter := math.Min(math.Max(p.Jitter, 0), 1)
+	random = math.Min(math.Max(random, 0), 1)
+	delay := nominal * (1 - jitter*random)
+	// float64(math.MaxInt64) rounds up to 2^63, which no Duration can hold.
+	if delay >= float64(math.MaxInt64) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// WithRetryPolicy returns a copy of the LedgerApi that uses policy for
+// PublishRawTransactionWithRetry. The original is not modified.
+//
+// Example:
+//
+//	ledger := client.LedgerApi.WithRetryPolicy(api.RetryPolicy{
+//	    BaseDelay:   500 * time.Millisecond,
+//	    MaxDelay:    10 * time.Second,
+//	    Multiplier:  2,
+//	    Jitter:      1,
+//	    MaxAttempts: 6,
+//	})
+//	err := ledger.PublishRawTransactionWithRetry(block, -1) // uses MaxAttempts
+func (la *LedgerApi) WithRetryPolicy(policy RetryPolicy) *LedgerApi {
+	clone := *la
+	clone.retryPolicy = &policy
+	return &clone
+}
+
+// currentRetryPolicy returns the configured policy or the default.
+func (la *LedgerApi) currentRetryPolicy() RetryPolicy {
+	if la.retryPolicy != nil {
+		return *la.retryPolicy
+	}
+	return DefaultRetryPolicy()
+}
+
+// retryDelay returns the jittered wait before retry number retry.
+func (la *LedgerApi) retryDelay(policy RetryPolicy, retry int) time.Duration {
+	random := rand.Float64 // #nosec G404 -- jitter does not need a secure source
+	if la.random != nil {
+		random = la.random
+	}
+	return policy.Delay(retry, random())
+}
+
+// sleepFor waits for d, or calls the injected sleep function in tests.
+func (la *LedgerApi) sleepFor(d time.Duration) {
+	if la.sleep != nil {
+		la.sleep(d)
+		return
+	}
+	time.Sleep(d)
+}
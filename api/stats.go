@@ -1,6 +1,8 @@
 package api
 
 import (
+	"strings"
+
 	"github.com/0x3639/znn-sdk-go/transport"
 	"github.com/zenon-network/go-zenon/protocol"
 	"github.com/zenon-network/go-zenon/rpc/api"
@@ -40,6 +42,66 @@ func (sa *StatsApi) NetworkInfo() (*api.NetworkInfoResponse, error) {
 	return ans, nil
 }
 
+// PeerDetail describes one peer of the node, as reported by
+// stats.networkInfo.
+//
+// The node reports each peer's public key, IP, and the name it announced in
+// the p2p handshake. go-zenon builds that name as "<version> <node name>", so
+// Version and NodeName are split from it; a peer running other software may
+// announce anything, in which case Version is empty. The node does not expose
+// connection direction or per-peer chain height.
+type PeerDetail struct {
+	// PublicKey is the peer's p2p node ID (hex)
+	PublicKey string
+	// IP is the peer's remote IP address, without port
+	IP string
+	// Name is the full name the peer announced
+	Name string
+	// Version is the znnd version from Name, such as "v0.0.8", or empty
+	Version string
+	// NodeName is the operator-chosen part of Name, such as "znn-node"
+	NodeName string
+}
+
+// PeerInfo returns the node's connected peers with their announced versions.
+//
+// It reads the same stats.networkInfo response as NetworkInfo and splits each
+// peer's name into version and node name, which is what a dashboard needs to
+// spot outdated peers.
+//
+// Returns one PeerDetail per connected peer, or the RPC error.
+//
+// Example:
+//
+//	peers, err := client.StatsApi.PeerInfo()
+//	if err != nil {
+//	    return err
+//	}
+//	for _, peer := range peers {
+//	    if peer.Version != "" && peer.Version != latest {
+//	        fmt.Printf("%s (%s) runs %s\n", peer.NodeName, peer.IP, peer.Version)
+//	    }
+//	}
+func (sa *StatsApi) PeerInfo() ([]PeerDetail, error) {
+	info, err := sa.NetworkInfo()
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]PeerDetail, 0, len(info.Peers))
+	for _, peer := range info.Peers {
+		if peer == nil {
+			continue
+		}
+		detail := PeerDetail{PublicKey: peer.PublicKey, IP: peer.IP, Name: peer.Name, NodeName: peer.Name}
+		if version, nodeName, ok := strings.Cut(peer.Name, " "); ok && strings.HasPrefix(version, "v") {
+			detail.Version = version
+			detail.NodeName = nodeName
+		}
+		peers = append(peers, detail)
+	}
+	return peers, nil
+}
+
 func (sa *StatsApi) SyncInfo() (*protocol.SyncInfo, error) {
 	ans := new(protocol.SyncInfo)
 	if err := sa.client.Call(ans, "stats.syncInfo"); err != nil {
@@ -0,0 +1,35 @@
+package api
+
+import "testing"
+
+const networkInfoResponse = `{
+  "numPeers": 3,
+  "peers": [
+    {"publicKey": "8a9b0c", "ip": "203.0.113.7", "name": "v0.0.8 znn-node"},
+    {"publicKey": "1d2e3f", "ip": "198.51.100.21", "name": "v0.0.7 my pillar"},
+    {"publicKey": "4a5b6c", "ip": "192.0.2.4", "name": "custom-client"}
+  ],
+  "self": {"publicKey": "ffeedd", "ip": "0.0.0.0", "name": "v0.0.8 znn-node"}
+}`
+
+func TestPeerInfo(t *testing.T) {
+	caller := &methodResultCaller{responses: map[string]string{"stats.networkInfo": networkInfoResponse}}
+	peers, err := NewStatsApi(caller).PeerInfo()
+	if err != nil {
+		t.Fatalf("PeerInfo() error = %v", err)
+	}
+
+	want := []PeerDetail{
+		{PublicKey: "8a9b0c", IP: "203.0.113.7", Name: "v0.0.8 znn-node", Version: "v0.0.8", NodeName: "znn-node"},
+		{PublicKey: "1d2e3f", IP: "198.51.100.21", Name: "v0.0.7 my pillar", Version: "v0.0.7", NodeName: "my pillar"},
+		{PublicKey: "4a5b6c", IP: "192.0.2.4", Name: "custom-client", NodeName: "custom-client"},
+	}
+	if len(peers) != len(want) {
+		t.Fatalf("PeerInfo() returned %d peers, want %d", len(peers), len(want))
+	}
+	for i := range want {
+		if peers[i] != want[i] {
+			t.Errorf("peer %d = %+v, want %+v", i, peers[i], want[i])
+		}
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 
 	"github.com/zenon-network/go-zenon/common/types"
 	"github.com/zenon-network/go-zenon/rpc/api/subscribe"
@@ -18,6 +19,15 @@ func NewSubscriberApi(client *server.Client) *SubscriberApi {
 	}
 }
 
+// subscribe opens a ledger subscription, failing cleanly when the API has no
+// websocket client (for example on a client built around a mock caller).
+func (sa *SubscriberApi) subscribe(ctx context.Context, channel interface{}, args ...interface{}) (*server.ClientSubscription, error) {
+	if sa == nil || sa.client == nil {
+		return nil, errors.New("subscriptions require a websocket connection")
+	}
+	return sa.client.Subscribe(ctx, "ledger", channel, args...)
+}
+
 // ToMomentums subscribes to real-time momentum (block) production events.
 //
 // Momentums are Zenon's equivalent of blocks - each momentum contains a batch of
@@ -57,9 +67,11 @@ func NewSubscriberApi(client *server.Client) *SubscriberApi {
 //	}
 //
 // Note: The subscription will stop when ctx is cancelled or Unsubscribe() is called.
+// The channel is unbuffered; use ToMomentumsBuffered to absorb bursts or to
+// drop old events when the consumer falls behind.
 func (sa *SubscriberApi) ToMomentums(ctx context.Context) (*server.ClientSubscription, chan []subscribe.Momentum, error) {
 	ch := make(chan []subscribe.Momentum)
-	subscription, err := sa.client.Subscribe(ctx, "ledger", ch, "momentums")
+	subscription, err := sa.subscribe(ctx, ch, "momentums")
 	if err != nil {
 		return nil, nil, err
 	}
@@ -105,7 +117,7 @@ func (sa *SubscriberApi) ToMomentums(ctx context.Context) (*server.ClientSubscri
 // Consider using ToAccountBlocksByAddress for specific addresses instead.
 func (sa *SubscriberApi) ToAllAccountBlocks(ctx context.Context) (*server.ClientSubscription, chan []subscribe.AccountBlock, error) {
 	ch := make(chan []subscribe.AccountBlock)
-	subscription, err := sa.client.Subscribe(ctx, "ledger", ch, "allAccountBlocks")
+	subscription, err := sa.subscribe(ctx, ch, "allAccountBlocks")
 	if err != nil {
 		return nil, nil, err
 	}
@@ -155,7 +167,7 @@ func (sa *SubscriberApi) ToAllAccountBlocks(ctx context.Context) (*server.Client
 // This is ideal for monitoring a single wallet or application address.
 func (sa *SubscriberApi) ToAccountBlocksByAddress(ctx context.Context, address types.Address) (*server.ClientSubscription, chan []subscribe.AccountBlock, error) {
 	ch := make(chan []subscribe.AccountBlock)
-	subscription, err := sa.client.Subscribe(ctx, "ledger", ch, "accountBlocksByAddress", address.String())
+	subscription, err := sa.subscribe(ctx, ch, "accountBlocksByAddress", address.String())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -207,7 +219,7 @@ func (sa *SubscriberApi) ToAccountBlocksByAddress(ctx context.Context, address t
 // This is essential for automated payment processing and wallet auto-receive features.
 func (sa *SubscriberApi) ToUnreceivedAccountBlocksByAddress(ctx context.Context, address types.Address) (*server.ClientSubscription, chan []subscribe.AccountBlock, error) {
 	ch := make(chan []subscribe.AccountBlock)
-	subscription, err := sa.client.Subscribe(ctx, "ledger", ch, "unreceivedAccountBlocksByAddress", address.String())
+	subscription, err := sa.subscribe(ctx, ch, "unreceivedAccountBlocksByAddress", address.String())
 	if err != nil {
 		return nil, nil, err
 	}
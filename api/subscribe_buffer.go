@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/zenon-network/go-zenon/rpc/api/subscribe"
+	"github.com/zenon-network/go-zenon/rpc/server"
+)
+
+// OverflowPolicy selects what a buffered subscription does when its channel
+// is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits for the consumer to make room. Nothing is lost, but
+	// a consumer that stays behind lets events pile up in the websocket
+	// client, which ends the subscription with
+	// server.ErrSubscriptionQueueOverflow after 20000 queued events.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest discards the oldest buffered event to make room for
+	// the newest one and counts it in DroppedCount. The subscription stays
+	// open and the consumer always sees the most recent events.
+	OverflowDropOldest
+)
+
+// BufferedSubscription is a subscription whose events are delivered through
+// a buffered channel. It embeds the underlying ClientSubscription, so
+// Unsubscribe and Err work as usual.
+type BufferedSubscription struct {
+	*server.ClientSubscription
+	dropped atomic.Uint64
+}
+
+// DroppedCount returns how many events OverflowDropOldest has discarded
+// because the consumer fell behind. It is always 0 under OverflowBlock.
+func (bs *BufferedSubscription) DroppedCount() uint64 {
+	return bs.dropped.Load()
+}
+
+// ToMomentumsBuffered is ToMomentums with a buffered delivery channel and an
+// explicit overflow policy.
+//
+// ToMomentums delivers through an unbuffered channel, so every event waits
+// for the consumer. A buffer absorbs bursts; the policy decides what happens
+// once the buffer is full:
+//   - OverflowBlock never loses an event but can end the subscription if the
+//     consumer stays behind for long (see OverflowBlock)
+//   - OverflowDropOldest keeps the subscription open and the data fresh, at
+//     the cost of gaps that DroppedCount reports
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - bufferSize: Capacity of the returned channel, in events (must be > 0)
+//   - policy: OverflowBlock or OverflowDropOldest
+//
+// Returns the subscription handle, a channel that is closed when ctx is
+// cancelled or the subscription ends, or an error if bufferSize is not
+// positive or subscribing fails.
+//
+// Example - Monitor that prefers fresh momentums over completeness:
+//
+//	sub, momentumChan, err := client.SubscriberApi.ToMomentumsBuffered(ctx, 256, api.OverflowDropOldest)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer sub.Unsubscribe()
+//
+//	for momentums := range momentumChan {
+//	    record(momentums)
+//	    if n := sub.DroppedCount(); n > 0 {
+//	        log.Printf("fell behind: %d events dropped so far", n)
+//	    }
+//	}
+func (sa *SubscriberApi) ToMomentumsBuffered(ctx context.Context, bufferSize int, policy OverflowPolicy) (*BufferedSubscription, <-chan []subscribe.Momentum, error) {
+	if bufferSize <= 0 {
+		return nil, nil, errors.New("bufferSize must be positive")
+	}
+	subscription, raw, err := sa.ToMomentums(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	buffered := &BufferedSubscription{ClientSubscription: subscription}
+	out := make(chan []subscribe.Momentum, bufferSize)
+	go forwardBuffered(ctx, subscription.Err(), raw, out, policy, func() { buffered.dropped.Add(1) })
+	return buffered, out, nil
+}
+
+// forwardBuffered copies events from raw to out under policy until ctx is
+// done or done fires, then closes out. onDrop is called once for every event
+// OverflowDropOldest discards.
+func forwardBuffered[T any](ctx context.Context, done <-chan error, raw <-chan T, out chan T, policy OverflowPolicy, onDrop func()) {
+	defer close(out)
+	for {
+		var event T
+		select {
+		case event = <-raw:
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		}
+
+		if policy == OverflowDropOldest {
+			for delivered := false; !delivered; {
+				select {
+				case out <- event:
+					delivered = true
+				default:
+					// Full: discard the oldest event, unless the consumer
+					// took it first, and try again.
+					select {
+					case <-out:
+						onDrop()
+					default:
+					}
+				}
+			}
+			continue
+		}
+
+		select {
+		case out <- event:
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
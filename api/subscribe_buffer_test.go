@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestForwardBufferedDropOldest(t *testing.T) {
+	raw := make(chan int)
+	out := make(chan int, 2)
+	done := make(chan error)
+	drops := make(chan struct{}, 2)
+	go forwardBuffered(context.Background(), done, raw, out, OverflowDropOldest, func() { drops <- struct{}{} })
+
+	// Nobody reads out, so events 1 and 2 are discarded for 3 and 4.
+	for i := 1; i <= 4; i++ {
+		raw <- i
+	}
+	// Draining out before the second drop would make room for event 4
+	// without one, so wait for both.
+	for i := 0; i < 2; i++ {
+		select {
+		case <-drops:
+		case <-time.After(time.Second):
+			t.Fatalf("saw %d drops, want 2", i)
+		}
+	}
+	close(done)
+
+	var got []int
+	for event := range out {
+		got = append(got, event)
+	}
+	if len(got) != 2 || got[0] != 3 || got[1] != 4 {
+		t.Errorf("delivered %v, want [3 4]", got)
+	}
+	if extra := len(drops); extra != 0 {
+		t.Errorf("saw %d extra drops, want 0", extra)
+	}
+}
+
+func TestForwardBufferedBlock(t *testing.T) {
+	raw := make(chan int)
+	out := make(chan int, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go forwardBuffered(ctx, make(chan error), raw, out, OverflowBlock, func() {
+		t.Error("OverflowBlock dropped an event")
+	})
+
+	raw <- 1
+	raw <- 2 // held by the forwarder until there is room
+	select {
+	case raw <- 3:
+		t.Fatal("forwarder accepted an event while blocked on a full buffer")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	for want := 1; want <= 2; want++ {
+		if got := <-out; got != want {
+			t.Errorf("received %d, want %d", got, want)
+		}
+	}
+
+	cancel()
+	for range out {
+	}
+}
+
+func TestToMomentumsBufferedRejectsBadBufferSize(t *testing.T) {
+	sa := NewSubscriberApi(nil)
+	if _, _, err := sa.ToMomentumsBuffered(context.Background(), 0, OverflowBlock); err == nil {
+		t.Error("ToMomentumsBuffered() with bufferSize 0 should fail")
+	}
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/0x3639/znn-sdk-go/transport"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api/subscribe"
+	"github.com/zenon-network/go-zenon/rpc/server"
+)
+
+// BlockDirection selects send or receive blocks in a SubscriptionFilter.
+type BlockDirection int
+
+const (
+	// DirectionAny matches every block type.
+	DirectionAny BlockDirection = iota
+	// DirectionSend matches user and contract send blocks.
+	DirectionSend
+	// DirectionReceive matches user and contract receive blocks.
+	DirectionReceive
+)
+
+// SubscriptionFilter narrows the account blocks delivered by
+// ToAccountBlocksByAddressFiltered. Every field is optional; its zero value
+// matches all blocks.
+//
+// Fields:
+//   - Direction: Send or receive blocks only (DirectionAny matches both)
+//   - TokenStandard: Token that was transferred (types.ZeroTokenStandard matches any)
+//   - MinAmount: Smallest transferred amount in base units (nil or zero matches any)
+//
+// For receive blocks, the token and amount are those of the send block being
+// received.
+type SubscriptionFilter struct {
+	Direction     BlockDirection
+	TokenStandard types.ZenonTokenStandard
+	MinAmount     *big.Int
+}
+
+// needsBlockDetails reports whether the filter inspects token or amount,
+// which the subscription payload does not carry.
+func (f SubscriptionFilter) needsBlockDetails() bool {
+	return f.TokenStandard != types.ZeroTokenStandard || (f.MinAmount != nil && f.MinAmount.Sign() > 0)
+}
+
+func (f SubscriptionFilter) matchesDirection(blockType uint64) bool {
+	switch f.Direction {
+	case DirectionSend:
+		return nom.IsSendBlock(blockType)
+	case DirectionReceive:
+		return nom.IsReceiveBlock(blockType)
+	default:
+		return true
+	}
+}
+
+func (f SubscriptionFilter) matchesTransfer(zts types.ZenonTokenStandard, amount *big.Int) bool {
+	if f.TokenStandard != types.ZeroTokenStandard && zts != f.TokenStandard {
+		return false
+	}
+	if f.MinAmount != nil && f.MinAmount.Sign() > 0 {
+		if amount == nil || amount.Cmp(f.MinAmount) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ToAccountBlocksByAddressFiltered subscribes to account blocks for an address
+// and delivers only the blocks that match a filter.
+//
+// Subscription events carry only block type, hash, and addresses. When the
+// filter sets TokenStandard or MinAmount, each block whose direction matches is
+// looked up with ledger.getAccountBlockByHash (receive blocks via their send
+// block) before it is delivered. Blocks whose lookup fails are dropped.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - address: Address to monitor for transactions
+//   - filter: Criteria a block must match to be delivered
+//
+// Returns:
+//   - ClientSubscription: Subscription handle for management
+//   - Channel: Receives non-empty arrays of matching AccountBlock events. It is
+//     closed when ctx is cancelled or the subscription ends.
+//   - Error: If subscription fails
+//
+// Example - Incoming ZNN payments of at least 1 ZNN:
+//
+//	filter := api.SubscriptionFilter{
+//	    Direction:     api.DirectionReceive,
+//	    TokenStandard: types.ZnnTokenStandard,
+//	    MinAmount:     big.NewInt(100000000),
+//	}
+//	sub, blockChan, err := client.SubscriberApi.ToAccountBlocksByAddressFiltered(ctx, myAddress, filter)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer sub.Unsubscribe()
+//
+//	for blocks := range blockChan {
+//	    for _, block := range blocks {
+//	        fmt.Printf("Payment received in block %s\n", block.Hash)
+//	    }
+//	}
+func (sa *SubscriberApi) ToAccountBlocksByAddressFiltered(ctx context.Context, address types.Address, filter SubscriptionFilter) (*server.ClientSubscription, chan []subscribe.AccountBlock, error) {
+	subscription, raw, err := sa.ToAccountBlocksByAddress(ctx, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make(chan []subscribe.AccountBlock)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case blocks := <-raw:
+				matched := filterAccountBlocks(sa.client, blocks, filter)
+				if len(matched) == 0 {
+					continue
+				}
+				select {
+				case out <- matched:
+				case <-subscription.Err():
+					return
+				case <-ctx.Done():
+					return
+				}
+			case <-subscription.Err():
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return subscription, out, nil
+}
+
+// filterAccountBlocks returns the blocks matching filter, fetching full blocks
+// through client only when the filter needs token or amount.
+func filterAccountBlocks(client transport.Caller, blocks []subscribe.AccountBlock, filter SubscriptionFilter) []subscribe.AccountBlock {
+	var ledger *LedgerApi
+	if filter.needsBlockDetails() {
+		ledger = NewLedgerApi(client)
+	}
+
+	matched := make([]subscribe.AccountBlock, 0, len(blocks))
+	for _, block := range blocks {
+		if !filter.matchesDirection(block.BlockType) {
+			continue
+		}
+		if ledger != nil {
+			hash := block.Hash
+			if nom.IsReceiveBlock(block.BlockType) {
+				hash = block.FromHash
+			}
+			full, err := ledger.GetAccountBlockByHash(hash)
+			if err != nil || full == nil {
+				continue
+			}
+			if !filter.matchesTransfer(full.TokenStandard, full.Amount) {
+				continue
+			}
+		}
+		matched = append(matched, block)
+	}
+	return matched
+}
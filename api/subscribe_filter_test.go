@@ -0,0 +1,89 @@
+package api
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+	"github.com/zenon-network/go-zenon/rpc/api/subscribe"
+)
+
+type blockLookupCaller struct {
+	blocks map[string]*nom.AccountBlock
+	calls  int
+}
+
+func (c *blockLookupCaller) Call(result interface{}, _ string, args ...interface{}) error {
+	c.calls++
+	block, ok := c.blocks[args[0].(string)]
+	if !ok {
+		return errors.New("block not found")
+	}
+	result.(*api.AccountBlock).AccountBlock = *block
+	return nil
+}
+
+func TestFilterAccountBlocks(t *testing.T) {
+	sendHash := types.HexToHashPanic("0101010101010101010101010101010101010101010101010101010101010101")
+	qsrSendHash := types.HexToHashPanic("0202020202020202020202020202020202020202020202020202020202020202")
+	pairedZnnHash := types.HexToHashPanic("0303030303030303030303030303030303030303030303030303030303030303")
+	caller := &blockLookupCaller{blocks: map[string]*nom.AccountBlock{
+		sendHash.String():      {TokenStandard: types.ZnnTokenStandard, Amount: big.NewInt(500)},
+		qsrSendHash.String():   {TokenStandard: types.QsrTokenStandard, Amount: big.NewInt(5000)},
+		pairedZnnHash.String(): {TokenStandard: types.ZnnTokenStandard, Amount: big.NewInt(2000)},
+	}}
+	blocks := []subscribe.AccountBlock{
+		{BlockType: nom.BlockTypeUserSend, Hash: sendHash},
+		{BlockType: nom.BlockTypeUserReceive, FromHash: qsrSendHash},
+		{BlockType: nom.BlockTypeUserReceive, FromHash: pairedZnnHash},
+		{BlockType: nom.BlockTypeContractReceive, FromHash: types.HexToHashPanic("0404040404040404040404040404040404040404040404040404040404040404")},
+	}
+
+	tests := []struct {
+		name   string
+		filter SubscriptionFilter
+		want   []int
+		calls  int
+	}{
+		{name: "zero filter matches all", filter: SubscriptionFilter{}, want: []int{0, 1, 2, 3}},
+		{name: "send only", filter: SubscriptionFilter{Direction: DirectionSend}, want: []int{0}},
+		{name: "receive only", filter: SubscriptionFilter{Direction: DirectionReceive}, want: []int{1, 2, 3}},
+		{
+			name:   "incoming ZNN",
+			filter: SubscriptionFilter{Direction: DirectionReceive, TokenStandard: types.ZnnTokenStandard},
+			want:   []int{2},
+			calls:  3,
+		},
+		{
+			name:   "minimum amount",
+			filter: SubscriptionFilter{MinAmount: big.NewInt(1000)},
+			want:   []int{1, 2},
+			calls:  4,
+		},
+		{
+			name:   "zero minimum amount matches all",
+			filter: SubscriptionFilter{MinAmount: big.NewInt(0)},
+			want:   []int{0, 1, 2, 3},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			caller.calls = 0
+			got := filterAccountBlocks(caller, blocks, test.filter)
+			if len(got) != len(test.want) {
+				t.Fatalf("filterAccountBlocks() returned %d blocks, want %d", len(got), len(test.want))
+			}
+			for i, index := range test.want {
+				if got[i] != blocks[index] {
+					t.Fatalf("block %d = %+v, want %+v", i, got[i], blocks[index])
+				}
+			}
+			if caller.calls != test.calls {
+				t.Fatalf("lookups = %d, want %d", caller.calls, test.calls)
+			}
+		})
+	}
+}
@@ -3,11 +3,23 @@ package crypto
 import (
 	"crypto/ed25519"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 
+	"github.com/zenon-network/go-zenon/common/types"
 	"golang.org/x/crypto/sha3"
 )
 
+var (
+	// ErrInvalidSignature is returned by VerifyWithAddress when the signature
+	// does not verify against the public key
+	ErrInvalidSignature = errors.New("signature does not verify")
+
+	// ErrAddressMismatch is returned by VerifyWithAddress when the public key
+	// does not derive to the expected address
+	ErrAddressMismatch = errors.New("public key does not match address")
+)
+
 // GetPublicKey derives the Ed25519 public key from a private key
 func GetPublicKey(privateKey []byte) ([]byte, error) {
 	if len(privateKey) != ed25519.PrivateKeySize {
@@ -23,7 +35,12 @@ func GetPublicKey(privateKey []byte) ([]byte, error) {
 	return []byte(pubKey), nil
 }
 
-// Sign creates an Ed25519 signature of a message using a private key
+// Sign creates an Ed25519 signature of a message using a private key.
+//
+// Ed25519 signing is deterministic (RFC 8032): the same key and message always
+// produce the same signature, byte for byte identical to go-zenon's
+// wallet.KeyPair.Sign. crypto/testdata/ed25519_vectors.json records such
+// signatures for known seeds.
 func Sign(message []byte, privateKey []byte) ([]byte, error) {
 	if len(privateKey) != ed25519.PrivateKeySize {
 		return nil, fmt.Errorf("invalid private key size: expected %d, got %d", ed25519.PrivateKeySize, len(privateKey))
@@ -49,6 +66,70 @@ func Verify(signature []byte, message []byte, publicKey []byte) (bool, error) {
 	return ed25519.Verify(pubKey, message, signature), nil
 }
 
+// VerifyWithAddress verifies an Ed25519 signature and also checks that the
+// public key derives to addr. This is the full check for authenticating an
+// account block: a valid signature from a key that does not own the sending
+// address must be rejected.
+//
+// It returns true and a nil error only when both checks pass. Otherwise it
+// returns false with an error giving the reason: a size error for malformed
+// input, ErrInvalidSignature, or ErrAddressMismatch.
+//
+// Example:
+//
+//	ok, err := crypto.VerifyWithAddress(block.Signature, block.Hash.Bytes(), block.PublicKey, block.Address)
+//	if !ok {
+//	    return fmt.Errorf("block not authentic: %w", err)
+//	}
+func VerifyWithAddress(signature []byte, message []byte, publicKey []byte, addr types.Address) (bool, error) {
+	ok, err := Verify(signature, message, publicKey)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, ErrInvalidSignature
+	}
+	if derived, _ := AddressFromPublicKey(publicKey); derived != addr {
+		return false, fmt.Errorf("%w: key derives to %s, not %s", ErrAddressMismatch, derived, addr)
+	}
+	return true, nil
+}
+
+// AddressFromPublicKey returns the Zenon address owned by an Ed25519 public
+// key: the user address prefix followed by the key's core bytes (see
+// CoreBytesFromPublicKey). The mapping is one-way; a public key cannot be
+// recovered from an address.
+//
+// Returns an error if publicKey is not 32 bytes.
+//
+// Example:
+//
+//	sender, err := crypto.AddressFromPublicKey(block.PublicKey)
+//	if err != nil || sender != block.Address {
+//	    return errors.New("block public key does not own its address")
+//	}
+func AddressFromPublicKey(publicKey []byte) (types.Address, error) {
+	core, err := CoreBytesFromPublicKey(publicKey)
+	if err != nil {
+		return types.Address{}, err
+	}
+	return types.BytesToAddress(append([]byte{types.UserAddrByte}, core...))
+}
+
+// CoreBytesFromPublicKey returns the 19 core bytes of the address owned by an
+// Ed25519 public key: the first 19 bytes of the key's SHA3-256 hash. An
+// address's raw form is a one-byte type prefix followed by these bytes, and
+// its bech32 string encodes that raw form.
+//
+// Returns an error if publicKey is not 32 bytes.
+func CoreBytesFromPublicKey(publicKey []byte) ([]byte, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key size: expected %d, got %d", ed25519.PublicKeySize, len(publicKey))
+	}
+	hash := sha3.Sum256(publicKey)
+	return hash[:types.AddressCoreSize], nil
+}
+
 // Digest computes the SHA3-256 hash of data
 // The digestSize parameter allows customization of output length (default: 32 bytes)
 func Digest(data []byte, digestSize int) []byte {
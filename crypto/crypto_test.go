@@ -5,7 +5,10 @@ import (
 	"crypto/ed25519"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
 )
 
 // =============================================================================
@@ -325,6 +328,53 @@ func TestVerify_InvalidSignatureSize(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// VerifyWithAddress Tests
+// =============================================================================
+
+func TestVerifyWithAddress(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	otherPubKey, otherPrivKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	message := []byte("test message")
+	signature := ed25519.Sign(privKey, message)
+	otherSignature := ed25519.Sign(otherPrivKey, message)
+	address := types.PubKeyToAddress(pubKey)
+
+	testCases := []struct {
+		name      string
+		signature []byte
+		publicKey []byte
+		wantErr   error
+	}{
+		{"Valid", signature, pubKey, nil},
+		{"Valid signature from another key", otherSignature, otherPubKey, ErrAddressMismatch},
+		{"Signature from another key", otherSignature, pubKey, ErrInvalidSignature},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, err := VerifyWithAddress(tc.signature, message, tc.publicKey, address)
+			if ok != (tc.wantErr == nil) {
+				t.Errorf("VerifyWithAddress() = %v, want %v", ok, tc.wantErr == nil)
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("VerifyWithAddress() error = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+
+	if ok, err := VerifyWithAddress(signature, message, pubKey[:16], address); ok || err == nil {
+		t.Errorf("VerifyWithAddress() with short key = %v, %v, want false and an error", ok, err)
+	}
+}
+
 // =============================================================================
 // Digest Tests (SHA3-256)
 // =============================================================================
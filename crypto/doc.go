@@ -49,10 +49,29 @@
 //
 // Zenon addresses are derived from public keys using:
 //  1. SHA3-256 hash of public key
-//  2. Core bytes selection
+//  2. Core bytes selection (the first 19 bytes of the hash)
 //  3. Bech32 encoding with 'z' prefix
 //
-// This is typically handled by the wallet package's address derivation methods.
+// CoreBytesFromPublicKey performs the first two steps and AddressFromPublicKey
+// the whole derivation, for example to check that a block's public key owns
+// its address:
+//
+//	address, err := crypto.AddressFromPublicKey(block.PublicKey)
+//
+// Signatures are deterministic and match go-zenon byte for byte; the vectors in
+// testdata/ed25519_vectors.json pin both the RFC 8032 examples and keys
+// derived from a Zenon mnemonic.
+//
+// # Off-chain Messages
+//
+// VerifyMessage checks signatures made by wallet.SignMessage over a domain and
+// a message. MessageHash prefixes both with a fixed tag, so a message
+// signature is never valid for an account block and the reverse:
+//
+//	ok := crypto.VerifyMessage("app.example.com", challenge, sig, pubKey)
+//
+// VerifyRawMessage checks untagged Ed25519 signatures over a message's UTF-8
+// bytes, the layout KeyPair.Sign produces.
 //
 // # Security Considerations
 //
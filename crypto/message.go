@@ -0,0 +1,64 @@
+package crypto
+
+import (
+	"encoding/binary"
+)
+
+// MessageTag prefixes every off-chain message before it is hashed. Account
+// blocks are signed over their raw 32-byte hash with no prefix, so a message
+// signature can never be replayed as a transaction signature or the reverse.
+const MessageTag = "\x19Zenon Signed Message:\n"
+
+// MessageHash returns the SHA3-256 digest that SignMessage signs for domain
+// and message: the MessageTag, the domain length as a big-endian uint32, the
+// domain, then the message. The length prefix keeps ("ab", "c") and
+// ("a", "bc") distinct.
+//
+// The domain names the context the signature is valid in, such as the dapp
+// host, so that a login challenge signed for one site is rejected by another.
+func MessageHash(domain, message string) []byte {
+	data := make([]byte, 0, len(MessageTag)+4+len(domain)+len(message))
+	data = append(data, MessageTag...)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(domain)))
+	data = append(data, domain...)
+	data = append(data, message...)
+	return DigestDefault(data)
+}
+
+// VerifyMessage reports whether sig is a valid signature by pubKey of message
+// within domain, as produced by wallet.SignMessage. Malformed keys or
+// signatures report false.
+//
+// Example:
+//
+//	if !crypto.VerifyMessage("app.example.com", challenge, sig, pubKey) {
+//	    return errors.New("login signature rejected")
+//	}
+func VerifyMessage(domain, message string, sig, pubKey []byte) bool {
+	ok, err := Verify(sig, MessageHash(domain, message), pubKey)
+	return err == nil && ok
+}
+
+// VerifyRawMessage reports whether sig is a valid Ed25519 signature by pubKey
+// of the UTF-8 bytes of message, with no tag or domain.
+//
+// This is the layout KeyPair.Sign([]byte(message)) produces. Use it only to
+// accept signatures from signers that sign raw message bytes; VerifyMessage
+// is the format for new protocols. Malformed keys or signatures report false.
+//
+// Example:
+//
+//	sig, _ := hex.DecodeString(signatureHex)
+//	pubKey, _ := hex.DecodeString(publicKeyHex)
+//	if !crypto.VerifyRawMessage(challenge, sig, pubKey) {
+//	    return errors.New("login signature rejected")
+//	}
+//
+// Note: An untagged signature over a 32-byte message is indistinguishable from
+// an account block signature over that hash. Never ask users to sign
+// arbitrary 32-byte values in this format, and include a human-readable
+// context in every challenge.
+func VerifyRawMessage(message string, sig, pubKey []byte) bool {
+	ok, err := Verify(sig, []byte(message), pubKey)
+	return err == nil && ok
+}
@@ -0,0 +1,48 @@
+package crypto
+
+import "testing"
+
+// rawMessageFixture is an untagged message signature: Ed25519 over the
+// message's UTF-8 bytes, by account 0 of the SDK's test mnemonic (see
+// testdata/ed25519_vectors.json).
+var rawMessageFixture = struct {
+	publicKey string
+	message   string
+	signature string
+}{
+	publicKey: "3e13d7238d0e768a567dce84b54915f2323f2dcd0ef9a716d9c61abed631ba10",
+	message:   "Sign in to app.example.com\nNonce: 7f3c91a2",
+	signature: "f5bd366e1f3fbe7aab725fde86440481ee2849c8973a4d032e9eb6197c7f747269c25680040f636d38b666c223e1ddb2457115972474132a0fa1c65cb41d350e",
+}
+
+func TestVerifyRawMessage(t *testing.T) {
+	pubKey := mustDecodeHex(t, rawMessageFixture.publicKey)
+	sig := mustDecodeHex(t, rawMessageFixture.signature)
+	message := rawMessageFixture.message
+
+	if !VerifyRawMessage(message, sig, pubKey) {
+		t.Fatal("VerifyRawMessage() rejected the fixture")
+	}
+	if VerifyRawMessage(message+" ", sig, pubKey) {
+		t.Error("VerifyRawMessage() accepted a different message")
+	}
+	if VerifyRawMessage(message, sig[:63], pubKey) {
+		t.Error("VerifyRawMessage() accepted a truncated signature")
+	}
+	if VerifyRawMessage(message, sig, pubKey[:31]) {
+		t.Error("VerifyRawMessage() accepted a truncated public key")
+	}
+	// The tagged format is deliberately incompatible in both directions.
+	if VerifyMessage("app.example.com", message, sig, pubKey) {
+		t.Error("VerifyMessage() accepted an untagged signature")
+	}
+
+	for _, v := range loadSignatureVectors(t) {
+		if v.Name != "Zenon account 0 text" {
+			continue
+		}
+		if !VerifyRawMessage(string(mustDecodeHex(t, v.Message)), mustDecodeHex(t, v.Signature), mustDecodeHex(t, v.PublicKey)) {
+			t.Errorf("VerifyRawMessage() rejected vector %q signed by go-zenon", v.Name)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	zwallet "github.com/zenon-network/go-zenon/wallet"
+)
+
+// signatureVector is one entry of testdata/ed25519_vectors.json. The first
+// three are RFC 8032 section 7.1 tests 1-3; the rest use keys derived by
+// go-zenon from the SDK's test mnemonic. Signatures were produced with
+// go-zenon's wallet.KeyPair.Sign.
+type signatureVector struct {
+	Name      string `json:"name"`
+	Seed      string `json:"seed"`
+	PublicKey string `json:"publicKey"`
+	Address   string `json:"address"`
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+}
+
+func loadSignatureVectors(t *testing.T) []signatureVector {
+	t.Helper()
+	data, err := os.ReadFile("testdata/ed25519_vectors.json")
+	if err != nil {
+		t.Fatalf("failed to read vectors: %v", err)
+	}
+	var vectors []signatureVector
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatalf("failed to parse vectors: %v", err)
+	}
+	if len(vectors) == 0 {
+		t.Fatal("no vectors loaded")
+	}
+	return vectors
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("invalid hex %q: %v", s, err)
+	}
+	return b
+}
+
+func TestSignatureVectors(t *testing.T) {
+	for _, v := range loadSignatureVectors(t) {
+		t.Run(v.Name, func(t *testing.T) {
+			privateKey := ed25519.NewKeyFromSeed(mustDecodeHex(t, v.Seed))
+			message := mustDecodeHex(t, v.Message)
+			wantSignature := mustDecodeHex(t, v.Signature)
+
+			publicKey, err := GetPublicKey(privateKey)
+			if err != nil {
+				t.Fatalf("GetPublicKey() error = %v", err)
+			}
+			if hex.EncodeToString(publicKey) != v.PublicKey {
+				t.Errorf("public key = %x, want %s", publicKey, v.PublicKey)
+			}
+
+			address, err := AddressFromPublicKey(publicKey)
+			if err != nil {
+				t.Fatalf("AddressFromPublicKey() error = %v", err)
+			}
+			if address.String() != v.Address {
+				t.Errorf("address = %s, want %s", address, v.Address)
+			}
+
+			signature, err := Sign(message, privateKey)
+			if err != nil {
+				t.Fatalf("Sign() error = %v", err)
+			}
+			if !bytes.Equal(signature, wantSignature) {
+				t.Errorf("signature = %x, want %s", signature, v.Signature)
+			}
+			again, _ := Sign(message, privateKey)
+			if !bytes.Equal(again, signature) {
+				t.Error("Sign() is not deterministic")
+			}
+
+			zenonKeyPair := zwallet.KeyPair{Private: privateKey, Public: publicKey}
+			if zenonSignature := zenonKeyPair.Sign(message); !bytes.Equal(zenonSignature, signature) {
+				t.Errorf("go-zenon signature = %x, SDK signature = %x", zenonSignature, signature)
+			}
+
+			if ok, err := VerifyWithAddress(wantSignature, message, publicKey, address); !ok {
+				t.Errorf("VerifyWithAddress() = false, %v", err)
+			}
+		})
+	}
+}
+
+func TestAddressFromPublicKey_InvalidSize(t *testing.T) {
+	for _, size := range []int{0, 31, 33, 64} {
+		if _, err := AddressFromPublicKey(make([]byte, size)); err == nil {
+			t.Errorf("AddressFromPublicKey(%d bytes) should return an error", size)
+		}
+		if _, err := CoreBytesFromPublicKey(make([]byte, size)); err == nil {
+			t.Errorf("CoreBytesFromPublicKey(%d bytes) should return an error", size)
+		}
+	}
+}
+
+func TestCoreBytesFromPublicKey(t *testing.T) {
+	for _, v := range loadSignatureVectors(t) {
+		publicKey := mustDecodeHex(t, v.PublicKey)
+		core, err := CoreBytesFromPublicKey(publicKey)
+		if err != nil {
+			t.Fatalf("%s: CoreBytesFromPublicKey() error = %v", v.Name, err)
+		}
+		address := types.ParseAddressPanic(v.Address)
+		if !bytes.Equal(core, address.Bytes()[1:]) {
+			t.Errorf("%s: core bytes = %x, want %x", v.Name, core, address.Bytes()[1:])
+		}
+	}
+}
@@ -35,6 +35,44 @@ var (
 	MinPlasmaAmount = big.NewInt(21000)
 )
 
+const (
+	// CostPerFusionUnit is the QSR amount, in base units, that buys one
+	// fusion unit. Fused amounts are rounded down to whole units.
+	CostPerFusionUnit = OneQsr
+
+	// PlasmaPerFusionUnit is the plasma granted by one fusion unit
+	PlasmaPerFusionUnit = 2100
+
+	// MaxFusionUnitsPerAccount is the number of fusion units beyond which
+	// fusing more QSR to an account no longer increases its plasma
+	MaxFusionUnitsPerAccount = 5000
+
+	// MaxFusionPlasmaForAccount is the most plasma fusion can give an account
+	MaxFusionPlasmaForAccount = MaxFusionUnitsPerAccount * PlasmaPerFusionUnit
+
+	// AccountBlockBasePlasma is the plasma of a receive block or of a send
+	// block that carries no data
+	AccountBlockBasePlasma = 21000
+
+	// PlasmaPerDataByte is the extra plasma charged for each byte of data in
+	// a send block to a non-contract address
+	PlasmaPerDataByte = 68
+
+	// MaxAccountBlockDataLength is the largest Data field a block may carry
+	MaxAccountBlockDataLength = 16 * 1024
+
+	// EmbeddedSimplePlasma is the plasma of most embedded contract calls
+	EmbeddedSimplePlasma = AccountBlockBasePlasma * 5 / 2
+
+	// EmbeddedWithdrawPlasma is the plasma of embedded calls whose execution
+	// sends funds back to the caller (for example CancelFuse or Mint)
+	EmbeddedWithdrawPlasma = AccountBlockBasePlasma * 7 / 2
+
+	// EmbeddedDoubleWithdrawPlasma is the plasma of embedded calls whose
+	// execution sends two blocks back (for example Sentinel.Revoke)
+	EmbeddedDoubleWithdrawPlasma = AccountBlockBasePlasma * 9 / 2
+)
+
 // =============================================================================
 // Pillar Constants
 // =============================================================================
@@ -3,6 +3,8 @@ package embedded
 import (
 	"math/big"
 	"testing"
+
+	zenonconstants "github.com/zenon-network/go-zenon/vm/constants"
 )
 
 // =============================================================================
@@ -51,6 +53,43 @@ func TestMinPlasmaAmount(t *testing.T) {
 	}
 }
 
+func TestFusionConstantsMatchGoZenon(t *testing.T) {
+	if CostPerFusionUnit != zenonconstants.CostPerFusionUnit {
+		t.Errorf("CostPerFusionUnit = %d, want %d", CostPerFusionUnit, zenonconstants.CostPerFusionUnit)
+	}
+	if PlasmaPerFusionUnit != zenonconstants.PlasmaPerFusionUnit {
+		t.Errorf("PlasmaPerFusionUnit = %d, want %d", PlasmaPerFusionUnit, zenonconstants.PlasmaPerFusionUnit)
+	}
+	if MaxFusionUnitsPerAccount != zenonconstants.MaxFusionUnitsPerAccount {
+		t.Errorf("MaxFusionUnitsPerAccount = %d, want %d", MaxFusionUnitsPerAccount, zenonconstants.MaxFusionUnitsPerAccount)
+	}
+	if MaxFusionPlasmaForAccount != zenonconstants.MaxFusionPlasmaForAccount {
+		t.Errorf("MaxFusionPlasmaForAccount = %d, want %d", MaxFusionPlasmaForAccount, zenonconstants.MaxFusionPlasmaForAccount)
+	}
+}
+
+func TestBlockPlasmaConstantsMatchGoZenon(t *testing.T) {
+	if AccountBlockBasePlasma != zenonconstants.AccountBlockBasePlasma {
+		t.Errorf("AccountBlockBasePlasma = %d, want %d", AccountBlockBasePlasma, zenonconstants.AccountBlockBasePlasma)
+	}
+	if PlasmaPerDataByte != zenonconstants.ABByteDataPlasma {
+		t.Errorf("PlasmaPerDataByte = %d, want %d", PlasmaPerDataByte, zenonconstants.ABByteDataPlasma)
+	}
+	if MaxAccountBlockDataLength != zenonconstants.MaxDataLength {
+		t.Errorf("MaxAccountBlockDataLength = %d, want %d", MaxAccountBlockDataLength, zenonconstants.MaxDataLength)
+	}
+	table := zenonconstants.AlphanetPlasmaTable
+	if EmbeddedSimplePlasma != table.EmbeddedSimple {
+		t.Errorf("EmbeddedSimplePlasma = %d, want %d", EmbeddedSimplePlasma, table.EmbeddedSimple)
+	}
+	if EmbeddedWithdrawPlasma != table.EmbeddedWWithdraw {
+		t.Errorf("EmbeddedWithdrawPlasma = %d, want %d", EmbeddedWithdrawPlasma, table.EmbeddedWWithdraw)
+	}
+	if EmbeddedDoubleWithdrawPlasma != table.EmbeddedWDoubleWithdraw {
+		t.Errorf("EmbeddedDoubleWithdrawPlasma = %d, want %d", EmbeddedDoubleWithdrawPlasma, table.EmbeddedWDoubleWithdraw)
+	}
+}
+
 // =============================================================================
 // Pillar Constants Tests
 // =============================================================================
@@ -140,6 +179,9 @@ func TestStakeMinZnnAmount(t *testing.T) {
 	if StakeMinZnnAmount.Cmp(expected) != 0 {
 		t.Errorf("StakeMinZnnAmount = %s, want %s", StakeMinZnnAmount, expected)
 	}
+	if StakeMinZnnAmount.Cmp(zenonconstants.StakeMinAmount) != 0 {
+		t.Errorf("StakeMinZnnAmount = %s, go-zenon StakeMinAmount = %s", StakeMinZnnAmount, zenonconstants.StakeMinAmount)
+	}
 }
 
 func TestStakeTimeConstants(t *testing.T) {
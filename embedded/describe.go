@@ -0,0 +1,164 @@
+package embedded
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/0x3639/znn-sdk-go/abi"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// =============================================================================
+// Block Descriptions - Decoding Embedded Contract Calls
+// =============================================================================
+
+// ErrNotEmbeddedContract is returned by DescribeBlock for blocks whose
+// ToAddress is not an embedded contract.
+var ErrNotEmbeddedContract = errors.New("block is not addressed to an embedded contract")
+
+// CallDescription is a decoded embedded contract call.
+type CallDescription struct {
+	// Contract is the contract name, matching the parsed ABI variable
+	// (for example "Token" or "Pillar")
+	Contract string
+	// Address is the contract address the block was sent to
+	Address types.Address
+	// Method is the ABI method name (for example "Mint")
+	Method string
+	// Arguments maps each ABI input name to its decoded value, using the
+	// types produced by the abi package (*big.Int, string, types.Address, ...)
+	Arguments map[string]interface{}
+}
+
+// embeddedContract pairs an embedded contract address with its name and ABI.
+type embeddedContract struct {
+	name    string
+	address types.Address
+	abi     func() *abi.Abi
+}
+
+// embeddedContracts lists every contract DescribeBlock can decode. The ABIs
+// are read through functions because they are parsed in init.
+var embeddedContracts = []embeddedContract{
+	{"Plasma", types.PlasmaContract, func() *abi.Abi { return Plasma }},
+	{"Pillar", types.PillarContract, func() *abi.Abi { return Pillar }},
+	{"Token", types.TokenContract, func() *abi.Abi { return Token }},
+	{"Sentinel", types.SentinelContract, func() *abi.Abi { return Sentinel }},
+	{"Swap", types.SwapContract, func() *abi.Abi { return Swap }},
+	{"Stake", types.StakeContract, func() *abi.Abi { return Stake }},
+	{"Accelerator", types.AcceleratorContract, func() *abi.Abi { return Accelerator }},
+	{"Spork", types.SporkContract, func() *abi.Abi { return Spork }},
+	{"Htlc", types.HtlcContract, func() *abi.Abi { return Htlc }},
+	{"Bridge", types.BridgeContract, func() *abi.Abi { return Bridge }},
+	{"Liquidity", types.LiquidityContract, func() *abi.Abi { return Liquidity }},
+}
+
+// DescribeBlock decodes the embedded contract call carried by a send block.
+//
+// The contract is chosen by the block's ToAddress and the method by the
+// 4-byte selector at the start of Data; the remaining data is decoded with
+// that method's ABI inputs.
+//
+// Parameters:
+//   - block: Account block to describe, typically read from the ledger
+//
+// Returns the call description, or nil with no error if the block is sent to
+// an embedded contract but carries no data or an unknown selector (for example
+// a plain transfer). Returns an error wrapping ErrNotEmbeddedContract if
+// ToAddress is not an embedded contract, or an error if the arguments do not
+// decode.
+//
+// Example:
+//
+//	call, err := embedded.DescribeBlock(&block.AccountBlock)
+//	if errors.Is(err, embedded.ErrNotEmbeddedContract) {
+//	    // ordinary transfer between accounts
+//	} else if err == nil && call != nil {
+//	    fmt.Printf("%s.%s %v\n", call.Contract, call.Method, call.Arguments)
+//	}
+func DescribeBlock(block *nom.AccountBlock) (*CallDescription, error) {
+	if block == nil {
+		return nil, fmt.Errorf("nil block")
+	}
+
+	var contract *embeddedContract
+	for i := range embeddedContracts {
+		if embeddedContracts[i].address == block.ToAddress {
+			contract = &embeddedContracts[i]
+			break
+		}
+	}
+	if contract == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotEmbeddedContract, block.ToAddress)
+	}
+
+	if len(block.Data) < abi.EncodedSignLength {
+		return nil, nil
+	}
+	selector := block.Data[:abi.EncodedSignLength]
+	for _, entry := range contract.abi().Entries {
+		if entry.Type != abi.Function {
+			continue
+		}
+		fn := abi.NewAbiFunction(entry.Name, entry.Inputs)
+		if !bytes.Equal(fn.EncodeSignature(), selector) {
+			continue
+		}
+
+		values, err := fn.Decode(block.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s.%s arguments: %w", contract.name, entry.Name, err)
+		}
+		arguments := make(map[string]interface{}, len(values))
+		for i, value := range values {
+			arguments[entry.Inputs[i].Name] = value
+		}
+		return &CallDescription{
+			Contract:  contract.name,
+			Address:   contract.address,
+			Method:    entry.Name,
+			Arguments: arguments,
+		}, nil
+	}
+	return nil, nil
+}
+
+// methodPlasma lists the embedded calls that cost more than
+// EmbeddedSimplePlasma, keyed by "Contract.Method". It mirrors the current
+// go-zenon method table, after all sporks.
+var methodPlasma = map[string]uint64{
+	"Accelerator.Update":             EmbeddedWithdrawPlasma,
+	"Bridge.Redeem":                  EmbeddedWithdrawPlasma,
+	"Htlc.Reclaim":                   EmbeddedWithdrawPlasma,
+	"Htlc.Unlock":                    EmbeddedWithdrawPlasma,
+	"Liquidity.CancelLiquidityStake": EmbeddedWithdrawPlasma,
+	"Liquidity.CollectReward":        EmbeddedDoubleWithdrawPlasma,
+	"Pillar.Revoke":                  EmbeddedWithdrawPlasma,
+	"Pillar.WithdrawQsr":             EmbeddedWithdrawPlasma,
+	"Plasma.CancelFuse":              EmbeddedWithdrawPlasma,
+	"Sentinel.Revoke":                EmbeddedDoubleWithdrawPlasma,
+	"Sentinel.WithdrawQsr":           EmbeddedWithdrawPlasma,
+	"Stake.Cancel":                   EmbeddedWithdrawPlasma,
+	"Swap.RetrieveAssets":            EmbeddedDoubleWithdrawPlasma,
+	"Token.IssueToken":               EmbeddedWithdrawPlasma,
+	"Token.Mint":                     EmbeddedWithdrawPlasma,
+}
+
+// BasePlasma returns the plasma the protocol charges for this call, before
+// any PoW or fused plasma is applied. Calls that are not listed as withdraw
+// or double-withdraw calls cost EmbeddedSimplePlasma.
+//
+// Example:
+//
+//	call, _ := embedded.DescribeBlock(block)
+//	if call != nil {
+//	    fmt.Println(call.BasePlasma()) // 73500 for Plasma.CancelFuse
+//	}
+func (c *CallDescription) BasePlasma() uint64 {
+	if plasma, ok := methodPlasma[c.Contract+"."+c.Method]; ok {
+		return plasma
+	}
+	return EmbeddedSimplePlasma
+}
@@ -0,0 +1,143 @@
+package embedded
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/abi"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func describeTestBlock(t *testing.T, to types.Address, contract *abi.Abi, method string, args ...interface{}) *nom.AccountBlock {
+	t.Helper()
+	data, err := contract.EncodeFunction(method, args)
+	if err != nil {
+		t.Fatalf("EncodeFunction(%s) error = %v", method, err)
+	}
+	return &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, ToAddress: to, Data: data}
+}
+
+func TestDescribeBlock_TokenMint(t *testing.T) {
+	receiver := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	block := describeTestBlock(t, types.TokenContract, Token, "Mint", types.ZnnTokenStandard, big.NewInt(5000), receiver)
+
+	call, err := DescribeBlock(block)
+	if err != nil {
+		t.Fatalf("DescribeBlock() error = %v", err)
+	}
+	if call == nil || call.Contract != "Token" || call.Method != "Mint" || call.Address != types.TokenContract {
+		t.Fatalf("DescribeBlock() = %+v, want Token.Mint", call)
+	}
+	if zts, ok := call.Arguments["tokenStandard"].(types.ZenonTokenStandard); !ok || zts != types.ZnnTokenStandard {
+		t.Errorf("tokenStandard = %v, want %s", call.Arguments["tokenStandard"], types.ZnnTokenStandard)
+	}
+	if amount, ok := call.Arguments["amount"].(*big.Int); !ok || amount.Cmp(big.NewInt(5000)) != 0 {
+		t.Errorf("amount = %v, want 5000", call.Arguments["amount"])
+	}
+	if addr, ok := call.Arguments["receiveAddress"].(types.Address); !ok || addr != receiver {
+		t.Errorf("receiveAddress = %v, want %s", call.Arguments["receiveAddress"], receiver)
+	}
+}
+
+func TestDescribeBlock_MethodWithoutArguments(t *testing.T) {
+	call, err := DescribeBlock(describeTestBlock(t, types.TokenContract, Token, "Burn"))
+	if err != nil {
+		t.Fatalf("DescribeBlock() error = %v", err)
+	}
+	if call == nil || call.Method != "Burn" || len(call.Arguments) != 0 {
+		t.Fatalf("DescribeBlock() = %+v, want Token.Burn without arguments", call)
+	}
+}
+
+func TestDescribeBlock_SameMethodNameOnDifferentContracts(t *testing.T) {
+	producer := types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")
+	pillar, err := DescribeBlock(describeTestBlock(t, types.PillarContract, Pillar, "Register", "pillar-1", producer, producer, 0, 100))
+	if err != nil {
+		t.Fatalf("DescribeBlock(pillar) error = %v", err)
+	}
+	if pillar == nil || pillar.Contract != "Pillar" || pillar.Method != "Register" || pillar.Arguments["name"] != "pillar-1" {
+		t.Errorf("DescribeBlock(pillar) = %+v, want Pillar.Register of pillar-1", pillar)
+	}
+
+	sentinel, err := DescribeBlock(describeTestBlock(t, types.SentinelContract, Sentinel, "Register"))
+	if err != nil {
+		t.Fatalf("DescribeBlock(sentinel) error = %v", err)
+	}
+	if sentinel == nil || sentinel.Contract != "Sentinel" || sentinel.Method != "Register" {
+		t.Errorf("DescribeBlock(sentinel) = %+v, want Sentinel.Register", sentinel)
+	}
+}
+
+func TestDescribeBlock_UnrecognizedCalls(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "no data", data: nil},
+		{name: "short data", data: []byte{1, 2}},
+		{name: "unknown selector", data: []byte{0xde, 0xad, 0xbe, 0xef}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call, err := DescribeBlock(&nom.AccountBlock{ToAddress: types.PlasmaContract, Data: tt.data})
+			if err != nil || call != nil {
+				t.Errorf("DescribeBlock() = %+v, %v, want nil, nil", call, err)
+			}
+		})
+	}
+}
+
+func TestDescribeBlock_Errors(t *testing.T) {
+	user := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	if _, err := DescribeBlock(&nom.AccountBlock{ToAddress: user, Data: []byte{1, 2, 3, 4}}); !errors.Is(err, ErrNotEmbeddedContract) {
+		t.Errorf("DescribeBlock(user address) error = %v, want ErrNotEmbeddedContract", err)
+	}
+	if _, err := DescribeBlock(nil); err == nil {
+		t.Error("DescribeBlock(nil) should return an error")
+	}
+
+	block := describeTestBlock(t, types.PlasmaContract, Plasma, "Fuse", user)
+	block.Data = block.Data[:len(block.Data)-8]
+	if _, err := DescribeBlock(block); err == nil {
+		t.Error("DescribeBlock(truncated arguments) should return an error")
+	}
+}
+
+func TestCallDescription_BasePlasma(t *testing.T) {
+	tests := []struct {
+		contract, method string
+		want             uint64
+	}{
+		{"Plasma", "Fuse", EmbeddedSimplePlasma},
+		{"Plasma", "CancelFuse", EmbeddedWithdrawPlasma},
+		{"Pillar", "Revoke", EmbeddedWithdrawPlasma},
+		{"Pillar", "CollectReward", EmbeddedSimplePlasma},
+		{"Sentinel", "Revoke", EmbeddedDoubleWithdrawPlasma},
+		{"Liquidity", "CollectReward", EmbeddedDoubleWithdrawPlasma},
+		{"Token", "IssueToken", EmbeddedWithdrawPlasma},
+	}
+	for _, tt := range tests {
+		call := &CallDescription{Contract: tt.contract, Method: tt.method}
+		if got := call.BasePlasma(); got != tt.want {
+			t.Errorf("%s.%s BasePlasma() = %d, want %d", tt.contract, tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestMethodPlasmaEntriesExist(t *testing.T) {
+	for key := range methodPlasma {
+		found := false
+		for _, contract := range embeddedContracts {
+			for _, entry := range contract.abi().Entries {
+				if contract.name+"."+entry.Name == key {
+					found = true
+				}
+			}
+		}
+		if !found {
+			t.Errorf("methodPlasma key %q does not name an embedded ABI method", key)
+		}
+	}
+}
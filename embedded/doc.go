@@ -46,6 +46,15 @@
 //	embedded.TokenMaxSupply = big.NewInt(2^255)
 //	embedded.TokenMaxDecimals = 18
 //
+// # Token Standards
+//
+// A new token's ZTS is derived from the hash of its IssueToken send block, so
+// it cannot be predicted from the issuance parameters. Read it from the
+// completed send block, or confirm it from the contract's receive block:
+//
+//	zts, err := embedded.TokenStandardFromIssueBlock(signedIssueBlock)
+//	zts, err := embedded.TokenStandardFromIssueReceipt(receiveBlock)
+//
 // # Validation Utilities
 //
 // Validate contract parameters before submission:
@@ -60,10 +69,13 @@
 //	    log.Fatal("Invalid token symbol")
 //	}
 //
-//	// Validate stake duration
-//	if !embedded.IsValidStakeDuration(months) {
+//	// Validate stake duration and amount
+//	if !embedded.IsValidStakeDuration(months * embedded.StakeTimeUnitSec) {
 //	    log.Fatal("Stake duration must be 1-12 months")
 //	}
+//	if !embedded.IsValidStakeAmount(amount) {
+//	    log.Fatal("Stake at least 1 ZNN")
+//	}
 //
 // # Contract Definitions
 //
@@ -78,6 +90,16 @@
 //	pillarDef := embedded.GetContractDefinition(embedded.PillarContract)
 //	fmt.Println("Methods:", pillarDef.Methods)
 //
+// # Describing Blocks
+//
+// DescribeBlock decodes the contract call carried by a send block, for
+// explorers and activity feeds:
+//
+//	call, err := embedded.DescribeBlock(&block.AccountBlock)
+//	if err == nil && call != nil {
+//	    fmt.Println(call.Contract, call.Method, call.Arguments["amount"])
+//	}
+//
 // # Usage in Contract Calls
 //
 // Typically used indirectly through api/embedded:
@@ -0,0 +1,118 @@
+package embedded
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// =============================================================================
+// Token Standards - Learning the ZTS of an Issued Token
+// =============================================================================
+//
+// The Token contract derives a new token's ZTS from the hash of the IssueToken
+// send block, not from the issuance parameters. Two issuances with identical
+// name, symbol and supply get different ZTS values, so the ZTS cannot be
+// predicted from the parameters alone. It is known as soon as the send block
+// is complete (autofilled, with PoW or plasma, hashed and signed), which is
+// still before it is published.
+
+var (
+	// ErrNotTokenIssuance is returned when a block is not an IssueToken call
+	// or the Token contract's receive block for one.
+	ErrNotTokenIssuance = errors.New("block is not a token issuance")
+
+	// ErrTokenIssuanceFailed is returned by TokenStandardFromIssueReceipt when
+	// the Token contract rejected the issuance.
+	ErrTokenIssuanceFailed = errors.New("token issuance failed")
+)
+
+// TokenStandardFromIssueBlock returns the ZTS the Token contract will assign
+// to the token issued by send, an IssueToken send block.
+//
+// The block's Hash must already be final: call this after the block has been
+// autofilled, given PoW or plasma and signed, and before or after it is
+// published. Changing any field afterwards changes the hash and the ZTS.
+//
+// Parameters:
+//   - send: Completed IssueToken send block, for example from TokenApi.IssueToken
+//
+// Returns the ZTS, or an error wrapping ErrNotTokenIssuance if the block is
+// not an IssueToken call, or an error if its hash has not been set.
+//
+// Example:
+//
+//	template := client.TokenApi.IssueToken("My Token", "MTK", "", supply, supply, 8, false, true, false)
+//	// ... autofill, PoW, sign ...
+//	zts, err := embedded.TokenStandardFromIssueBlock(template)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println("will issue", zts)
+func TokenStandardFromIssueBlock(send *nom.AccountBlock) (types.ZenonTokenStandard, error) {
+	if send == nil {
+		return types.ZeroTokenStandard, fmt.Errorf("nil block")
+	}
+	call, err := DescribeBlock(send)
+	if err != nil && !errors.Is(err, ErrNotEmbeddedContract) {
+		return types.ZeroTokenStandard, err
+	}
+	if send.BlockType != nom.BlockTypeUserSend || call == nil || call.Contract != "Token" || call.Method != "IssueToken" {
+		return types.ZeroTokenStandard, fmt.Errorf("%w: block %s", ErrNotTokenIssuance, send.Hash)
+	}
+	if send.Hash == types.ZeroHash {
+		return types.ZeroTokenStandard, fmt.Errorf("issue block has no hash yet")
+	}
+	return types.NewZenonTokenStandard(send.Hash.Bytes()), nil
+}
+
+// TokenStandardFromIssueReceipt returns the ZTS of the token created by an
+// issuance, given the Token contract's receive block for it.
+//
+// A successful issuance's receive block carries one descendant contract send
+// that delivers the initial supply, in the new token, to the issuer. A failed
+// issuance refunds the fee in ZNN instead. The receive block must therefore
+// include its DescendantBlocks, as the ledger API returns them.
+//
+// Parameters:
+//   - receive: Token contract receive block, for example the
+//     PairedAccountBlock of the issue block once it has been received
+//
+// Returns the ZTS, or an error wrapping ErrNotTokenIssuance if the block is
+// not the Token contract's receive block for an issuance, or
+// ErrTokenIssuanceFailed if the contract rejected it.
+//
+// Example:
+//
+//	send, err := client.LedgerApi.GetAccountBlockByHash(issueHash)
+//	if err != nil || send.PairedAccountBlock == nil {
+//	    return err // not received by the contract yet
+//	}
+//	zts, err := embedded.TokenStandardFromIssueReceipt(&send.PairedAccountBlock.AccountBlock)
+//	if errors.Is(err, embedded.ErrTokenIssuanceFailed) {
+//	    // fee refunded, no token was created
+//	}
+func TokenStandardFromIssueReceipt(receive *nom.AccountBlock) (types.ZenonTokenStandard, error) {
+	if receive == nil {
+		return types.ZeroTokenStandard, fmt.Errorf("nil block")
+	}
+	if receive.BlockType != nom.BlockTypeContractReceive || receive.Address != types.TokenContract {
+		return types.ZeroTokenStandard, fmt.Errorf("%w: block %s is not a Token contract receive block", ErrNotTokenIssuance, receive.Hash)
+	}
+
+	zts := types.NewZenonTokenStandard(receive.FromBlockHash.Bytes())
+	for _, descendant := range receive.DescendantBlocks {
+		if descendant == nil || descendant.BlockType != nom.BlockTypeContractSend {
+			continue
+		}
+		if descendant.TokenStandard == zts {
+			return zts, nil
+		}
+		if descendant.TokenStandard == types.ZnnTokenStandard {
+			return types.ZeroTokenStandard, fmt.Errorf("%w: send block %s was refunded", ErrTokenIssuanceFailed, receive.FromBlockHash)
+		}
+	}
+	return types.ZeroTokenStandard, fmt.Errorf("%w: receive block %s does not mint a token for send block %s", ErrNotTokenIssuance, receive.Hash, receive.FromBlockHash)
+}
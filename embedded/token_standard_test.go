@@ -0,0 +1,97 @@
+package embedded
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func issueTestBlock(t *testing.T) *nom.AccountBlock {
+	t.Helper()
+	supply := big.NewInt(100000000)
+	block := describeTestBlock(t, types.TokenContract, Token, "IssueToken", "My Token", "MTK", "", supply, supply, uint8(8), false, true, false)
+	block.Hash = types.HexToHashPanic("4d3c6e8c5c6e4c0b2c5ad6c4a6b5b4a2d2f6c9e4b1a8e0f3a7d6c5b4a3928170")
+	return block
+}
+
+func TestTokenStandardFromIssueBlock(t *testing.T) {
+	block := issueTestBlock(t)
+	zts, err := TokenStandardFromIssueBlock(block)
+	if err != nil {
+		t.Fatalf("TokenStandardFromIssueBlock() error = %v", err)
+	}
+	if want := types.NewZenonTokenStandard(block.Hash.Bytes()); zts != want {
+		t.Errorf("TokenStandardFromIssueBlock() = %s, want %s", zts, want)
+	}
+
+	// Same parameters, different block: different token.
+	other := issueTestBlock(t)
+	other.Hash = types.HexToHashPanic("0000000000000000000000000000000000000000000000000000000000000001")
+	if otherZts, _ := TokenStandardFromIssueBlock(other); otherZts == zts {
+		t.Error("issue blocks with different hashes produced the same ZTS")
+	}
+}
+
+func TestTokenStandardFromIssueBlock_Rejects(t *testing.T) {
+	mint := describeTestBlock(t, types.TokenContract, Token, "Mint", types.ZnnTokenStandard, big.NewInt(1), types.TokenContract)
+	mint.Hash = types.HexToHashPanic("0000000000000000000000000000000000000000000000000000000000000001")
+	if _, err := TokenStandardFromIssueBlock(mint); !errors.Is(err, ErrNotTokenIssuance) {
+		t.Errorf("Mint block error = %v, want ErrNotTokenIssuance", err)
+	}
+
+	transfer := &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, ToAddress: types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")}
+	if _, err := TokenStandardFromIssueBlock(transfer); !errors.Is(err, ErrNotTokenIssuance) {
+		t.Errorf("transfer error = %v, want ErrNotTokenIssuance", err)
+	}
+
+	unhashed := issueTestBlock(t)
+	unhashed.Hash = types.ZeroHash
+	if _, err := TokenStandardFromIssueBlock(unhashed); err == nil {
+		t.Error("issue block without a hash should fail")
+	}
+}
+
+func TestTokenStandardFromIssueReceipt(t *testing.T) {
+	send := issueTestBlock(t)
+	want := types.NewZenonTokenStandard(send.Hash.Bytes())
+	receive := &nom.AccountBlock{
+		BlockType:     nom.BlockTypeContractReceive,
+		Address:       types.TokenContract,
+		FromBlockHash: send.Hash,
+		DescendantBlocks: []*nom.AccountBlock{{
+			BlockType:     nom.BlockTypeContractSend,
+			Address:       types.TokenContract,
+			TokenStandard: want,
+			Amount:        big.NewInt(100000000),
+		}},
+	}
+
+	zts, err := TokenStandardFromIssueReceipt(receive)
+	if err != nil {
+		t.Fatalf("TokenStandardFromIssueReceipt() error = %v", err)
+	}
+	if zts != want {
+		t.Errorf("TokenStandardFromIssueReceipt() = %s, want %s", zts, want)
+	}
+	if fromSend, _ := TokenStandardFromIssueBlock(send); fromSend != zts {
+		t.Errorf("send block gives %s, receipt gives %s", fromSend, zts)
+	}
+
+	receive.DescendantBlocks[0].TokenStandard = types.ZnnTokenStandard
+	if _, err := TokenStandardFromIssueReceipt(receive); !errors.Is(err, ErrTokenIssuanceFailed) {
+		t.Errorf("refunded issuance error = %v, want ErrTokenIssuanceFailed", err)
+	}
+
+	receive.DescendantBlocks = nil
+	if _, err := TokenStandardFromIssueReceipt(receive); !errors.Is(err, ErrNotTokenIssuance) {
+		t.Errorf("receive without descendants error = %v, want ErrNotTokenIssuance", err)
+	}
+
+	receive.Address = types.PillarContract
+	if _, err := TokenStandardFromIssueReceipt(receive); !errors.Is(err, ErrNotTokenIssuance) {
+		t.Errorf("Pillar receive block error = %v, want ErrNotTokenIssuance", err)
+	}
+}
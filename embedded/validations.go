@@ -1,6 +1,9 @@
 package embedded
 
-import "fmt"
+import (
+	"fmt"
+	"math/big"
+)
 
 // =============================================================================
 // Token Validations
@@ -110,3 +113,43 @@ func ValidateProjectDescription(value string) error {
 
 	return nil
 }
+
+// =============================================================================
+// Staking Validations
+// =============================================================================
+
+// ValidateStakeDuration validates a stake duration in seconds: a whole number
+// of StakeTimeUnitSec between StakeTimeMinSec and StakeTimeMaxSec
+func ValidateStakeDuration(durationInSec int64) error {
+	if durationInSec < StakeTimeMinSec || durationInSec > StakeTimeMaxSec {
+		return fmt.Errorf("stake duration must be between %d and %d %ss, got %d seconds",
+			StakeTimeMinSec/StakeTimeUnitSec, StakeTimeMaxSec/StakeTimeUnitSec, StakeUnitDurationName, durationInSec)
+	}
+
+	if durationInSec%StakeTimeUnitSec != 0 {
+		return fmt.Errorf("stake duration must be a whole number of %ss (%d seconds), got %d seconds",
+			StakeUnitDurationName, StakeTimeUnitSec, durationInSec)
+	}
+
+	return nil
+}
+
+// ValidateStakeAmount validates a stake amount in ZNN base units: at least
+// StakeMinZnnAmount
+func ValidateStakeAmount(amount *big.Int) error {
+	if amount == nil || amount.Cmp(StakeMinZnnAmount) < 0 {
+		return fmt.Errorf("stake amount must be at least %s base units (1 ZNN), got %s", StakeMinZnnAmount, amount)
+	}
+
+	return nil
+}
+
+// IsValidStakeDuration reports whether durationInSec is an accepted stake duration
+func IsValidStakeDuration(durationInSec int64) bool {
+	return ValidateStakeDuration(durationInSec) == nil
+}
+
+// IsValidStakeAmount reports whether amount meets the minimum stake amount
+func IsValidStakeAmount(amount *big.Int) bool {
+	return ValidateStakeAmount(amount) == nil
+}
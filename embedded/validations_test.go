@@ -1,6 +1,7 @@
 package embedded
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 )
@@ -342,3 +343,67 @@ func TestValidatePillarName_AtMaxLength(t *testing.T) {
 		t.Errorf("ValidatePillarName() should accept name at max length (%d)", PillarNameMaxLength)
 	}
 }
+
+// =============================================================================
+// Staking Validation Tests
+// =============================================================================
+
+func TestIsValidStakeAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount *big.Int
+		want   bool
+	}{
+		{"nil", nil, false},
+		{"zero", big.NewInt(0), false},
+		{"negative", big.NewInt(-OneZnn), false},
+		{"one base unit below minimum", big.NewInt(OneZnn - 1), false},
+		{"minimum", big.NewInt(OneZnn), true},
+		{"one base unit above minimum", big.NewInt(OneZnn + 1), true},
+		{"well above minimum", big.NewInt(5000 * OneZnn), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidStakeAmount(tt.amount); got != tt.want {
+				t.Errorf("IsValidStakeAmount(%v) = %v, want %v", tt.amount, got, tt.want)
+			}
+			err := ValidateStakeAmount(tt.amount)
+			if (err == nil) != tt.want {
+				t.Errorf("ValidateStakeAmount(%v) error = %v", tt.amount, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "at least") {
+				t.Errorf("ValidateStakeAmount(%v) error %q does not state the minimum", tt.amount, err)
+			}
+		})
+	}
+}
+
+func TestIsValidStakeDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration int64
+		want     bool
+	}{
+		{"zero", 0, false},
+		{"negative", -StakeTimeUnitSec, false},
+		{"below minimum", StakeTimeMinSec - 1, false},
+		{"one month", StakeTimeMinSec, true},
+		{"six months", 6 * StakeTimeUnitSec, true},
+		{"twelve months", StakeTimeMaxSec, true},
+		{"not a whole month", StakeTimeUnitSec + 1, false},
+		{"365 days", 365 * 24 * 60 * 60, false},
+		{"thirteen months", StakeTimeMaxSec + StakeTimeUnitSec, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidStakeDuration(tt.duration); got != tt.want {
+				t.Errorf("IsValidStakeDuration(%d) = %v, want %v", tt.duration, got, tt.want)
+			}
+			if err := ValidateStakeDuration(tt.duration); (err == nil) != tt.want {
+				t.Errorf("ValidateStakeDuration(%d) error = %v", tt.duration, err)
+			}
+		})
+	}
+}
@@ -4,4 +4,5 @@
 // The helpers in this internal package reject invalid requests before a
 // transport call is attempted. They are shared by the ledger and embedded API
 // packages so every paginated endpoint applies the same canonical limits.
+// CollectUnreceived walks the unreceived-block listing within those limits.
 package rpcvalidation
@@ -10,6 +10,12 @@ const (
 	// MemoryPoolPageSize is the canonical maximum for unconfirmed, unreceived,
 	// and liquidity-stake memory-pool style endpoints.
 	MemoryPoolPageSize uint64 = 50
+
+	// UnreceivedMaxPageIndex is the first page index the node rejects for
+	// ledger.getUnreceivedBlocksByAddress. Only the first
+	// UnreceivedMaxPageIndex*MemoryPoolPageSize (500) unreceived blocks of an
+	// address can be listed.
+	UnreceivedMaxPageIndex uint64 = 10
 )
 
 // ValidateLimit checks one page-size or count argument against its endpoint maximum.
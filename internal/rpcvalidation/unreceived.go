@@ -0,0 +1,55 @@
+package rpcvalidation
+
+import (
+	"fmt"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// UnreceivedPageFunc fetches one page of ledger.getUnreceivedBlocksByAddress.
+type UnreceivedPageFunc func(pageIndex, pageSize uint32) (*api.AccountBlockList, error)
+
+// CollectUnreceived pages through an address's unreceived blocks with pages of
+// MemoryPoolPageSize, stopping after limit blocks.
+//
+// The node answers every page from the same list of at most 500 blocks and
+// reports its length in Count. Paging therefore ends on a short page, once
+// Count is reached, or at UnreceivedMaxPageIndex, whichever comes first. The
+// node's More flag only says that the mailbox behind that list held 500
+// hashes, so it is returned to the caller rather than used to page.
+//
+// Parameters:
+//   - fetch: Requests one page, normally LedgerApi.GetUnreceivedBlocksByAddress
+//   - limit: Most blocks to return; zero or negative means no limit
+//
+// Returns the blocks in the order the node lists them, each hash once, and
+// whether the node reported that more blocks may be pending than it lists.
+// Returns an error naming the page if fetch fails.
+func CollectUnreceived(fetch UnreceivedPageFunc, limit int) ([]*api.AccountBlock, bool, error) {
+	pageSize := uint32(MemoryPoolPageSize)
+	seen := make(map[types.Hash]bool)
+	var blocks []*api.AccountBlock
+	more := false
+	for pageIndex := uint32(0); pageIndex < uint32(UnreceivedMaxPageIndex); pageIndex++ {
+		page, err := fetch(pageIndex, pageSize)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list unreceived blocks (page %d): %w", pageIndex, err)
+		}
+		more = page.More
+		for _, block := range page.List {
+			if block == nil || seen[block.Hash] {
+				continue
+			}
+			seen[block.Hash] = true
+			blocks = append(blocks, block)
+			if limit > 0 && len(blocks) == limit {
+				return blocks, more, nil
+			}
+		}
+		if len(page.List) < int(pageSize) || (uint64(pageIndex)+1)*uint64(pageSize) >= uint64(page.Count) {
+			break
+		}
+	}
+	return blocks, more, nil
+}
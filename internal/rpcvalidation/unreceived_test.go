@@ -0,0 +1,105 @@
+package rpcvalidation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// mailbox serves pages the way go-zenon does: every page is cut from the same
+// list of at most 500 blocks, Count is that list's length, and More only says
+// that the mailbox held 500 hashes.
+type mailbox struct {
+	pending int
+	pages   []uint32
+}
+
+func (m *mailbox) fetch(pageIndex, pageSize uint32) (*api.AccountBlockList, error) {
+	m.pages = append(m.pages, pageIndex)
+	if uint64(pageIndex) >= UnreceivedMaxPageIndex {
+		return nil, errors.New("page index param too big")
+	}
+	listed := min(m.pending, 500)
+	start := min(int(pageIndex*pageSize), listed)
+	end := min(start+int(pageSize), listed)
+	page := &api.AccountBlockList{Count: listed, More: m.pending >= 500}
+	for i := start; i < end; i++ {
+		block := &api.AccountBlock{}
+		block.Hash = types.NewHash([]byte{byte(i >> 8), byte(i)})
+		page.List = append(page.List, block)
+	}
+	return page, nil
+}
+
+func TestCollectUnreceived(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		pending   int
+		limit     int
+		wantCount int
+		wantMore  bool
+		wantPages int
+	}{
+		{name: "empty", pending: 0, wantPages: 1},
+		{name: "one short page", pending: 30, wantCount: 30, wantPages: 1},
+		{name: "exactly one page", pending: 50, wantCount: 50, wantPages: 1},
+		{name: "several pages", pending: 120, wantCount: 120, wantPages: 3},
+		{name: "limit within a page", pending: 120, limit: 60, wantCount: 60, wantPages: 2},
+		{name: "limit on a page boundary", pending: 120, limit: 50, wantCount: 50, wantPages: 1},
+		{name: "full listing", pending: 500, wantCount: 500, wantMore: true, wantPages: 10},
+		{name: "mailbox larger than listing", pending: 700, wantCount: 500, wantMore: true, wantPages: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mailbox{pending: tt.pending}
+			blocks, more, err := CollectUnreceived(m.fetch, tt.limit)
+			if err != nil {
+				t.Fatalf("CollectUnreceived() error = %v", err)
+			}
+			if len(blocks) != tt.wantCount || more != tt.wantMore {
+				t.Errorf("CollectUnreceived() = %d blocks, more %v; want %d, %v", len(blocks), more, tt.wantCount, tt.wantMore)
+			}
+			if len(m.pages) != tt.wantPages {
+				t.Errorf("fetched pages %v, want %d pages", m.pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestCollectUnreceivedSkipsRepeatedBlocks(t *testing.T) {
+	t.Parallel()
+	shifted := &api.AccountBlock{}
+	shifted.Hash = types.NewHash([]byte("shifted"))
+	fetch := func(pageIndex, pageSize uint32) (*api.AccountBlockList, error) {
+		list := make([]*api.AccountBlock, 0, pageSize)
+		for i := uint32(0); i < pageSize-1; i++ {
+			block := &api.AccountBlock{}
+			block.Hash = types.NewHash([]byte{byte(pageIndex), byte(i)})
+			list = append(list, block)
+		}
+		// The last block of page 0 shows up again at the end of page 1.
+		list = append(list, shifted)
+		return &api.AccountBlockList{List: list, Count: 2 * int(pageSize)}, nil
+	}
+	blocks, _, err := CollectUnreceived(fetch, 0)
+	if err != nil {
+		t.Fatalf("CollectUnreceived() error = %v", err)
+	}
+	if want := 2*int(MemoryPoolPageSize) - 1; len(blocks) != want {
+		t.Errorf("CollectUnreceived() = %d blocks, want %d", len(blocks), want)
+	}
+}
+
+func TestCollectUnreceivedError(t *testing.T) {
+	t.Parallel()
+	failure := errors.New("node unavailable")
+	_, _, err := CollectUnreceived(func(uint32, uint32) (*api.AccountBlockList, error) {
+		return nil, failure
+	}, 0)
+	if !errors.Is(err, failure) {
+		t.Errorf("CollectUnreceived() error = %v, want %v", err, failure)
+	}
+}
@@ -0,0 +1,20 @@
+// Package logging defines the Logger interface the SDK uses for diagnostic
+// output.
+//
+// SDK packages never write to the standard logger directly. Components that
+// have something to report, such as the RPC client's reconnect loop and the
+// PoW difficulty cap, send it to a Logger, which defaults to Nop. Integrators
+// route SDK logs into their own framework by implementing the three methods:
+//
+//	type zapLogger struct{ s *zap.SugaredLogger }
+//
+//	func (l zapLogger) Debugf(format string, args ...interface{}) { l.s.Debugf(format, args...) }
+//	func (l zapLogger) Warnf(format string, args ...interface{})  { l.s.Warnf(format, args...) }
+//	func (l zapLogger) Errorf(format string, args ...interface{}) { l.s.Errorf(format, args...) }
+//
+//	opts := rpc_client.DefaultClientOptions()
+//	opts.Logger = zapLogger{s: sugar}
+//	pow.SetLogger(zapLogger{s: sugar})
+//
+// NewStdLogger adapts a standard library *log.Logger for quick setups.
+package logging
@@ -0,0 +1,62 @@
+package logging
+
+import "log"
+
+// Logger receives diagnostic messages from the SDK.
+//
+// Implementations must be safe for concurrent use; the RPC client logs from
+// background goroutines. Format strings follow fmt.Printf conventions.
+type Logger interface {
+	// Debugf reports routine events, such as a failed reconnect attempt
+	Debugf(format string, args ...interface{})
+	// Warnf reports recoverable anomalies, such as a capped PoW difficulty
+	Warnf(format string, args ...interface{})
+	// Errorf reports failures the SDK cannot recover from on its own
+	Errorf(format string, args ...interface{})
+}
+
+// Nop is a Logger that discards every message. It is the SDK default.
+var Nop Logger = nopLogger{}
+
+type nopLogger struct{}
+
+func (nopLogger) Debugf(string, ...interface{}) {}
+func (nopLogger) Warnf(string, ...interface{})  {}
+func (nopLogger) Errorf(string, ...interface{}) {}
+
+// OrNop returns logger, or Nop when logger is nil.
+func OrNop(logger Logger) Logger {
+	if logger == nil {
+		return Nop
+	}
+	return logger
+}
+
+// NewStdLogger returns a Logger that writes to l, prefixing each message with
+// its level. A nil l writes to the standard library's default logger.
+//
+// Example:
+//
+//	pow.SetLogger(logging.NewStdLogger(log.New(os.Stderr, "znn ", log.LstdFlags)))
+func NewStdLogger(l *log.Logger) Logger {
+	if l == nil {
+		l = log.Default()
+	}
+	return stdLogger{l: l}
+}
+
+type stdLogger struct {
+	l *log.Logger
+}
+
+func (s stdLogger) Debugf(format string, args ...interface{}) {
+	s.l.Printf("DEBUG: "+format, args...)
+}
+
+func (s stdLogger) Warnf(format string, args ...interface{}) {
+	s.l.Printf("WARNING: "+format, args...)
+}
+
+func (s stdLogger) Errorf(format string, args ...interface{}) {
+	s.l.Printf("ERROR: "+format, args...)
+}
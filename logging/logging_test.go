@@ -0,0 +1,34 @@
+package logging
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+)
+
+func TestNewStdLoggerPrefixesLevels(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewStdLogger(log.New(&buf, "", 0))
+
+	logger.Debugf("attempt %d", 1)
+	logger.Warnf("capped to %d", 2)
+	logger.Errorf("gave up after %d", 3)
+
+	want := "DEBUG: attempt 1\nWARNING: capped to 2\nERROR: gave up after 3\n"
+	if buf.String() != want {
+		t.Errorf("output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestOrNop(t *testing.T) {
+	if OrNop(nil) != Nop {
+		t.Error("OrNop(nil) should return Nop")
+	}
+	std := NewStdLogger(log.New(&strings.Builder{}, "", 0))
+	if OrNop(std) != std {
+		t.Error("OrNop() should return a non-nil logger unchanged")
+	}
+	// Nop must accept any arguments without panicking
+	Nop.Errorf("%s %d", "ignored", 1)
+}
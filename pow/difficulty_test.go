@@ -3,8 +3,10 @@ package pow
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/zenon-network/go-zenon/common/types"
@@ -278,3 +280,45 @@ func BenchmarkValidateAndCapDifficulty_Capped(b *testing.B) {
 		validateAndCapDifficulty(difficulty)
 	}
 }
+
+// recordingLogger collects formatted messages by level.
+type recordingLogger struct {
+	mu    sync.Mutex
+	warns []string
+}
+
+func (l *recordingLogger) Debugf(string, ...interface{}) {}
+func (l *recordingLogger) Errorf(string, ...interface{}) {}
+func (l *recordingLogger) Warnf(format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.warns = append(l.warns, fmt.Sprintf(format, args...))
+}
+
+func TestValidateAndCapDifficulty_WarnsThroughLogger(t *testing.T) {
+	recorder := &recordingLogger{}
+	SetLogger(recorder)
+	defer SetLogger(nil)
+
+	if _, err := validateAndCapDifficulty(MaxProtocolDifficulty); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.warns) != 0 {
+		t.Errorf("warnings for an in-range difficulty: %q", recorder.warns)
+	}
+
+	if _, err := validateAndCapDifficulty(MaxProtocolDifficulty + 1); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.warns) != 1 || !strings.Contains(recorder.warns[0], "exceeds protocol maximum") {
+		t.Errorf("warnings = %q, want one capping warning", recorder.warns)
+	}
+
+	SetLogger(nil)
+	if _, err := validateAndCapDifficulty(MaxProtocolDifficulty + 1); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorder.warns) != 1 {
+		t.Error("SetLogger(nil) should stop sending warnings to the previous logger")
+	}
+}
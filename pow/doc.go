@@ -113,5 +113,9 @@
 //	    // Need to generate PoW or fuse more QSR
 //	}
 //
+// PlasmaForAccountBlock estimates requiredPlasma offline from the block's
+// type, recipient, and data length. The node's GetRequiredPoWForAccountBlock
+// remains the authoritative answer.
+//
 // For more information, see https://pkg.go.dev/github.com/0x3639/znn-sdk-go/pow
 package pow
@@ -0,0 +1,81 @@
+package pow
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zenon-network/go-zenon/common/types"
+	"golang.org/x/crypto/sha3"
+)
+
+// setPowHashForTest replaces the PoW hash for the rest of the test. It exists
+// only in test builds, so production code cannot change the algorithm.
+func setPowHashForTest(t *testing.T, fn func(data []byte) [32]byte) {
+	t.Helper()
+	previous := powHash.Load()
+	replacement := powHashFunc(fn)
+	powHash.Store(&replacement)
+	t.Cleanup(func() { powHash.Store(previous) })
+}
+
+// solvedAtHash is a cheap hash under which only nonce solves any difficulty.
+func solvedAtHash(nonce uint64) func(data []byte) [32]byte {
+	return func(data []byte) [32]byte {
+		var sum [32]byte
+		if binary.LittleEndian.Uint64(data[:8]) == nonce {
+			for i := range sum {
+				sum[i] = 0xff
+			}
+		}
+		return sum
+	}
+}
+
+func TestPowHash_DefaultIsSHA3(t *testing.T) {
+	dataHash := types.HexToHashPanic("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20")
+	nonce := nonceToBytes(42)
+	sum := sha3.Sum256(append(nonce[:], dataHash.Bytes()...))
+	if got, want := hashWithNonce(dataHash, nonce), binary.LittleEndian.Uint64(sum[:8]); got != want {
+		t.Errorf("hashWithNonce() = %d, want SHA3-256 value %d", got, want)
+	}
+}
+
+func TestPowHash_InjectedSolution(t *testing.T) {
+	setPowHashForTest(t, solvedAtHash(25_000))
+
+	nonce, err := GeneratePoWFrom(context.Background(), types.Hash{}, MaxProtocolDifficulty, 0)
+	if err != nil || nonce != 25_000 {
+		t.Fatalf("GeneratePoWFrom() = %d, %v, want 25000", nonce, err)
+	}
+	if !CheckPoW(types.Hash{}, nonce, MaxProtocolDifficulty) {
+		t.Error("CheckPoW() rejects the injected solution")
+	}
+
+	results := make([]<-chan PowResult, 3)
+	for i := range results {
+		results[i] = GeneratePowAsync(context.Background(), types.Hash{}, MaxProtocolDifficulty)
+	}
+	for i, ch := range results {
+		if result := <-ch; result.Error != nil || result.Nonce != uint64ToHex(25_000) {
+			t.Errorf("GeneratePowAsync() #%d = %q, %v, want %q", i, result.Nonce, result.Error, uint64ToHex(25_000))
+		}
+	}
+}
+
+func TestPowHash_InjectedCancellation(t *testing.T) {
+	// No nonce ever solves, so only cancellation ends the search.
+	setPowHashForTest(t, func([]byte) [32]byte { return [32]byte{} })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	result := <-GeneratePowAsyncFrom(ctx, types.Hash{}, MaxProtocolDifficulty, 1000, nil)
+	if !errors.Is(result.Error, ErrCancelled) {
+		t.Fatalf("GeneratePowAsyncFrom() error = %v, want ErrCancelled", result.Error)
+	}
+	if result.ResumeNonce < 1000 || (result.ResumeNonce-1000)%checkInterval != 0 {
+		t.Errorf("ResumeNonce = %d, want 1000 plus whole batches", result.ResumeNonce)
+	}
+}
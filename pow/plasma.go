@@ -0,0 +1,100 @@
+package pow
+
+import (
+	"fmt"
+
+	"github.com/0x3639/znn-sdk-go/embedded"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+const (
+	// PoWDifficultyPerPlasma is the PoW difficulty that buys one unit of
+	// plasma, matching go-zenon's constants.PoWDifficultyPerPlasma.
+	PoWDifficultyPerPlasma uint64 = 1500
+
+	// MaxPoWPlasmaForAccountBlock is the most plasma PoW can provide for one
+	// account block (4.5 × embedded.AccountBlockBasePlasma). Blocks needing
+	// more must be covered by fused plasma.
+	MaxPoWPlasmaForAccountBlock uint64 = 94_500
+)
+
+// DifficultyForPlasma returns the PoW difficulty that provides plasma units
+// of plasma, as the node computes it.
+//
+// PoW can provide at most MaxPoWPlasmaForAccountBlock per block. Like
+// go-zenon's vm.PlasmaToDifficulty, which fails with ErrForbiddenParam,
+// larger amounts return an error: such a block needs fused plasma for the
+// part PoW cannot supply.
+//
+// Returns the difficulty, or an error wrapping ErrPlasmaTooHigh when plasma
+// exceeds MaxPoWPlasmaForAccountBlock.
+//
+// Example - Explain a plasma shortfall:
+//
+//	short := required - available
+//	difficulty, err := pow.DifficultyForPlasma(short)
+//	if errors.Is(err, pow.ErrPlasmaTooHigh) {
+//	    fmt.Println("PoW alone cannot cover this block; fuse QSR")
+//	} else if err == nil {
+//	    fmt.Printf("short %d plasma = difficulty %d\n", short, difficulty)
+//	}
+func DifficultyForPlasma(plasma uint64) (uint64, error) {
+	if plasma > MaxPoWPlasmaForAccountBlock {
+		return 0, fmt.Errorf("%w: %d > %d", ErrPlasmaTooHigh, plasma, MaxPoWPlasmaForAccountBlock)
+	}
+	return plasma * PoWDifficultyPerPlasma, nil
+}
+
+// PlasmaFromDifficulty returns the plasma a block with the given PoW
+// difficulty receives: difficulty / PoWDifficultyPerPlasma, rounded down and
+// capped at MaxPoWPlasmaForAccountBlock, matching go-zenon's
+// vm.DifficultyToPlasma.
+func PlasmaFromDifficulty(difficulty uint64) uint64 {
+	if difficulty > MaxProtocolDifficulty {
+		return MaxPoWPlasmaForAccountBlock
+	}
+	return difficulty / PoWDifficultyPerPlasma
+}
+
+// PlasmaForAccountBlock estimates the base plasma the protocol charges for
+// block, following the node's rules:
+//   - blocks from an embedded contract cost nothing
+//   - receive blocks cost embedded.AccountBlockBasePlasma
+//   - sends to an ordinary address cost embedded.AccountBlockBasePlasma plus
+//     embedded.PlasmaPerDataByte for every byte of Data
+//   - embedded contract calls cost the method's fixed plasma (see
+//     embedded.CallDescription.BasePlasma); calls that cannot be decoded are
+//     estimated at embedded.EmbeddedSimplePlasma
+//
+// Parameters:
+//   - block: Account block to estimate; only Address, BlockType, ToAddress
+//     and Data are read
+//
+// Example:
+//
+//	plasma := pow.PlasmaForAccountBlock(transaction)
+//	if plasma > available {
+//	    fmt.Println("fuse more QSR or generate PoW")
+//	}
+//
+// Note: This is an offline estimate. The node's answer to
+// PlasmaApi.GetRequiredPoWForAccountBlock is authoritative, and also accounts
+// for plasma the address already has and for rule changes this SDK does not
+// know about. A nil block returns 0.
+func PlasmaForAccountBlock(block *nom.AccountBlock) uint64 {
+	if block == nil || types.IsEmbeddedAddress(block.Address) {
+		return 0
+	}
+	if block.IsReceiveBlock() {
+		return embedded.AccountBlockBasePlasma
+	}
+	if !types.IsEmbeddedAddress(block.ToAddress) {
+		return embedded.AccountBlockBasePlasma + uint64(len(block.Data))*embedded.PlasmaPerDataByte
+	}
+	call, err := embedded.DescribeBlock(block)
+	if err != nil || call == nil {
+		return embedded.EmbeddedSimplePlasma
+	}
+	return call.BasePlasma()
+}
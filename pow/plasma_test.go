@@ -0,0 +1,111 @@
+package pow
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/abi"
+	"github.com/0x3639/znn-sdk-go/embedded"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func TestPlasmaForAccountBlock_Transfers(t *testing.T) {
+	sender := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	receiver := types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")
+
+	empty := &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, Address: sender, ToAddress: receiver}
+	if got := PlasmaForAccountBlock(empty); got != 21000 {
+		t.Errorf("empty transfer = %d, want 21000", got)
+	}
+
+	heavy := &nom.AccountBlock{
+		BlockType: nom.BlockTypeUserSend,
+		Address:   sender,
+		ToAddress: receiver,
+		Data:      bytes.Repeat([]byte{0xab}, 1000),
+	}
+	got := PlasmaForAccountBlock(heavy)
+	if got != 21000+1000*68 {
+		t.Errorf("1000-byte transfer = %d, want %d", got, 21000+1000*68)
+	}
+	if perByte := (got - PlasmaForAccountBlock(empty)) / 1000; perByte != embedded.PlasmaPerDataByte {
+		t.Errorf("per-byte plasma = %d, want %d", perByte, embedded.PlasmaPerDataByte)
+	}
+
+	receive := &nom.AccountBlock{BlockType: nom.BlockTypeUserReceive, Address: sender, Data: heavy.Data}
+	if got := PlasmaForAccountBlock(receive); got != 21000 {
+		t.Errorf("receive = %d, want 21000", got)
+	}
+
+	contractSend := &nom.AccountBlock{BlockType: nom.BlockTypeContractSend, Address: types.TokenContract, ToAddress: receiver}
+	if got := PlasmaForAccountBlock(contractSend); got != 0 {
+		t.Errorf("block from embedded contract = %d, want 0", got)
+	}
+	if got := PlasmaForAccountBlock(nil); got != 0 {
+		t.Errorf("nil block = %d, want 0", got)
+	}
+}
+
+func TestPlasmaForAccountBlock_EmbeddedCalls(t *testing.T) {
+	sender := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	call := func(to types.Address, contract *abi.Abi, method string, args ...interface{}) *nom.AccountBlock {
+		data, err := contract.EncodeFunction(method, args)
+		if err != nil {
+			t.Fatalf("EncodeFunction(%s) error = %v", method, err)
+		}
+		return &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, Address: sender, ToAddress: to, Data: data}
+	}
+
+	tests := []struct {
+		name  string
+		block *nom.AccountBlock
+		want  uint64
+	}{
+		{"Fuse", call(types.PlasmaContract, embedded.Plasma, "Fuse", sender), 52500},
+		{"CancelFuse", call(types.PlasmaContract, embedded.Plasma, "CancelFuse", types.ZeroHash), 73500},
+		{"Mint", call(types.TokenContract, embedded.Token, "Mint", types.ZnnTokenStandard, big.NewInt(1), sender), 73500},
+		{"Sentinel.Revoke", call(types.SentinelContract, embedded.Sentinel, "Revoke"), 94500},
+		{"no data", &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, Address: sender, ToAddress: types.PlasmaContract}, 52500},
+	}
+	for _, tt := range tests {
+		if got := PlasmaForAccountBlock(tt.block); got != tt.want {
+			t.Errorf("%s = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDifficultyPlasmaConversion(t *testing.T) {
+	tests := []struct {
+		plasma     uint64
+		difficulty uint64
+	}{
+		{0, 0},
+		{1, 1500},
+		{21000, 31_500_000},
+		{MaxPoWPlasmaForAccountBlock, MaxProtocolDifficulty},
+	}
+	for _, tt := range tests {
+		if got, err := DifficultyForPlasma(tt.plasma); err != nil || got != tt.difficulty {
+			t.Errorf("DifficultyForPlasma(%d) = %d, %v; want %d", tt.plasma, got, err, tt.difficulty)
+		}
+		if got := PlasmaFromDifficulty(tt.difficulty); got != tt.plasma {
+			t.Errorf("PlasmaFromDifficulty(%d) = %d, want %d", tt.difficulty, got, tt.plasma)
+		}
+	}
+
+	if MaxProtocolDifficulty != 141_750_000 {
+		t.Errorf("MaxProtocolDifficulty = %d, want 141750000", MaxProtocolDifficulty)
+	}
+	if got := PlasmaFromDifficulty(1499); got != 0 {
+		t.Errorf("PlasmaFromDifficulty(1499) = %d, want 0 (rounded down)", got)
+	}
+	if _, err := DifficultyForPlasma(MaxPoWPlasmaForAccountBlock + 1); !errors.Is(err, ErrPlasmaTooHigh) {
+		t.Errorf("DifficultyForPlasma(max+1) error = %v, want ErrPlasmaTooHigh", err)
+	}
+	if got := PlasmaFromDifficulty(MaxReasonableDifficulty); got != MaxPoWPlasmaForAccountBlock {
+		t.Errorf("PlasmaFromDifficulty(%d) = %d, want cap %d", MaxReasonableDifficulty, got, MaxPoWPlasmaForAccountBlock)
+	}
+}
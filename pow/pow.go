@@ -5,12 +5,13 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"log"
 	"math/big"
 	"os"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
+	"github.com/0x3639/znn-sdk-go/logging"
 	"github.com/zenon-network/go-zenon/common/types"
 	"golang.org/x/crypto/sha3"
 )
@@ -24,7 +25,7 @@ const (
 	// - A malfunctioning node
 	// - A malicious attempt to DoS the client
 	// - An incompatible protocol version
-	MaxProtocolDifficulty uint64 = 141_750_000
+	MaxProtocolDifficulty = MaxPoWPlasmaForAccountBlock * PoWDifficultyPerPlasma
 
 	// MaxReasonableDifficulty is a safety cap with a 50% buffer above the protocol maximum.
 	// Difficulties above this threshold will be rejected as obvious attacks or errors.
@@ -46,6 +47,10 @@ var (
 
 	// ErrDifficultyTooHigh is returned when difficulty exceeds the reasonable maximum
 	ErrDifficultyTooHigh = errors.New("difficulty exceeds reasonable maximum (possible DoS attack)")
+
+	// ErrPlasmaTooHigh is returned by DifficultyForPlasma when PoW cannot
+	// provide the requested plasma for one account block
+	ErrPlasmaTooHigh = errors.New("plasma exceeds what PoW can provide for one account block")
 )
 
 // workerPool manages concurrent PoW generation operations.
@@ -148,6 +153,9 @@ type PowResult struct {
 	Nonce string
 	// Error is set if PoW generation failed or was cancelled
 	Error error
+	// ResumeNonce is set by GeneratePowAsyncFrom when the search is
+	// cancelled: the first nonce not yet tried, to pass back as startNonce
+	ResumeNonce uint64
 }
 
 // PowStatus represents the status of PoW generation
@@ -172,6 +180,33 @@ func (s PowStatus) String() string {
 	}
 }
 
+var (
+	logger     = logging.Nop
+	loggerLock sync.RWMutex
+)
+
+// SetLogger routes this package's diagnostic messages, such as the warning
+// emitted when a node-supplied difficulty is capped, to l. A nil l restores
+// the default, which discards them.
+//
+// Example:
+//
+//	pow.SetLogger(logging.NewStdLogger(nil)) // log through the standard logger
+//
+// Unlike SetMaxPoWWorkers, SetLogger is safe to call at any time.
+func SetLogger(l logging.Logger) {
+	loggerLock.Lock()
+	defer loggerLock.Unlock()
+	logger = logging.OrNop(l)
+}
+
+// currentLogger returns the logger set with SetLogger.
+func currentLogger() logging.Logger {
+	loggerLock.RLock()
+	defer loggerLock.RUnlock()
+	return logger
+}
+
 // validateAndCapDifficulty validates the difficulty and caps it if necessary.
 //
 // Returns:
@@ -180,7 +215,7 @@ func (s PowStatus) String() string {
 //
 // Behavior:
 //   - difficulty <= MaxProtocolDifficulty: Returns as-is, no warning
-//   - MaxProtocolDifficulty < difficulty <= MaxReasonableDifficulty: Caps to MaxProtocolDifficulty, logs warning
+//   - MaxProtocolDifficulty < difficulty <= MaxReasonableDifficulty: Caps to MaxProtocolDifficulty, logs a warning via SetLogger's Logger
 //   - difficulty > MaxReasonableDifficulty: Returns error (obvious attack)
 func validateAndCapDifficulty(difficulty uint64) (uint64, error) {
 	// Check if obviously too high (probable DoS attack)
@@ -191,7 +226,7 @@ func validateAndCapDifficulty(difficulty uint64) (uint64, error) {
 
 	// Check if above protocol maximum (cap it and warn)
 	if difficulty > MaxProtocolDifficulty {
-		log.Printf("WARNING: Difficulty %d exceeds protocol maximum %d. "+
+		currentLogger().Warnf("Difficulty %d exceeds protocol maximum %d. "+
 			"Capping to protocol maximum. This may indicate a malfunctioning or malicious node.",
 			difficulty, MaxProtocolDifficulty)
 		return MaxProtocolDifficulty, nil
@@ -233,8 +268,9 @@ func validateAndCapDifficultyBigInt(difficulty *big.Int) (*big.Int, error) {
 //
 // Here dataHash is SHA3-256(address || previousHash) for the account block.
 //
-// Note: This function panics if difficulty exceeds MaxReasonableDifficulty.
-// For error handling, use GeneratePowWithContext instead.
+// Note: This function panics if difficulty exceeds MaxReasonableDifficulty and
+// cannot be stopped once started. For error handling or cancellation, use
+// GeneratePowWithContext instead.
 func GeneratePoW(dataHash types.Hash, difficulty uint64) string {
 	if difficulty == 0 {
 		return "0000000000000000"
@@ -295,7 +331,8 @@ func GeneratePowBytes(dataHash types.Hash, difficulty uint64) []byte {
 
 // GeneratePowWithContext generates PoW with context support for cancellation
 // Returns the nonce as a hex string or ErrCancelled if context is cancelled
-// Checks context cancellation every 10000 iterations for efficiency
+// Checks context cancellation every checkInterval iterations, so a cancelled
+// search stops within milliseconds even at MaxProtocolDifficulty
 //
 // Returns ErrDifficultyTooHigh if difficulty exceeds MaxReasonableDifficulty.
 func GeneratePowWithContext(ctx context.Context, dataHash types.Hash, difficulty uint64) (string, error) {
@@ -311,24 +348,80 @@ func GeneratePowWithContext(ctx context.Context, dataHash types.Hash, difficulty
 
 	difficultyBig := new(big.Int).SetUint64(cappedDifficulty)
 	threshold := GetThresholdByDifficulty(difficultyBig)
-	nonce := uint64(0)
-	checkInterval := uint64(10000) // Check context every 10k iterations
+	nonce, err := searchNonce(ctx, dataHash, threshold, 0, nil)
+	if err != nil {
+		return "", err
+	}
+	return uint64ToHex(nonce), nil
+}
+
+// GeneratePoWFrom searches for a PoW nonce starting at startNonce instead of 0,
+// so a long computation can be checkpointed and resumed.
+//
+// Nonces are tried in increasing order, so resuming from a nonce that has not
+// yet been tried finds the same solution an uninterrupted run would have
+// found. On cancellation the returned nonce is the first one not yet tried;
+// persist it and pass it back as startNonce to continue.
+//
+// Parameters:
+//   - ctx: Cancels the search; checked every checkInterval nonces
+//   - dataHash: PoW data hash, SHA3-256(address || previousHash)
+//   - difficulty: Required difficulty
+//   - startNonce: First nonce to try (0 for a fresh search)
+//
+// Returns the found nonce as the uint64 used by CheckPoW, or the resume nonce
+// with ErrCancelled, or ErrDifficultyTooHigh. A zero difficulty returns
+// startNonce, since any nonce is valid.
+//
+// Example:
+//
+//	nonce, err := pow.GeneratePoWFrom(ctx, dataHash, difficulty, saved)
+//	if errors.Is(err, pow.ErrCancelled) {
+//	    saveCheckpoint(nonce) // resume from here next time
+//	    return err
+//	}
+//	binary.LittleEndian.PutUint64(block.Nonce.Data[:], nonce)
+func GeneratePoWFrom(ctx context.Context, dataHash types.Hash, difficulty uint64, startNonce uint64) (uint64, error) {
+	if difficulty == 0 {
+		return startNonce, nil
+	}
+
+	cappedDifficulty, err := validateAndCapDifficulty(difficulty)
+	if err != nil {
+		return startNonce, err
+	}
 
+	threshold := GetThresholdByDifficulty(new(big.Int).SetUint64(cappedDifficulty))
+	return searchNonce(ctx, dataHash, threshold, startNonce, nil)
+}
+
+// checkInterval is how many nonces are tried between context checks and
+// progress reports.
+const checkInterval = 10000
+
+// searchNonce tries nonces from start upward until one meets threshold.
+//
+// Before each batch of checkInterval nonces it reports the next untried nonce
+// to progress (when non-nil) and checks ctx. On cancellation it returns that
+// untried nonce with ErrCancelled, so no candidate is ever skipped on resume.
+func searchNonce(ctx context.Context, dataHash types.Hash, threshold, start uint64, progress func(nonce uint64)) (uint64, error) {
+	nonce := start
 	for {
-		// Check context cancellation periodically
-		if nonce%checkInterval == 0 {
-			select {
-			case <-ctx.Done():
-				return "", ErrCancelled
-			default:
-			}
+		if progress != nil {
+			progress(nonce)
 		}
-
-		if meetsDifficulty(dataHash, nonce, threshold) {
-			return uint64ToHex(nonce), nil
+		select {
+		case <-ctx.Done():
+			return nonce, ErrCancelled
+		default:
 		}
 
-		nonce++
+		for i := 0; i < checkInterval; i++ {
+			if meetsDifficulty(dataHash, nonce, threshold) {
+				return nonce, nil
+			}
+			nonce++
+		}
 	}
 }
 
@@ -347,25 +440,11 @@ func GeneratePowBigIntWithContext(ctx context.Context, dataHash types.Hash, diff
 	}
 
 	threshold := GetThresholdByDifficulty(cappedDifficulty)
-	nonce := uint64(0)
-	checkInterval := uint64(10000) // Check context every 10k iterations
-
-	for {
-		// Check context cancellation periodically
-		if nonce%checkInterval == 0 {
-			select {
-			case <-ctx.Done():
-				return "", ErrCancelled
-			default:
-			}
-		}
-
-		if meetsDifficulty(dataHash, nonce, threshold) {
-			return uint64ToHex(nonce), nil
-		}
-
-		nonce++
+	nonce, err := searchNonce(ctx, dataHash, threshold, 0, nil)
+	if err != nil {
+		return "", err
 	}
+	return uint64ToHex(nonce), nil
 }
 
 // GeneratePowAsync generates PoW asynchronously and returns a channel.
@@ -433,6 +512,58 @@ func GeneratePowAsync(ctx context.Context, dataHash types.Hash, difficulty uint6
 	return resultChan
 }
 
+// GeneratePowAsyncFrom is like GeneratePowAsync but starts the search at
+// startNonce and reports progress, so long computations can be checkpointed.
+//
+// When progress is non-nil it is called from the worker goroutine with the
+// next untried nonce before every batch of checkInterval nonces; persist the
+// latest value to resume after a crash. It must return quickly. If ctx is
+// cancelled after the search starts, the result carries ErrCancelled and
+// ResumeNonce; cancelled while queued, ResumeNonce is startNonce.
+//
+// Example:
+//
+//	resultChan := pow.GeneratePowAsyncFrom(ctx, hash, difficulty, saved, func(nonce uint64) {
+//	    checkpoint.Store(nonce)
+//	})
+//	result := <-resultChan
+//	if errors.Is(result.Error, pow.ErrCancelled) {
+//	    saveCheckpoint(result.ResumeNonce)
+//	}
+func GeneratePowAsyncFrom(ctx context.Context, dataHash types.Hash, difficulty uint64, startNonce uint64, progress func(nonce uint64)) <-chan PowResult {
+	initWorkerPool()
+	resultChan := make(chan PowResult, 1)
+
+	go func() {
+		defer close(resultChan)
+
+		if err := pool.acquire(ctx); err != nil {
+			resultChan <- PowResult{Error: err, ResumeNonce: startNonce}
+			return
+		}
+		defer pool.release()
+
+		if difficulty == 0 {
+			resultChan <- PowResult{Nonce: uint64ToHex(startNonce)}
+			return
+		}
+		cappedDifficulty, err := validateAndCapDifficulty(difficulty)
+		if err != nil {
+			resultChan <- PowResult{Error: err, ResumeNonce: startNonce}
+			return
+		}
+		threshold := GetThresholdByDifficulty(new(big.Int).SetUint64(cappedDifficulty))
+		nonce, err := searchNonce(ctx, dataHash, threshold, startNonce, progress)
+		if err != nil {
+			resultChan <- PowResult{Error: err, ResumeNonce: nonce}
+			return
+		}
+		resultChan <- PowResult{Nonce: uint64ToHex(nonce)}
+	}()
+
+	return resultChan
+}
+
 // GeneratePowBigIntAsync is like GeneratePowAsync but accepts *big.Int difficulty.
 // This is useful when difficulty exceeds uint64 range or comes from contract data.
 //
@@ -539,6 +670,21 @@ func nonceToBytes(nonce uint64) [8]byte {
 	return b
 }
 
+// powHashFunc is the 256-bit hash underlying the PoW value.
+type powHashFunc func(data []byte) [32]byte
+
+// powHash holds the PoW hash function. It is always SHA3-256, the hash the
+// node verifies; only tests replace it (see setPowHashForTest in
+// hash_test.go), to drive the search and cancellation logic cheaply. It is
+// atomic because a cancelled search from one test may still be running when
+// the next test swaps it.
+var powHash atomic.Pointer[powHashFunc]
+
+func init() {
+	sum := powHashFunc(sha3.Sum256)
+	powHash.Store(&sum)
+}
+
 // hashWithNonce computes the canonical PoW value: the first 8 bytes of
 // SHA3-256(nonce || dataHash) interpreted as a little-endian uint64. This mirrors
 // go-zenon's pow.hashWithNonce, where the nonce precedes the data hash.
@@ -546,7 +692,7 @@ func hashWithNonce(dataHash types.Hash, nonce [8]byte) uint64 {
 	calc := make([]byte, 40)
 	l := copy(calc, nonce[:])
 	copy(calc[l:], dataHash.Bytes())
-	sum := sha3.Sum256(calc)
+	sum := (*powHash.Load())(calc)
 	return binary.LittleEndian.Uint64(sum[:8])
 }
 
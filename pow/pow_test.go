@@ -544,6 +544,55 @@ func TestGeneratePowAsync_Timeout(t *testing.T) {
 	}
 }
 
+// TestGeneratePowWithContext_CancelMidFlight cancels a search that cannot
+// finish in the test's lifetime and expects it to stop promptly.
+func TestGeneratePowWithContext_CancelMidFlight(t *testing.T) {
+	testHash := types.Hash{}
+	copy(testHash[:], []byte("mid_flight_cancel"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	nonce, err := GeneratePowWithContext(ctx, testHash, MaxProtocolDifficulty)
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, ErrCancelled) {
+		t.Fatalf("GeneratePowWithContext() error = %v, want %v", err, ErrCancelled)
+	}
+	if nonce != "" {
+		t.Errorf("GeneratePowWithContext() nonce = %s, want empty", nonce)
+	}
+	if elapsed > time.Second {
+		t.Errorf("GeneratePowWithContext() took %v to stop after cancel", elapsed)
+	}
+}
+
+func TestGeneratePowAsync_CancelMidFlight(t *testing.T) {
+	testHash := types.Hash{}
+	copy(testHash[:], []byte("async_mid_flight_cancel"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	resultChan := GeneratePowAsync(ctx, testHash, MaxProtocolDifficulty)
+
+	// Give the worker time to acquire a slot and start hashing
+	time.Sleep(20 * time.Millisecond)
+	start := time.Now()
+	cancel()
+
+	select {
+	case result := <-resultChan:
+		if !errors.Is(result.Error, ErrCancelled) {
+			t.Errorf("GeneratePowAsync() error = %v, want %v", result.Error, ErrCancelled)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("GeneratePowAsync() took %v to stop after cancel", elapsed)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("GeneratePowAsync() did not stop after cancel")
+	}
+}
+
 func TestGeneratePowAsync_MultipleConcurrent(t *testing.T) {
 	ctx := context.Background()
 	numOps := 5
@@ -0,0 +1,94 @@
+package pow
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// resumeTestHash has its first solution at difficulty 1,000,000 at nonce
+// 101990, well past the first few checkpoint batches.
+var resumeTestHash = types.HexToHashPanic("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20")
+
+const (
+	resumeTestDifficulty = 1_000_000
+	resumeTestSolution   = 101990
+)
+
+func TestGeneratePoWFrom_FindsKnownSolution(t *testing.T) {
+	nonce, err := GeneratePoWFrom(context.Background(), resumeTestHash, resumeTestDifficulty, 0)
+	if err != nil || nonce != resumeTestSolution {
+		t.Fatalf("GeneratePoWFrom(0) = %d, %v, want %d", nonce, err, resumeTestSolution)
+	}
+	if !CheckPoW(resumeTestHash, nonce, resumeTestDifficulty) {
+		t.Error("nonce does not pass CheckPoW")
+	}
+}
+
+func TestGeneratePoWFrom_ResumesNearSolution(t *testing.T) {
+	nonce, err := GeneratePoWFrom(context.Background(), resumeTestHash, resumeTestDifficulty, resumeTestSolution-5)
+	if err != nil || nonce != resumeTestSolution {
+		t.Errorf("GeneratePoWFrom(solution-5) = %d, %v, want %d", nonce, err, resumeTestSolution)
+	}
+
+	nonce, err = GeneratePoWFrom(context.Background(), resumeTestHash, resumeTestDifficulty, resumeTestSolution)
+	if err != nil || nonce != resumeTestSolution {
+		t.Errorf("GeneratePoWFrom(solution) = %d, %v, want %d", nonce, err, resumeTestSolution)
+	}
+}
+
+func TestGeneratePoWFrom_CancelledReturnsStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	nonce, err := GeneratePoWFrom(ctx, resumeTestHash, resumeTestDifficulty, 12345)
+	if !errors.Is(err, ErrCancelled) || nonce != 12345 {
+		t.Errorf("GeneratePoWFrom() = %d, %v, want 12345 and ErrCancelled", nonce, err)
+	}
+}
+
+func TestGeneratePoWFrom_ZeroAndExcessiveDifficulty(t *testing.T) {
+	if nonce, err := GeneratePoWFrom(context.Background(), resumeTestHash, 0, 7); err != nil || nonce != 7 {
+		t.Errorf("GeneratePoWFrom(difficulty 0) = %d, %v, want 7", nonce, err)
+	}
+	if _, err := GeneratePoWFrom(context.Background(), resumeTestHash, MaxReasonableDifficulty+1, 0); !errors.Is(err, ErrDifficultyTooHigh) {
+		t.Errorf("GeneratePoWFrom(too high) error = %v, want ErrDifficultyTooHigh", err)
+	}
+}
+
+// TestGeneratePowAsyncFrom_CheckpointAndResume cancels a search after a few
+// progress reports, then resumes from the reported nonce and must reach the
+// same solution as an uninterrupted run.
+func TestGeneratePowAsyncFrom_CheckpointAndResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var reported []uint64
+	result := <-GeneratePowAsyncFrom(ctx, resumeTestHash, resumeTestDifficulty, 0, func(nonce uint64) {
+		reported = append(reported, nonce)
+		if len(reported) == 3 {
+			cancel()
+		}
+	})
+	if !errors.Is(result.Error, ErrCancelled) {
+		t.Fatalf("first run error = %v, want ErrCancelled", result.Error)
+	}
+	if result.ResumeNonce != reported[len(reported)-1] || result.ResumeNonce == 0 || result.ResumeNonce > resumeTestSolution {
+		t.Fatalf("ResumeNonce = %d, reported %v", result.ResumeNonce, reported)
+	}
+	for i := 1; i < len(reported); i++ {
+		if reported[i] != reported[i-1]+checkInterval {
+			t.Fatalf("progress reports %v are not one batch apart", reported)
+		}
+	}
+
+	resumed := <-GeneratePowAsyncFrom(context.Background(), resumeTestHash, resumeTestDifficulty, result.ResumeNonce, nil)
+	if resumed.Error != nil {
+		t.Fatalf("resumed run error = %v", resumed.Error)
+	}
+	if got := nonceFromHex(resumed.Nonce); got != resumeTestSolution {
+		t.Errorf("resumed nonce = %d, want %d", got, resumeTestSolution)
+	}
+}
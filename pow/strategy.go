@@ -0,0 +1,111 @@
+package pow
+
+import (
+	"math"
+	"time"
+)
+
+// DefaultHashRate is the PoW hash rate, in hashes per second, assumed by
+// EstimatePoWDuration when none is given. It is a conservative figure for a
+// single modern CPU core; a typical laptop core manages roughly twice this.
+const DefaultHashRate uint64 = 1_000_000
+
+// EstimatePoWDuration estimates how long GeneratePoW takes for difficulty.
+//
+// A nonce meets difficulty d with probability 1/d per attempt, so the
+// expected number of hashes is d. Individual runs vary widely around this
+// mean: about one run in twenty takes three times as long.
+//
+// Parameters:
+//   - difficulty: Required PoW difficulty (0 needs no work)
+//   - hashesPerSecond: Measured hash rate, or 0 for DefaultHashRate
+//
+// Example:
+//
+//	eta := pow.EstimatePoWDuration(31_500_000, 0)
+//	fmt.Printf("PoW will take about %s\n", eta.Round(time.Second)) // ~32s
+func EstimatePoWDuration(difficulty, hashesPerSecond uint64) time.Duration {
+	if difficulty == 0 {
+		return 0
+	}
+	if hashesPerSecond == 0 {
+		hashesPerSecond = DefaultHashRate
+	}
+	seconds := float64(difficulty) / float64(hashesPerSecond)
+	if seconds >= math.MaxInt64/float64(time.Second) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// StrategyKind identifies how a block should be made feeless.
+type StrategyKind int
+
+const (
+	// StrategyUsePlasma means the account already has enough plasma.
+	StrategyUsePlasma StrategyKind = iota
+	// StrategyGeneratePoW means the plasma shortfall can be covered by PoW.
+	StrategyGeneratePoW
+	// StrategyNeedMorePlasmaOrPoW means neither current plasma nor the
+	// reported difficulty can make the block submittable; fuse more QSR or
+	// re-query the required PoW.
+	StrategyNeedMorePlasmaOrPoW
+)
+
+// String returns the string representation of StrategyKind
+func (k StrategyKind) String() string {
+	switch k {
+	case StrategyUsePlasma:
+		return "UsePlasma"
+	case StrategyGeneratePoW:
+		return "GeneratePoW"
+	case StrategyNeedMorePlasmaOrPoW:
+		return "NeedMorePlasmaOrPoW"
+	default:
+		return "Unknown"
+	}
+}
+
+// Strategy is the recommendation returned by RecommendStrategy.
+type Strategy struct {
+	// Kind is the recommended action
+	Kind StrategyKind
+	// EstimatedDuration is the expected PoW time at DefaultHashRate when Kind
+	// is StrategyGeneratePoW, and zero otherwise
+	EstimatedDuration time.Duration
+}
+
+// RecommendStrategy decides whether a block should rely on fused plasma or on
+// generated PoW.
+//
+// Decision rules:
+//   - currentPlasma >= requiredPlasma: StrategyUsePlasma
+//   - otherwise, 0 < requiredDifficulty <= MaxProtocolDifficulty:
+//     StrategyGeneratePoW, with an EstimatedDuration
+//   - otherwise: StrategyNeedMorePlasmaOrPoW (the node reported no usable
+//     difficulty, or one PoW cannot cover)
+//
+// Parameters:
+//   - currentPlasma: Plasma available to the account
+//   - requiredPlasma: Plasma the block needs
+//   - requiredDifficulty: PoW difficulty reported for the block
+//
+// Example:
+//
+//	required, _ := client.PlasmaApi.GetRequiredPoWForAccountBlock(params)
+//	s := pow.RecommendStrategy(required.AvailablePlasma, required.BasePlasma, required.RequiredDifficulty)
+//	if s.Kind == pow.StrategyGeneratePoW {
+//	    fmt.Printf("Generating PoW, about %s\n", s.EstimatedDuration.Round(time.Second))
+//	}
+func RecommendStrategy(currentPlasma, requiredPlasma, requiredDifficulty uint64) Strategy {
+	if currentPlasma >= requiredPlasma {
+		return Strategy{Kind: StrategyUsePlasma}
+	}
+	if requiredDifficulty == 0 || requiredDifficulty > MaxProtocolDifficulty {
+		return Strategy{Kind: StrategyNeedMorePlasmaOrPoW}
+	}
+	return Strategy{
+		Kind:              StrategyGeneratePoW,
+		EstimatedDuration: EstimatePoWDuration(requiredDifficulty, 0),
+	}
+}
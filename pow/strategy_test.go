@@ -0,0 +1,74 @@
+package pow
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEstimatePoWDuration(t *testing.T) {
+	tests := []struct {
+		difficulty, rate uint64
+		want             time.Duration
+	}{
+		{0, 0, 0},
+		{1_000_000, 0, time.Second},
+		{31_500_000, 0, 31500 * time.Millisecond},
+		{31_500_000, 2_000_000, 15750 * time.Millisecond},
+		{MaxProtocolDifficulty, 1, time.Duration(MaxProtocolDifficulty) * time.Second},
+		{math.MaxUint64, 1, time.Duration(math.MaxInt64)},
+	}
+	for _, tt := range tests {
+		if got := EstimatePoWDuration(tt.difficulty, tt.rate); got != tt.want {
+			t.Errorf("EstimatePoWDuration(%d, %d) = %s, want %s", tt.difficulty, tt.rate, got, tt.want)
+		}
+	}
+}
+
+func TestRecommendStrategy_Grid(t *testing.T) {
+	const base = 21_000
+	tests := []struct {
+		name                          string
+		current, required, difficulty uint64
+		want                          StrategyKind
+	}{
+		{"plenty of plasma", 100_000, base, 0, StrategyUsePlasma},
+		{"exact plasma", base, base, 0, StrategyUsePlasma},
+		{"plasma wins even with difficulty", base, base, 31_500_000, StrategyUsePlasma},
+		{"nothing required", 0, 0, 0, StrategyUsePlasma},
+		{"no plasma, base difficulty", 0, base, 31_500_000, StrategyGeneratePoW},
+		{"partial plasma", base - 1, base, 1_500, StrategyGeneratePoW},
+		{"protocol maximum", 0, 94_500, MaxProtocolDifficulty, StrategyGeneratePoW},
+		{"no plasma, no difficulty", 0, base, 0, StrategyNeedMorePlasmaOrPoW},
+		{"above protocol maximum", 0, base, MaxProtocolDifficulty + 1, StrategyNeedMorePlasmaOrPoW},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RecommendStrategy(tt.current, tt.required, tt.difficulty)
+			if got.Kind != tt.want {
+				t.Fatalf("Kind = %s, want %s", got.Kind, tt.want)
+			}
+			wantDuration := time.Duration(0)
+			if tt.want == StrategyGeneratePoW {
+				wantDuration = EstimatePoWDuration(tt.difficulty, 0)
+			}
+			if got.EstimatedDuration != wantDuration {
+				t.Errorf("EstimatedDuration = %s, want %s", got.EstimatedDuration, wantDuration)
+			}
+		})
+	}
+}
+
+func TestStrategyKind_String(t *testing.T) {
+	tests := map[StrategyKind]string{
+		StrategyUsePlasma:           "UsePlasma",
+		StrategyGeneratePoW:         "GeneratePoW",
+		StrategyNeedMorePlasmaOrPoW: "NeedMorePlasmaOrPoW",
+		StrategyKind(99):            "Unknown",
+	}
+	for kind, want := range tests {
+		if got := kind.String(); got != want {
+			t.Errorf("StrategyKind(%d).String() = %q, want %q", int(kind), got, want)
+		}
+	}
+}
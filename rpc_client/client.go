@@ -2,12 +2,14 @@ package rpc_client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/0x3639/znn-sdk-go/api"
 	"github.com/0x3639/znn-sdk-go/api/embedded"
+	"github.com/0x3639/znn-sdk-go/logging"
 	"github.com/0x3639/znn-sdk-go/transport"
 
 	"github.com/zenon-network/go-zenon/rpc/server"
@@ -27,6 +29,9 @@ type RpcClient struct {
 	url        string
 	status     WebsocketStatus
 	statusLock sync.RWMutex
+	// reconnecting is set from a connection loss until reconnection ends; it
+	// is guarded by statusLock so ConnectionState sees it with status.
+	reconnecting bool
 
 	// Auto-reconnect configuration
 	autoReconnect      bool
@@ -39,6 +44,16 @@ type RpcClient struct {
 	reconnectCtxCancel context.CancelFunc
 	reconnectLock      sync.Mutex // Prevents concurrent reconnection attempts
 
+	// logger receives reconnect and callback diagnostics; nil means discard
+	logger logging.Logger
+
+	// limiter throttles outbound requests; nil means unlimited. It survives
+	// reconnects so a reconnect does not reset the budget.
+	limiter *rateLimiter
+
+	// tlsConfig is ClientOptions.TLSConfig; nil means the default verification.
+	tlsConfig *tls.Config
+
 	// Callbacks
 	onConnectionEstablished []ConnectionEstablishedCallback
 	onConnectionLost        []ConnectionLostCallback
@@ -53,8 +68,12 @@ type RpcClient struct {
 	monitorCtx     context.Context
 	monitorCancel  context.CancelFunc
 	healthCheckCmd string
+	// pingTimeout bounds Ping calls whose context has no deadline; 0 means
+	// DefaultPingTimeout. Tests shorten it.
+	pingTimeout time.Duration
 
-	// API lock protects API field reassignment during reconnection
+	// API lock protects API field reassignment during reconnection, and the
+	// client and caller fields that connect, Stop, and Ping share
 	apiLock sync.RWMutex
 
 	// Embedded contract APIs
@@ -86,12 +105,33 @@ type ClientOptions struct {
 	MaxReconnectDelay time.Duration
 	// ReconnectAttempts is the maximum number of reconnect attempts (0 = infinite)
 	ReconnectAttempts int
-	// HealthCheckInterval is the interval for connection health checks (default: 30s, 0 = disabled)
+	// HealthCheckInterval is the interval for connection health checks (default: 30s, 0 = disabled).
+	// Each check is a Ping; a failed or timed-out Ping runs the
+	// connection-lost callbacks and, with AutoReconnect, reconnects. This is
+	// the keepalive that detects half-open websockets while the client is idle.
 	HealthCheckInterval time.Duration
 	// HealthCheckCommand is the RPC command to use for health checks (default: "ledger.getFrontierMomentum")
 	HealthCheckCommand string
+	// Logger receives connection-loss, reconnect, and callback-panic messages
+	// (default: nil, which discards them)
+	Logger logging.Logger
+	// MaxRequestsPerSecond throttles outbound RPC calls, including health
+	// checks, to this rate; calls beyond it wait their turn (default: 0,
+	// unlimited). Up to one second's worth of calls may be sent at once.
+	MaxRequestsPerSecond float64
+	// TLSConfig configures certificate verification for wss:// and https://
+	// URLs, including subscription sockets (default: nil, which verifies
+	// against the system roots). Use it to trust a private CA through RootCAs,
+	// or call PinCertificate to accept only a specific certificate. Setting
+	// InsecureSkipVerify disables verification entirely and lets anyone on the
+	// network path read and alter RPC traffic, including published blocks.
+	TLSConfig *tls.Config
 }
 
+// DefaultPingTimeout bounds Ping, and so each health check, when the caller's
+// context has no earlier deadline.
+const DefaultPingTimeout = 5 * time.Second
+
 // DefaultClientOptions returns default client options
 func DefaultClientOptions() ClientOptions {
 	return ClientOptions{
@@ -156,6 +196,9 @@ func NewRpcClient(url string) (*RpcClient, error) {
 //   - ReconnectAttempts: Max reconnection attempts, 0 for infinite (default: 0)
 //   - HealthCheckInterval: Interval for connection health checks (default: 30s, 0 to disable)
 //   - HealthCheckCommand: RPC command for health checks (default: "ledger.getFrontierMomentum")
+//   - Logger: Receives connection-loss and reconnect diagnostics (default: nil, discarded)
+//   - MaxRequestsPerSecond: Throttles outbound calls to this rate (default: 0, unlimited)
+//   - TLSConfig: Certificate verification for wss and https (default: nil, system roots)
 //
 // Returns an initialized RpcClient or an error if the initial connection fails.
 //
@@ -197,6 +240,9 @@ func NewRpcClientWithOptions(url string, opts ClientOptions) (*RpcClient, error)
 		onConnectionEstablished: make([]ConnectionEstablishedCallback, 0),
 		onConnectionLost:        make([]ConnectionLostCallback, 0),
 		healthCheckCmd:          opts.HealthCheckCommand,
+		logger:                  opts.Logger,
+		limiter:                 newRateLimiter(opts.MaxRequestsPerSecond),
+		tlsConfig:               opts.TLSConfig.Clone(),
 		subscriptions:           make(map[*NormalizedSubscription]struct{}),
 	}
 
@@ -217,14 +263,16 @@ func NewRpcClientWithOptions(url string, opts ClientOptions) (*RpcClient, error)
 func (c *RpcClient) connect() error {
 	c.setStatus(Connecting)
 
-	client, err := server.Dial(c.url)
+	client, err := c.dial()
 	if err != nil {
 		c.setStatus(Stopped)
 		return fmt.Errorf("failed to connect to %s: %w", c.url, err)
 	}
 
+	c.apiLock.Lock()
 	c.client = client
-	c.caller = transport.NewNormalizingCaller(client)
+	c.caller = transport.NewNormalizingCaller(withRateLimit(client, c.limiter))
+	c.apiLock.Unlock()
 	c.initializeAPIs()
 	c.setStatus(Running)
 	c.currentAttempt = 0
@@ -287,6 +335,58 @@ func (c *RpcClient) setStatus(status WebsocketStatus) {
 	c.status = status
 }
 
+// setStatusReconnecting updates the connection status and whether a
+// reconnection is in progress in one step.
+func (c *RpcClient) setStatusReconnecting(status WebsocketStatus, reconnecting bool) {
+	c.statusLock.Lock()
+	defer c.statusLock.Unlock()
+	c.status = status
+	c.reconnecting = reconnecting
+}
+
+// ConnectionState returns the current connection state.
+//
+// The state changes before the matching callbacks run: connection-established
+// callbacks observe StateConnected, and connection-lost callbacks observe
+// StateReconnecting when AutoReconnect is enabled or StateDisconnected when it
+// is not.
+//
+// This method is thread-safe and can be called from any goroutine.
+//
+// Example:
+//
+//	switch client.ConnectionState() {
+//	case rpc_client.StateConnected:
+//	    sendButton.Enable()
+//	case rpc_client.StateReconnecting:
+//	    sendButton.Disable()
+//	    statusBar.SetText("Reconnecting...")
+//	}
+func (c *RpcClient) ConnectionState() State {
+	c.statusLock.RLock()
+	defer c.statusLock.RUnlock()
+	switch {
+	case c.status == Running:
+		return StateConnected
+	case c.reconnecting:
+		return StateReconnecting
+	case c.status == Connecting:
+		return StateConnecting
+	default:
+		return StateDisconnected
+	}
+}
+
+// IsConnected reports whether the client is connected right now, that is,
+// whether ConnectionState is StateConnected.
+//
+// A connected client can still see a call fail if the connection drops while
+// it is in flight; IsConnected is meant for deciding what to offer the user,
+// not as a guarantee.
+func (c *RpcClient) IsConnected() bool {
+	return c.ConnectionState() == StateConnected
+}
+
 // IsClosed returns true if the connection is closed
 func (c *RpcClient) IsClosed() bool {
 	return c.Status() == Stopped
@@ -360,8 +460,7 @@ func (c *RpcClient) triggerConnectionEstablished() {
 		go func(cb ConnectionEstablishedCallback) {
 			defer func() {
 				if r := recover(); r != nil {
-					// Log or handle panic in callback
-					fmt.Printf("Panic in connection established callback: %v\n", r)
+					c.log().Errorf("panic in connection established callback: %v", r)
 				}
 			}()
 			cb()
@@ -380,8 +479,7 @@ func (c *RpcClient) triggerConnectionLost(err error) {
 		go func(cb ConnectionLostCallback, e error) {
 			defer func() {
 				if r := recover(); r != nil {
-					// Log or handle panic in callback
-					fmt.Printf("Panic in connection lost callback: %v\n", r)
+					c.log().Errorf("panic in connection lost callback: %v", r)
 				}
 			}()
 			cb(e)
@@ -406,19 +504,57 @@ func (c *RpcClient) startMonitoring(interval time.Duration) {
 	}()
 }
 
+// Ping checks that the node is answering by issuing the health check command
+// (ledger.getFrontierMomentum by default).
+//
+// A half-open websocket accepts writes but never replies, so a plain Call can
+// hang until TCP gives up. Ping instead fails once ctx is done or, if ctx has
+// no deadline, after DefaultPingTimeout.
+//
+// Returns nil when the node answered, or an error if the client is stopped,
+// the call failed, or it timed out. Ping does not itself mark the connection
+// lost; the periodic health check enabled by ClientOptions.HealthCheckInterval
+// does that.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+//	defer cancel()
+//	if err := client.Ping(ctx); err != nil {
+//	    log.Printf("node unreachable: %v", err)
+//	}
+func (c *RpcClient) Ping(ctx context.Context) error {
+	c.apiLock.RLock()
+	caller := c.caller
+	c.apiLock.RUnlock()
+	if c.IsClosed() || caller == nil {
+		return fmt.Errorf("client for %s is not connected", c.url)
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		timeout := c.pingTimeout
+		if timeout <= 0 {
+			timeout = DefaultPingTimeout
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	command := c.healthCheckCmd
+	if command == "" {
+		command = "ledger.getFrontierMomentum"
+	}
+	var result interface{}
+	return caller.CallContext(ctx, &result, command)
+}
+
 // performHealthCheck checks if the connection is healthy
 func (c *RpcClient) performHealthCheck() {
 	if c.IsClosed() {
 		return
 	}
 
-	// Try a simple RPC call
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	var result interface{}
-	err := c.caller.CallContext(ctx, &result, c.healthCheckCmd)
-	if err != nil {
+	if err := c.Ping(context.Background()); err != nil {
 		// Connection appears to be lost
 		c.handleConnectionLoss(fmt.Errorf("health check failed: %w", err))
 	}
@@ -430,12 +566,16 @@ func (c *RpcClient) handleConnectionLoss(err error) {
 		return
 	}
 
-	c.setStatus(Stopped)
+	c.setStatusReconnecting(Stopped, c.autoReconnect)
+	c.log().Warnf("connection to %s lost: %v", c.url, err)
 
 	// Close the old client
-	if c.client != nil {
-		c.client.Close()
-		c.client = nil
+	c.apiLock.Lock()
+	client := c.client
+	c.client = nil
+	c.apiLock.Unlock()
+	if client != nil {
+		client.Close()
 	}
 
 	// Trigger connection lost callbacks
@@ -458,6 +598,7 @@ func (c *RpcClient) startReconnect() {
 
 	c.reconnectCtx, c.reconnectCtxCancel = context.WithCancel(context.Background())
 	defer c.reconnectCtxCancel()
+	defer c.setReconnecting(false)
 
 	delay := c.reconnectDelay
 	c.currentAttempt = 0
@@ -473,16 +614,20 @@ func (c *RpcClient) startReconnect() {
 
 		// Check if we've exceeded max attempts
 		if c.reconnectAttempts > 0 && c.currentAttempt >= c.reconnectAttempts {
+			c.log().Errorf("giving up on %s after %d reconnect attempts", c.url, c.currentAttempt)
 			return
 		}
 
 		c.currentAttempt++
 
 		// Attempt to reconnect
-		if err := c.connect(); err == nil {
+		err := c.connect()
+		if err == nil {
 			// Successfully reconnected
+			c.log().Debugf("reconnected to %s", c.url)
 			return
 		}
+		c.log().Debugf("reconnect attempt %d to %s failed: %v (retrying in %s)", c.currentAttempt, c.url, err, delay)
 
 		// Wait before next attempt with exponential backoff, while remaining
 		// responsive to intentional shutdown.
@@ -503,6 +648,18 @@ func (c *RpcClient) startReconnect() {
 	}
 }
 
+// setReconnecting records whether a reconnection is in progress.
+func (c *RpcClient) setReconnecting(reconnecting bool) {
+	c.statusLock.Lock()
+	defer c.statusLock.Unlock()
+	c.reconnecting = reconnecting
+}
+
+// log returns the configured logger, or logging.Nop.
+func (c *RpcClient) log() logging.Logger {
+	return logging.OrNop(c.logger)
+}
+
 // Restart manually triggers a reconnection
 func (c *RpcClient) Restart() error {
 	c.Stop()
@@ -537,7 +694,7 @@ func (c *RpcClient) Restart() error {
 // Note: This method does not trigger connection lost callbacks since it's an
 // intentional shutdown rather than a connection failure.
 func (c *RpcClient) Stop() {
-	c.setStatus(Stopped)
+	c.setStatusReconnecting(Stopped, false)
 	c.closeNormalizedSubscriptions()
 
 	// Stop monitoring
@@ -558,11 +715,14 @@ func (c *RpcClient) Stop() {
 	}
 
 	// Close client
-	if c.client != nil {
-		c.client.Close()
-		c.client = nil
-	}
+	c.apiLock.Lock()
+	client := c.client
+	c.client = nil
 	c.caller = nil
+	c.apiLock.Unlock()
+	if client != nil {
+		client.Close()
+	}
 
 	// Release connection lifecycle callbacks on intentional disconnect.
 	c.callbackLock.Lock()
@@ -2,9 +2,13 @@ package rpc_client
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/0x3639/znn-sdk-go/logging"
 )
 
 // =============================================================================
@@ -422,3 +426,76 @@ func TestClientOptions_ExtremeValues(t *testing.T) {
 		t.Error("Should accept large reconnect attempt count")
 	}
 }
+
+// recordingLogger collects formatted messages prefixed with their level.
+type recordingLogger struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (l *recordingLogger) record(level, format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, level+": "+fmt.Sprintf(format, args...))
+}
+
+func (l *recordingLogger) Debugf(format string, args ...interface{}) {
+	l.record("debug", format, args...)
+}
+func (l *recordingLogger) Warnf(format string, args ...interface{}) {
+	l.record("warn", format, args...)
+}
+func (l *recordingLogger) Errorf(format string, args ...interface{}) {
+	l.record("error", format, args...)
+}
+
+func (l *recordingLogger) snapshot() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.messages...)
+}
+
+func TestRpcClient_ReconnectLogsThroughLogger(t *testing.T) {
+	recorder := &recordingLogger{}
+	client := &RpcClient{
+		url:               "ws://127.0.0.1:1",
+		reconnectDelay:    time.Millisecond,
+		maxReconnectDelay: time.Millisecond,
+		reconnectAttempts: 2,
+		stopReconnectChan: make(chan struct{}, 1),
+		logger:            recorder,
+	}
+
+	client.startReconnect()
+
+	messages := recorder.snapshot()
+	if len(messages) != 3 ||
+		!strings.HasPrefix(messages[0], "debug: reconnect attempt 1 to ws://127.0.0.1:1 failed") ||
+		!strings.HasPrefix(messages[1], "debug: reconnect attempt 2") ||
+		messages[2] != "error: giving up on ws://127.0.0.1:1 after 2 reconnect attempts" {
+		t.Errorf("messages = %q", messages)
+	}
+}
+
+func TestRpcClient_CallbackPanicLogsThroughLogger(t *testing.T) {
+	recorder := &recordingLogger{}
+	client := &RpcClient{logger: recorder}
+	client.AddOnConnectionLostCallback(func(error) { panic("boom") })
+
+	client.triggerConnectionLost(errors.New("lost"))
+
+	deadline := time.Now().Add(time.Second)
+	for len(recorder.snapshot()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if messages := recorder.snapshot(); len(messages) != 1 || messages[0] != "error: panic in connection lost callback: boom" {
+		t.Errorf("messages = %q", messages)
+	}
+}
+
+func TestRpcClient_NilLoggerDiscards(t *testing.T) {
+	client := &RpcClient{}
+	if client.log() != logging.Nop {
+		t.Error("log() should fall back to logging.Nop")
+	}
+}
@@ -44,6 +44,13 @@
 //	    fmt.Printf("Connection lost: %v\n", err)
 //	})
 //
+// ConnectionState and IsConnected report the state synchronously, for example
+// to disable a send button while the client is reconnecting:
+//
+//	if client.ConnectionState() == rpc_client.StateReconnecting {
+//	    sendButton.Disable()
+//	}
+//
 // For normalized updates with automatic reconnection and resubscription, use
 // [RpcClient.Subscribe]. Calling [RpcClient.Stop] closes these subscription sockets,
 // closes their channels, and clears registered lifecycle callbacks.
@@ -66,11 +73,49 @@
 //	}
 //	client, err := rpc_client.NewRpcClientWithOptions("ws://127.0.0.1:35998", options)
 //
+// Connection-loss and reconnect events are silent by default. Set
+// ClientOptions.Logger to any logging.Logger to receive them.
+//
+// Public nodes may disconnect clients that send too many requests. Set
+// ClientOptions.MaxRequestsPerSecond to throttle calls on the client side;
+// calls beyond the budget wait instead of failing.
+//
+// # TLS
+//
+// wss:// and https:// connections verify the node's certificate against the
+// system roots by default. A self-hosted node with a self-signed certificate
+// can be trusted by pinning that certificate rather than disabling
+// verification:
+//
+//	options := rpc_client.DefaultClientOptions()
+//	if err := options.PinCertificate(nodeCertPEM); err != nil {
+//	    log.Fatal(err)
+//	}
+//	client, err := rpc_client.NewRpcClientWithOptions("wss://node.lan:35998", options)
+//
+// Pinning also protects connections to public nodes from mis-issued CA
+// certificates, at the cost of updating the pin whenever the node rotates its
+// certificate. For a private CA, set ClientOptions.TLSConfig with RootCAs
+// instead. Never set InsecureSkipVerify: without verification, anyone on the
+// network path can read and alter RPC traffic, including the blocks a wallet
+// publishes and the balances it displays.
+//
 // # Read vs Write Operations
 //
 // Read-only operations (queries) only require a connected client. Write operations
 // (transactions) require a wallet and keypair for signing. See the wallet package
 // for wallet management.
 //
+// # Testing Without a Node
+//
+// MockClient answers calls from canned responses and records them.
+// NewRpcClientWithCaller builds a client around it, so code that takes an
+// *RpcClient, including the zenon send flow, runs offline:
+//
+//	mock := rpc_client.NewMockClient().
+//	    On("ledger.getFrontierMomentum", momentum)
+//	client := rpc_client.NewRpcClientWithCaller(mock)
+//	defer client.Stop()
+//
 // For more examples, see https://pkg.go.dev/github.com/0x3639/znn-sdk-go/rpc_client
 package rpc_client
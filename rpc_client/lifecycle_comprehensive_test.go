@@ -1,15 +1,18 @@
 package rpc_client
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/0x3639/znn-sdk-go/transport"
+	"github.com/gorilla/websocket"
 )
 
 type lifecycleCaller struct {
@@ -171,3 +174,233 @@ func TestRestartReconnectsStoppedClient(t *testing.T) {
 		t.Fatalf("restarted client = status %v ledger %v plasma %v", client.Status(), client.LedgerApi, client.PlasmaApi)
 	}
 }
+
+// stalledCaller models a half-open connection: after responsive is cleared,
+// calls never get a reply and only return when their context ends.
+type stalledCaller struct {
+	responsive atomic.Bool
+}
+
+func (c *stalledCaller) Call(result interface{}, method string, args ...interface{}) error {
+	return c.CallContext(context.Background(), result, method, args...)
+}
+
+func (c *stalledCaller) CallContext(ctx context.Context, _ interface{}, _ string, _ ...interface{}) error {
+	if c.responsive.Load() {
+		return nil
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestPingTimesOutOnStalledConnection(t *testing.T) {
+	raw := &stalledCaller{}
+	raw.responsive.Store(true)
+	client := &RpcClient{
+		status:         Running,
+		caller:         transport.NewNormalizingCaller(raw),
+		healthCheckCmd: "health.check",
+		pingTimeout:    10 * time.Millisecond,
+	}
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() on responsive connection error = %v", err)
+	}
+
+	raw.responsive.Store(false)
+	start := time.Now()
+	if err := client.Ping(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Ping() on stalled connection error = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Ping() took %v, want about the ping timeout", elapsed)
+	}
+
+	client.setStatus(Stopped)
+	if err := client.Ping(context.Background()); err == nil {
+		t.Fatal("Ping() on stopped client should fail")
+	}
+}
+
+// TestPingConcurrentWithStop runs under -race: Ping must not read the caller
+// while Stop clears it.
+func TestPingConcurrentWithStop(t *testing.T) {
+	raw := &stalledCaller{}
+	raw.responsive.Store(true)
+	client := &RpcClient{
+		status:         Running,
+		caller:         transport.NewNormalizingCaller(raw),
+		healthCheckCmd: "health.check",
+	}
+	pinged := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; ; i++ {
+			if err := client.Ping(context.Background()); err != nil {
+				return
+			}
+			if i == 0 {
+				close(pinged)
+			}
+		}
+	}()
+	<-pinged
+	client.Stop()
+	<-done
+	if err := client.Ping(context.Background()); err == nil {
+		t.Fatal("Ping() after Stop should fail")
+	}
+}
+
+func TestKeepAliveDetectsStalledConnection(t *testing.T) {
+	raw := &stalledCaller{}
+	raw.responsive.Store(true)
+	client := &RpcClient{
+		status:            Running,
+		caller:            transport.NewNormalizingCaller(raw),
+		healthCheckCmd:    "health.check",
+		pingTimeout:       10 * time.Millisecond,
+		stopReconnectChan: make(chan struct{}, 1),
+		subscriptions:     make(map[*NormalizedSubscription]struct{}),
+	}
+	lost := make(chan error, 1)
+	client.AddOnConnectionLostCallback(func(err error) { lost <- err })
+	client.startMonitoring(5 * time.Millisecond)
+	defer client.Stop()
+
+	select {
+	case err := <-lost:
+		t.Fatalf("connection reported lost while responsive: %v", err)
+	case <-time.After(30 * time.Millisecond):
+	}
+
+	raw.responsive.Store(false)
+	select {
+	case err := <-lost:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("connection-lost error = %v, want deadline exceeded", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("keepalive did not report the stalled connection")
+	}
+}
+
+// newToggleServer starts a websocket node that answers every request while
+// online. Going offline closes open connections and refuses new ones.
+func newToggleServer(t *testing.T) (*httptest.Server, func(online bool)) {
+	t.Helper()
+	var (
+		online      atomic.Bool
+		mu          sync.Mutex
+		connections []*websocket.Conn
+	)
+	online.Store(true)
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if !online.Load() {
+			http.Error(writer, "offline", http.StatusServiceUnavailable)
+			return
+		}
+		connection, err := upgrader.Upgrade(writer, request, nil)
+		if err != nil {
+			return
+		}
+		mu.Lock()
+		connections = append(connections, connection)
+		mu.Unlock()
+		defer connection.Close()
+		for {
+			var rpcRequest transport.Request
+			if err := connection.ReadJSON(&rpcRequest); err != nil {
+				return
+			}
+			if err := connection.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": rpcRequest.ID, "result": map[string]interface{}{}}); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, func(up bool) {
+		online.Store(up)
+		if up {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, connection := range connections {
+			connection.Close()
+		}
+		connections = nil
+	}
+}
+
+func TestConnectionStateFollowsConnectivity(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		autoReconnect bool
+		wantLost      State
+	}{
+		{name: "auto reconnect", autoReconnect: true, wantLost: StateReconnecting},
+		{name: "no reconnect", autoReconnect: false, wantLost: StateDisconnected},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server, setOnline := newToggleServer(t)
+			options := DefaultClientOptions()
+			options.AutoReconnect = test.autoReconnect
+			options.HealthCheckInterval = 0
+			options.ReconnectDelay = 5 * time.Millisecond
+			options.MaxReconnectDelay = 20 * time.Millisecond
+			client, err := NewRpcClientWithOptions("ws"+strings.TrimPrefix(server.URL, "http"), options)
+			if err != nil {
+				t.Fatalf("NewRpcClientWithOptions: %v", err)
+			}
+			defer client.Stop()
+			client.pingTimeout = 200 * time.Millisecond
+
+			if !client.IsConnected() || client.ConnectionState() != StateConnected {
+				t.Fatalf("initial state = %v, want Connected", client.ConnectionState())
+			}
+
+			lostState := make(chan State, 1)
+			client.AddOnConnectionLostCallback(func(error) { lostState <- client.ConnectionState() })
+			establishedState := make(chan State, 1)
+			client.AddOnConnectionEstablishedCallback(func() { establishedState <- client.ConnectionState() })
+
+			setOnline(false)
+			client.performHealthCheck()
+			select {
+			case state := <-lostState:
+				if state != test.wantLost {
+					t.Errorf("state in connection-lost callback = %v, want %v", state, test.wantLost)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("connection-lost callback was not invoked")
+			}
+			// Failed reconnect attempts keep the state.
+			time.Sleep(50 * time.Millisecond)
+			if state := client.ConnectionState(); state != test.wantLost || client.IsConnected() {
+				t.Fatalf("offline state = %v, want %v", state, test.wantLost)
+			}
+
+			setOnline(true)
+			if test.autoReconnect {
+				select {
+				case state := <-establishedState:
+					if state != StateConnected {
+						t.Errorf("state in connection-established callback = %v, want Connected", state)
+					}
+				case <-time.After(2 * time.Second):
+					t.Fatal("client did not reconnect")
+				}
+				if !client.IsConnected() {
+					t.Errorf("state after reconnect = %v, want Connected", client.ConnectionState())
+				}
+			}
+
+			client.Stop()
+			if state := client.ConnectionState(); state != StateDisconnected {
+				t.Errorf("state after Stop = %v, want Disconnected", state)
+			}
+		})
+	}
+}
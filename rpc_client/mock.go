@@ -0,0 +1,165 @@
+package rpc_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/0x3639/znn-sdk-go/transport"
+)
+
+// MockCall records one request received by a MockClient.
+type MockCall struct {
+	// Method is the JSON-RPC method, for example "ledger.getFrontierMomentum"
+	Method string
+	// Params are the positional arguments exactly as passed by the SDK
+	Params []interface{}
+}
+
+// MockHandler computes the response to a mocked call from its parameters.
+type MockHandler func(params []interface{}) (interface{}, error)
+
+// MockClient is an in-memory transport.Caller for unit tests.
+//
+// Register a response per method with On, OnError, or OnFunc, then pass the
+// mock to NewRpcClientWithCaller (or directly to any API constructor) to run
+// SDK code without a node. Responses go through a JSON round trip before
+// being stored in the caller's result, so they are decoded exactly as a
+// node's reply would be. Every call is recorded and can be inspected with
+// Calls and CallsTo.
+//
+// Several responses registered for the same method are returned in order; the
+// last one is repeated for any further calls. Calling a method with no
+// registered response fails.
+//
+// A MockClient is safe for concurrent use.
+//
+// Example:
+//
+//	mock := rpc_client.NewMockClient().
+//	    On("ledger.getFrontierMomentum", momentum).
+//	    OnError("ledger.publishRawTransaction", errors.New("insufficient balance"))
+//	client := rpc_client.NewRpcClientWithCaller(mock)
+//	defer client.Stop()
+//
+//	_, err := zenon.NewZenon(client).Send(template, keyPair)
+//	fmt.Println(len(mock.CallsTo("ledger.publishRawTransaction"))) // 1
+type MockClient struct {
+	mu        sync.Mutex
+	handlers  map[string][]MockHandler
+	positions map[string]int
+	calls     []MockCall
+}
+
+// NewMockClient creates a MockClient with no registered responses.
+func NewMockClient() *MockClient {
+	return &MockClient{
+		handlers:  make(map[string][]MockHandler),
+		positions: make(map[string]int),
+	}
+}
+
+// On queues a successful response for method. The result may be any value
+// that marshals to JSON, including a json.RawMessage holding a node reply
+// verbatim; nil produces a JSON null.
+func (m *MockClient) On(method string, result interface{}) *MockClient {
+	return m.OnFunc(method, func([]interface{}) (interface{}, error) {
+		return result, nil
+	})
+}
+
+// OnError queues a failed response for method. The error reaches SDK code
+// wrapped in a transport.RPCError, as a node error would.
+func (m *MockClient) OnError(method string, err error) *MockClient {
+	return m.OnFunc(method, func([]interface{}) (interface{}, error) {
+		return nil, err
+	})
+}
+
+// OnFunc queues a handler that computes the response for method from the
+// call parameters, for responses that depend on earlier calls.
+func (m *MockClient) OnFunc(method string, handler MockHandler) *MockClient {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[method] = append(m.handlers[method], handler)
+	return m
+}
+
+// Call implements transport.Caller.
+func (m *MockClient) Call(result interface{}, method string, args ...interface{}) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, MockCall{Method: method, Params: append([]interface{}(nil), args...)})
+	handlers := m.handlers[method]
+	position := m.positions[method]
+	if position < len(handlers)-1 {
+		m.positions[method] = position + 1
+	}
+	m.mu.Unlock()
+
+	if len(handlers) == 0 {
+		return fmt.Errorf("mock: no response registered for method %q", method)
+	}
+	response, err := handlers[position](args)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	encoded, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("mock: encode response for %q: %w", method, err)
+	}
+	if err := json.Unmarshal(encoded, result); err != nil {
+		return fmt.Errorf("mock: decode response for %q: %w", method, err)
+	}
+	return nil
+}
+
+// Calls returns every call received so far, in order.
+func (m *MockClient) Calls() []MockCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockCall(nil), m.calls...)
+}
+
+// CallsTo returns the calls received for method, in order.
+func (m *MockClient) CallsTo(method string) []MockCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var calls []MockCall
+	for _, call := range m.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// NewRpcClientWithCaller creates an RpcClient whose APIs send every request
+// through caller instead of a network connection.
+//
+// It is intended for tests with a MockClient, and for callers that supply
+// their own transport. The returned client reports the Running status, never
+// reconnects, and has no websocket, so SubscriberApi and Subscribe return an
+// error. Errors from caller are normalized to transport.RPCError like those
+// of a real connection.
+//
+// Parameters:
+//   - caller: Transport for all API calls; it must not be nil
+//
+// Example:
+//
+//	client := rpc_client.NewRpcClientWithCaller(rpc_client.NewMockClient().
+//	    On("ledger.getFrontierMomentum", momentum))
+//	m, _ := client.LedgerApi.GetFrontierMomentum()
+func NewRpcClientWithCaller(caller transport.Caller) *RpcClient {
+	c := &RpcClient{
+		caller:            transport.NewNormalizingCaller(caller),
+		status:            Running,
+		stopReconnectChan: make(chan struct{}),
+		subscriptions:     make(map[*NormalizedSubscription]struct{}),
+	}
+	c.initializeAPIs()
+	return c
+}
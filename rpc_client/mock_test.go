@@ -0,0 +1,90 @@
+package rpc_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/transport"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	nodeapi "github.com/zenon-network/go-zenon/rpc/api"
+)
+
+func TestMockClientReturnsResponsesInOrder(t *testing.T) {
+	mock := NewMockClient().
+		On("ledger.getFrontierMomentum", &nodeapi.Momentum{Momentum: &nom.Momentum{Height: 1}}).
+		On("ledger.getFrontierMomentum", json.RawMessage(`{"height":2,"producer":"z1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqsggv2f"}`))
+	client := NewRpcClientWithCaller(mock)
+	defer client.Stop()
+
+	for _, want := range []uint64{1, 2, 2} {
+		momentum, err := client.LedgerApi.GetFrontierMomentum()
+		if err != nil {
+			t.Fatalf("GetFrontierMomentum() error = %v", err)
+		}
+		if momentum.Height != want {
+			t.Errorf("GetFrontierMomentum() height = %d, want %d", momentum.Height, want)
+		}
+	}
+	if calls := mock.CallsTo("ledger.getFrontierMomentum"); len(calls) != 3 {
+		t.Errorf("CallsTo() = %d calls, want 3", len(calls))
+	}
+}
+
+func TestMockClientRecordsParamsAndUsesHandlers(t *testing.T) {
+	address := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	mock := NewMockClient().OnFunc("ledger.getAccountInfoByAddress", func(params []interface{}) (interface{}, error) {
+		return nodeapi.AccountInfo{Address: types.ParseAddressPanic(params[0].(string)), AccountHeight: 5}, nil
+	})
+	client := NewRpcClientWithCaller(mock)
+	defer client.Stop()
+
+	info, err := client.LedgerApi.GetAccountInfoByAddress(address)
+	if err != nil {
+		t.Fatalf("GetAccountInfoByAddress() error = %v", err)
+	}
+	if info.Address != address || info.AccountHeight != 5 {
+		t.Errorf("GetAccountInfoByAddress() = %s at %d", info.Address, info.AccountHeight)
+	}
+	calls := mock.Calls()
+	if len(calls) != 1 || calls[0].Method != "ledger.getAccountInfoByAddress" || calls[0].Params[0] != address.String() {
+		t.Errorf("Calls() = %+v", calls)
+	}
+}
+
+func TestMockClientErrors(t *testing.T) {
+	nodeErr := errors.New("insufficient balance")
+	mock := NewMockClient().OnError("ledger.getFrontierMomentum", nodeErr)
+	client := NewRpcClientWithCaller(mock)
+	defer client.Stop()
+
+	_, err := client.LedgerApi.GetFrontierMomentum()
+	var rpcErr *transport.RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Method != "ledger.getFrontierMomentum" || !errors.Is(err, nodeErr) {
+		t.Errorf("GetFrontierMomentum() error = %v, want RPCError wrapping the registered error", err)
+	}
+
+	if _, err := client.LedgerApi.GetFrontierAccountBlock(types.PlasmaContract); err == nil {
+		t.Error("GetFrontierAccountBlock() without a registered response should fail")
+	}
+}
+
+func TestNewRpcClientWithCallerLifecycle(t *testing.T) {
+	client := NewRpcClientWithCaller(NewMockClient())
+	if client.Status() != Running {
+		t.Errorf("Status() = %s, want Running", client.Status())
+	}
+	if _, _, err := client.SubscriberApi.ToMomentums(context.Background()); err == nil {
+		t.Error("SubscriberApi.ToMomentums() should fail without a websocket")
+	}
+	if _, err := client.Subscribe(context.Background(), "momentums"); err == nil {
+		t.Error("Subscribe() should fail without a websocket")
+	}
+	client.Stop()
+	client.Stop()
+	if !client.IsClosed() {
+		t.Error("IsClosed() = false after Stop")
+	}
+}
@@ -0,0 +1,116 @@
+package rpc_client
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/0x3639/znn-sdk-go/transport"
+)
+
+// rateLimiter is a token bucket that holds up to one second's worth of
+// requests. Each request takes a token; when none is left it reserves the
+// next one and waits until the bucket has refilled to cover it, so callers
+// are served in the order they arrive.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	// now and after are replaced in tests with a fake clock
+	now   func() time.Time
+	after func(time.Duration) <-chan time.Time
+}
+
+// newRateLimiter returns a limiter allowing perSecond requests per second,
+// or nil when perSecond is not positive (unlimited).
+func newRateLimiter(perSecond float64) *rateLimiter {
+	if perSecond <= 0 || math.IsNaN(perSecond) || math.IsInf(perSecond, 0) {
+		return nil
+	}
+	burst := math.Max(1, math.Ceil(perSecond))
+	return &rateLimiter{
+		rate:   perSecond,
+		burst:  burst,
+		tokens: burst,
+		now:    time.Now,
+		after:  time.After,
+	}
+}
+
+// wait blocks until a request may be sent or ctx is done. A request that
+// gives up returns its reservation to the bucket.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	if !l.last.IsZero() {
+		elapsed := now.Sub(l.last).Seconds()
+		if elapsed > 0 {
+			l.tokens = math.Min(l.burst, l.tokens+elapsed*l.rate)
+		}
+	}
+	l.last = now
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-l.after(delay):
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens = math.Min(l.burst, l.tokens+1)
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// rateLimitedCaller waits on a rateLimiter before forwarding each call.
+type rateLimitedCaller struct {
+	caller  transport.Caller
+	limiter *rateLimiter
+}
+
+// Call waits for the rate limit without a deadline, then forwards the call.
+func (c *rateLimitedCaller) Call(result interface{}, method string, args ...interface{}) error {
+	return c.CallContext(context.Background(), result, method, args...)
+}
+
+// CallContext waits for the rate limit, giving up when ctx is done, then
+// forwards the call with ctx when the wrapped caller supports it.
+func (c *rateLimitedCaller) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err := c.limiter.wait(ctx); err != nil {
+		return err
+	}
+	if contextual, ok := c.caller.(interface {
+		CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	}); ok {
+		return contextual.CallContext(ctx, result, method, args...)
+	}
+	return c.caller.Call(result, method, args...)
+}
+
+// withRateLimit wraps caller with limiter, or returns it unchanged when
+// limiter is nil.
+func withRateLimit(caller transport.Caller, limiter *rateLimiter) transport.Caller {
+	if limiter == nil {
+		return caller
+	}
+	return &rateLimitedCaller{caller: caller, limiter: limiter}
+}
@@ -0,0 +1,124 @@
+package rpc_client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeClock drives a rateLimiter without sleeping: after records each wait
+// and advances the clock by it.
+type fakeClock struct {
+	current time.Time
+	waits   []time.Duration
+}
+
+func (c *fakeClock) now() time.Time { return c.current }
+
+func (c *fakeClock) after(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	c.current = c.current.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.current
+	return ch
+}
+
+func newFakeLimiter(perSecond float64) (*rateLimiter, *fakeClock) {
+	clock := &fakeClock{current: time.Unix(1700000000, 0)}
+	limiter := newRateLimiter(perSecond)
+	limiter.now = clock.now
+	limiter.after = clock.after
+	return limiter, clock
+}
+
+func TestRateLimiterSpacesCallsBeyondBurst(t *testing.T) {
+	limiter, clock := newFakeLimiter(5)
+	caller := withRateLimit(NewMockClient().On("ledger.getFrontierMomentum", nil), limiter)
+
+	start := clock.now()
+	for i := 0; i < 8; i++ {
+		if err := caller.Call(nil, "ledger.getFrontierMomentum"); err != nil {
+			t.Fatalf("call %d error = %v", i, err)
+		}
+	}
+	// Five calls fit in the initial one-second budget; each further call
+	// waits 1/5 s for the next token.
+	want := []time.Duration{200 * time.Millisecond, 200 * time.Millisecond, 200 * time.Millisecond}
+	if len(clock.waits) != len(want) {
+		t.Fatalf("waits = %v, want %v", clock.waits, want)
+	}
+	for i := range want {
+		if clock.waits[i] != want[i] {
+			t.Errorf("wait %d = %v, want %v", i, clock.waits[i], want[i])
+		}
+	}
+	if elapsed := clock.now().Sub(start); elapsed != 600*time.Millisecond {
+		t.Errorf("elapsed = %v, want 600ms", elapsed)
+	}
+}
+
+func TestRateLimiterRefillsOverTime(t *testing.T) {
+	limiter, clock := newFakeLimiter(2)
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := limiter.wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clock.current = clock.current.Add(time.Second)
+	for i := 0; i < 2; i++ {
+		if err := limiter.wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(clock.waits) != 0 {
+		t.Errorf("waits = %v, want none after a full refill", clock.waits)
+	}
+	if err := limiter.wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(clock.waits) != 1 || clock.waits[0] != 500*time.Millisecond {
+		t.Errorf("waits = %v, want [500ms]", clock.waits)
+	}
+}
+
+func TestRateLimiterRespectsContext(t *testing.T) {
+	limiter, _ := newFakeLimiter(1)
+	limiter.after = func(time.Duration) <-chan time.Time { return nil } // never fires
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mock := NewMockClient().On("ledger.getFrontierMomentum", nil)
+	caller := withRateLimit(mock, limiter).(*rateLimitedCaller)
+	if err := caller.CallContext(ctx, nil, "ledger.getFrontierMomentum"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("CallContext() error = %v, want context.Canceled", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := caller.CallContext(ctx, nil, "ledger.getFrontierMomentum"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("CallContext() error = %v, want context.DeadlineExceeded", err)
+	}
+	if calls := len(mock.Calls()); calls != 0 {
+		t.Errorf("throttled calls reached the node %d times", calls)
+	}
+	if limiter.tokens != 0 {
+		t.Errorf("tokens = %v, want the abandoned reservation returned", limiter.tokens)
+	}
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	for _, rate := range []float64{0, -1} {
+		if limiter := newRateLimiter(rate); limiter != nil {
+			t.Errorf("newRateLimiter(%v) = %+v, want nil", rate, limiter)
+		}
+	}
+	mock := NewMockClient()
+	if caller := withRateLimit(mock, nil); caller != mock {
+		t.Error("withRateLimit(nil) should return the caller unchanged")
+	}
+}
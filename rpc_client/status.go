@@ -29,3 +29,41 @@ func (s WebsocketStatus) String() string {
 		return "Unknown"
 	}
 }
+
+// State is the connection state reported by RpcClient.ConnectionState.
+//
+// Unlike WebsocketStatus, which reports Stopped both after Stop and while a
+// lost connection is being re-established, State tells an application whether
+// the client is trying to reconnect, so it can disable actions such as sending
+// until the connection is back.
+type State int
+
+const (
+	// StateDisconnected indicates the client is not connected and is not
+	// trying to connect: it was stopped, the initial connection failed, or
+	// reconnection is disabled or gave up
+	StateDisconnected State = iota
+	// StateConnecting indicates the initial connection is being established
+	StateConnecting
+	// StateConnected indicates the client is connected and calls can be made
+	StateConnected
+	// StateReconnecting indicates the connection was lost and the client is
+	// reconnecting with backoff
+	StateReconnecting
+)
+
+// String returns the string representation of State
+func (s State) String() string {
+	switch s {
+	case StateDisconnected:
+		return "Disconnected"
+	case StateConnecting:
+		return "Connecting"
+	case StateConnected:
+		return "Connected"
+	case StateReconnecting:
+		return "Reconnecting"
+	default:
+		return "Unknown"
+	}
+}
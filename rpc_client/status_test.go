@@ -41,3 +41,22 @@ func TestWebsocketStatus_Values(t *testing.T) {
 		t.Errorf("Stopped = %d, want 3", Stopped)
 	}
 }
+
+func TestState_String(t *testing.T) {
+	tests := []struct {
+		state    State
+		expected string
+	}{
+		{StateDisconnected, "Disconnected"},
+		{StateConnecting, "Connecting"},
+		{StateConnected, "Connected"},
+		{StateReconnecting, "Reconnecting"},
+		{State(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.state.String(); got != tt.expected {
+			t.Errorf("State(%d).String() = %s, want %s", tt.state, got, tt.expected)
+		}
+	}
+}
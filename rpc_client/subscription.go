@@ -163,7 +163,7 @@ type websocketNotification struct {
 }
 
 func (s *NormalizedSubscription) open() (*websocket.Conn, string, error) {
-	connection, _, err := websocket.DefaultDialer.DialContext(s.ctx, s.client.url, nil)
+	connection, _, err := s.client.websocketDialer().DialContext(s.ctx, s.client.url, nil)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to connect subscription transport: %w", err)
 	}
@@ -0,0 +1,137 @@
+package rpc_client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/websocket"
+	"github.com/zenon-network/go-zenon/rpc/server"
+)
+
+// ErrCertificateNotPinned is returned when a TLS handshake presents a
+// certificate other than the ones pinned with ClientOptions.PinCertificate.
+var ErrCertificateNotPinned = errors.New("server certificate is not pinned")
+
+// PinCertificate makes the client trust only the given certificates for wss://
+// and https:// connections.
+//
+// pemBytes holds one or more PEM "CERTIFICATE" blocks, typically the node's own
+// certificate (self-signed or CA-issued). A TLS handshake then succeeds only if
+// the server presents one of these exact certificates as its leaf and the
+// certificate is valid for the host name in the URL. The system roots are not
+// consulted, so a certificate issued by any other CA, including a publicly
+// trusted one, is rejected with ErrCertificateNotPinned.
+//
+// PinCertificate builds on a copy of TLSConfig when one is set, so other
+// settings such as MinVersion are kept; RootCAs and VerifyConnection are
+// replaced.
+//
+// Parameters:
+//   - pemBytes: PEM-encoded certificates to pin, for example the contents of
+//     the node's cert.pem
+//
+// Returns an error if pemBytes contains no certificate or a certificate fails
+// to parse; TLSConfig is left unchanged in that case.
+//
+// Example:
+//
+//	pem, err := os.ReadFile("node-cert.pem")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	opts := rpc_client.DefaultClientOptions()
+//	if err := opts.PinCertificate(pem); err != nil {
+//	    log.Fatal(err)
+//	}
+//	client, err := rpc_client.NewRpcClientWithOptions("wss://node.example:35998", opts)
+//
+// Note: A pin must be updated before the node rotates its certificate, or the
+// client stops connecting. Pin the new and old certificates together during a
+// rotation.
+func (o *ClientOptions) PinCertificate(pemBytes []byte) error {
+	var pinned []*x509.Certificate
+	for rest := pemBytes; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("invalid pinned certificate: %w", err)
+		}
+		pinned = append(pinned, certificate)
+	}
+	if len(pinned) == 0 {
+		return errors.New("no PEM certificate to pin")
+	}
+
+	roots := x509.NewCertPool()
+	for _, certificate := range pinned {
+		roots.AddCert(certificate)
+	}
+	config := o.TLSConfig.Clone()
+	if config == nil {
+		config = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	config.RootCAs = roots
+	// Chain verification against roots alone would also accept leaves signed
+	// by a pinned CA certificate; the pin is on the leaf itself.
+	config.VerifyConnection = func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return ErrCertificateNotPinned
+		}
+		leaf := state.PeerCertificates[0].Raw
+		for _, certificate := range pinned {
+			if bytes.Equal(leaf, certificate.Raw) {
+				return nil
+			}
+		}
+		return ErrCertificateNotPinned
+	}
+	o.TLSConfig = config
+	return nil
+}
+
+// dial opens the go-zenon RPC client for c.url, applying c.tlsConfig to secure
+// transports.
+func (c *RpcClient) dial() (*server.Client, error) {
+	if c.tlsConfig == nil {
+		return server.Dial(c.url)
+	}
+	parsed, err := url.Parse(c.url)
+	if err != nil {
+		return nil, err
+	}
+	switch parsed.Scheme {
+	case "ws", "wss":
+		return server.DialWebsocketWithDialer(context.Background(), c.url, "", *c.websocketDialer())
+	case "http", "https":
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = c.tlsConfig.Clone()
+		return server.DialHTTPWithClient(c.url, &http.Client{Transport: transport})
+	default:
+		return server.Dial(c.url)
+	}
+}
+
+// websocketDialer returns the dialer for websocket connections, including
+// subscription sockets.
+func (c *RpcClient) websocketDialer() *websocket.Dialer {
+	if c.tlsConfig == nil {
+		return websocket.DefaultDialer
+	}
+	dialer := *websocket.DefaultDialer
+	dialer.TLSClientConfig = c.tlsConfig.Clone()
+	return &dialer
+}
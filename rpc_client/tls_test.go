@@ -0,0 +1,166 @@
+package rpc_client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// newSelfSignedServer starts a wss test server with httptest's self-signed
+// certificate and returns it with the certificate in PEM form.
+func newSelfSignedServer(t *testing.T) (*httptest.Server, []byte) {
+	t.Helper()
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		connection, err := upgrader.Upgrade(writer, request, nil)
+		if err != nil {
+			return
+		}
+		defer connection.Close()
+		for {
+			if _, _, err := connection.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+// otherCertificatePEM returns a self-signed certificate for 127.0.0.1 that the
+// test server does not use.
+func otherCertificatePEM(t *testing.T) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "other node"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestPinCertificateSelfSignedServer(t *testing.T) {
+	server, serverPEM := newSelfSignedServer(t)
+	url := "wss" + strings.TrimPrefix(server.URL, "https")
+
+	tests := []struct {
+		name      string
+		configure func(t *testing.T, opts *ClientOptions)
+		wantErr   bool
+	}{
+		{
+			name:      "default verification rejects self-signed",
+			configure: func(*testing.T, *ClientOptions) {},
+			wantErr:   true,
+		},
+		{
+			name: "pinned certificate",
+			configure: func(t *testing.T, opts *ClientOptions) {
+				if err := opts.PinCertificate(serverPEM); err != nil {
+					t.Fatalf("PinCertificate() error = %v", err)
+				}
+			},
+		},
+		{
+			name: "pinned alongside a rotated certificate",
+			configure: func(t *testing.T, opts *ClientOptions) {
+				if err := opts.PinCertificate(append(otherCertificatePEM(t), serverPEM...)); err != nil {
+					t.Fatalf("PinCertificate() error = %v", err)
+				}
+			},
+		},
+		{
+			name: "different certificate pinned",
+			configure: func(t *testing.T, opts *ClientOptions) {
+				if err := opts.PinCertificate(otherCertificatePEM(t)); err != nil {
+					t.Fatalf("PinCertificate() error = %v", err)
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "custom root pool",
+			configure: func(t *testing.T, opts *ClientOptions) {
+				roots := x509.NewCertPool()
+				roots.AddCert(server.Certificate())
+				opts.TLSConfig = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultClientOptions()
+			opts.AutoReconnect = false
+			opts.HealthCheckInterval = 0
+			tt.configure(t, &opts)
+
+			client, err := NewRpcClientWithOptions(url, opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRpcClientWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if client != nil {
+				client.Stop()
+			}
+		})
+	}
+}
+
+func TestPinCertificateRejectsInvalidPEM(t *testing.T) {
+	original := &tls.Config{MinVersion: tls.VersionTLS13}
+	for name, input := range map[string][]byte{
+		"empty":       nil,
+		"not PEM":     []byte("not a certificate"),
+		"private key": pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte{1}}),
+		"corrupt":     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte{1, 2, 3}}),
+	} {
+		opts := ClientOptions{TLSConfig: original}
+		if err := opts.PinCertificate(input); err == nil {
+			t.Errorf("%s: PinCertificate() error = nil, want error", name)
+		}
+		if opts.TLSConfig != original {
+			t.Errorf("%s: PinCertificate() replaced TLSConfig on error", name)
+		}
+	}
+}
+
+func TestPinCertificateKeepsExistingSettings(t *testing.T) {
+	_, serverPEM := newSelfSignedServer(t)
+	original := &tls.Config{MinVersion: tls.VersionTLS13}
+	opts := ClientOptions{TLSConfig: original}
+	if err := opts.PinCertificate(serverPEM); err != nil {
+		t.Fatalf("PinCertificate() error = %v", err)
+	}
+	if opts.TLSConfig.MinVersion != tls.VersionTLS13 {
+		t.Errorf("MinVersion = %x, want TLS 1.3", opts.TLSConfig.MinVersion)
+	}
+	if original.RootCAs != nil || original.VerifyConnection != nil {
+		t.Error("PinCertificate() modified the caller's TLSConfig")
+	}
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// IsValidAddress reports whether s is a valid Zenon address.
+//
+// Both the canonical lowercase form and the all-uppercase form accepted by
+// bech32 are valid; mixed-case strings are not. Surrounding whitespace is
+// ignored.
+//
+// Example:
+//
+//	if !utils.IsValidAddress(input) {
+//	    return fmt.Errorf("invalid address %q", input)
+//	}
+func IsValidAddress(s string) bool {
+	_, err := NormalizeAddress(s)
+	return err == nil
+}
+
+// NormalizeAddress returns the canonical form of a Zenon address.
+//
+// The canonical form is the lowercase bech32 string produced by
+// types.Address.String. Normalize user input before storing or comparing
+// addresses, since the same address may also be written in uppercase (as QR
+// codes often do).
+//
+// Parameters:
+//   - s: Address string; surrounding whitespace is ignored
+//
+// Returns the canonical address string, or an error if s has an invalid
+// checksum, mixed case, or a prefix other than "z".
+//
+// Example:
+//
+//	addr, err := utils.NormalizeAddress("Z1QQJNWJJPNUE8XMMPANZ6CSZE6TCMTZZDTFSWW7")
+//	// addr == "z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7"
+func NormalizeAddress(s string) (string, error) {
+	addr, err := types.ParseAddress(strings.TrimSpace(s))
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
+
+// AddressEqual reports whether a and b are the same valid address, regardless
+// of casing or surrounding whitespace.
+//
+// Invalid addresses are never equal, not even to themselves.
+//
+// Example:
+//
+//	if utils.AddressEqual(block.ToAddress.String(), userInput) {
+//	    // payment is for the expected recipient
+//	}
+func AddressEqual(a, b string) bool {
+	normalizedA, err := NormalizeAddress(a)
+	if err != nil {
+		return false
+	}
+	normalizedB, err := NormalizeAddress(b)
+	if err != nil {
+		return false
+	}
+	return normalizedA == normalizedB
+}
+
+// ShortAddress abbreviates an address for display, keeping its first head and
+// last tail characters around an ellipsis.
+//
+// Parameters:
+//   - addr: Address to abbreviate
+//   - head: Characters to keep from the start (including the "z1" prefix)
+//   - tail: Characters to keep from the end
+//
+// Returns the full address when head+tail would not shorten it. Negative
+// counts are treated as zero.
+//
+// Example:
+//
+//	utils.ShortAddress(addr, 4, 3) // "z1qq…ww7"
+func ShortAddress(addr types.Address, head, tail int) string {
+	s := addr.String()
+	head = max(head, 0)
+	tail = max(tail, 0)
+	if head+tail >= len(s) {
+		return s
+	}
+	return s[:head] + "…" + s[len(s)-tail:]
+}
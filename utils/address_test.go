@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+const testAddress = "z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7"
+
+// testWrongPrefixAddress has a valid bech32 checksum but the "x" prefix.
+const testWrongPrefixAddress = "x1qqjnwjjpnue8xmmpanz6csze6tcmtzzdauhc5g"
+
+func TestNormalizeAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "canonical", input: testAddress, want: testAddress},
+		{name: "uppercase", input: strings.ToUpper(testAddress), want: testAddress},
+		{name: "surrounding whitespace", input: "  " + testAddress + "\n", want: testAddress},
+		{name: "mixed case", input: "Z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7", wantErr: true},
+		{name: "invalid prefix", input: testWrongPrefixAddress, wantErr: true},
+		{name: "bad checksum", input: "z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww8", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeAddress(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeAddress(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeAddress(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+			if IsValidAddress(tt.input) == tt.wantErr {
+				t.Errorf("IsValidAddress(%q) = %v, want %v", tt.input, !tt.wantErr, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddressEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{name: "identical", a: testAddress, b: testAddress, want: true},
+		{name: "different casing", a: testAddress, b: strings.ToUpper(testAddress), want: true},
+		{name: "different addresses", a: testAddress, b: types.PlasmaContract.String(), want: false},
+		{name: "invalid prefix", a: testWrongPrefixAddress, b: testWrongPrefixAddress, want: false},
+		{name: "invalid and valid", a: "", b: testAddress, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddressEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("AddressEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortAddress(t *testing.T) {
+	addr := types.ParseAddressPanic(testAddress)
+	tests := []struct {
+		name       string
+		head, tail int
+		want       string
+	}{
+		{name: "typical", head: 4, tail: 3, want: "z1qq…ww7"},
+		{name: "no tail", head: 6, tail: 0, want: "z1qqjn…"},
+		{name: "negative counts", head: -1, tail: 2, want: "…w7"},
+		{name: "too long to shorten", head: 20, tail: 20, want: testAddress},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShortAddress(addr, tt.head, tt.tail); got != tt.want {
+				t.Errorf("ShortAddress(%d, %d) = %q, want %q", tt.head, tt.tail, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
+
+	"github.com/zenon-network/go-zenon/common/types"
 )
 
 // =============================================================================
@@ -133,3 +136,160 @@ func AddDecimals(number *big.Int, decimals int) string {
 
 	return result
 }
+
+// RawToRat converts an amount in base units to an exact rational number of
+// whole tokens, raw / 10^decimals.
+//
+// Unlike AddDecimals, the result is a number rather than a string, so it can
+// take part in further arithmetic without losing precision. Convert back with
+// RatToRaw once the calculation is done.
+//
+// Parameters:
+//   - raw: Amount in base units (e.g., 150000000)
+//   - decimals: Number of decimal places (e.g., 8 for ZNN/QSR)
+//
+// Returns the amount as a big.Rat, or nil if raw is nil or decimals is
+// negative.
+//
+// Example - Split a balance three ways:
+//
+//	share := new(big.Rat).Quo(utils.RawToRat(balance, 8), big.NewRat(3, 1))
+//	raw, err := utils.RatToRaw(share, 8)
+//	// err wraps ErrNotRepresentable unless balance divides evenly by 3
+func RawToRat(raw *big.Int, decimals int) *big.Rat {
+	if raw == nil || decimals < 0 {
+		return nil
+	}
+	return new(big.Rat).SetFrac(raw, decimalsMultiplier(decimals))
+}
+
+// ErrNotRepresentable is returned by RatToRaw when a value has more
+// fractional digits than the token's decimals allow.
+var ErrNotRepresentable = errors.New("amount is not representable in the given decimals")
+
+// RatToRaw converts an exact rational number of whole tokens to base units,
+// r * 10^decimals.
+//
+// The conversion never rounds: a value that falls between two base units
+// (such as 1/3 of a token) is an error, so the caller decides explicitly how
+// any remainder is handled.
+//
+// Parameters:
+//   - r: Amount in whole tokens (e.g., 3/2 for 1.5)
+//   - decimals: Number of decimal places (e.g., 8 for ZNN/QSR)
+//
+// Returns the amount in base units, or an error if r is nil, decimals is
+// negative, or r is not a whole number of base units (wrapping
+// ErrNotRepresentable).
+//
+// Example:
+//
+//	raw, err := utils.RatToRaw(big.NewRat(3, 2), 8)
+//	// Returns: 150000000
+func RatToRaw(r *big.Rat, decimals int) (*big.Int, error) {
+	if r == nil {
+		return nil, fmt.Errorf("amount cannot be nil")
+	}
+	if decimals < 0 {
+		return nil, fmt.Errorf("decimals cannot be negative: %d", decimals)
+	}
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(decimalsMultiplier(decimals)))
+	if !scaled.IsInt() {
+		return nil, fmt.Errorf("%w: %s with %d decimals", ErrNotRepresentable, r.RatString(), decimals)
+	}
+	return new(big.Int).Set(scaled.Num()), nil
+}
+
+// DustDisplayDecimals is the number of decimals IsDustAmount assumes an amount
+// is displayed with. Tokens with fewer decimals are displayed in full.
+const DustDisplayDecimals = 4
+
+// IsDustAmount reports whether an amount is too small to be worth sending:
+// zero, negative, or so small that it rounds to zero when displayed with
+// DustDisplayDecimals decimals.
+//
+// For ZNN and QSR (8 decimals) that flags anything below 0.00005, that is
+// below 5000 base units. A token with DustDisplayDecimals or fewer decimals is
+// displayed exactly, so only zero and negative amounts are dust.
+//
+// Parameters:
+//   - raw: Amount in base units
+//   - decimals: Token decimals (e.g., 8 for ZNN/QSR)
+//
+// Returns true for dust, including a nil amount.
+//
+// Example:
+//
+//	if utils.IsDustAmount(amount, utils.CoinDecimals) {
+//	    return fmt.Errorf("%s ZNN is too small to send", utils.AddDecimals(amount, 8))
+//	}
+func IsDustAmount(raw *big.Int, decimals int) bool {
+	if raw == nil || raw.Sign() <= 0 {
+		return true
+	}
+	if decimals <= DustDisplayDecimals {
+		return false
+	}
+	// raw rounds to zero when it is below half of the smallest displayed unit.
+	unit := decimalsMultiplier(decimals - DustDisplayDecimals)
+	return new(big.Int).Lsh(raw, 1).Cmp(unit) < 0
+}
+
+// ErrInvalidAmountUnit is returned by ParseAmountWithUnit when the amount has
+// no unit, an unknown unit, or cannot be read unambiguously.
+var ErrInvalidAmountUnit = errors.New("amount must be a number followed by ZNN or QSR")
+
+// ParseAmountWithUnit parses an amount typed with its coin, such as "10 ZNN"
+// or "0.5qsr", into base units and the coin's token standard.
+//
+// The unit is case-insensitive and may follow the number with or without
+// spaces. A bare number is rejected rather than guessed, as are negative
+// amounts and amounts with more fractional digits than the coin has (which
+// ExtractDecimals would silently truncate).
+//
+// Parameters:
+//   - s: Amount and unit (e.g., "10 ZNN", "0.5qsr", "1.25 Znn")
+//
+// Returns the amount in base units and ZnnTokenStandard or QsrTokenStandard,
+// or an error wrapping ErrInvalidAmountUnit.
+//
+// Example:
+//
+//	amount, zts, err := utils.ParseAmountWithUnit("0.5 QSR")
+//	// Returns: 50000000, types.QsrTokenStandard, nil
+//	template := client.LedgerApi.SendTemplate(to, zts, amount, nil)
+func ParseAmountWithUnit(s string) (*big.Int, types.ZenonTokenStandard, error) {
+	trimmed := strings.TrimSpace(s)
+	split := strings.LastIndexAny(trimmed, "0123456789.") + 1
+	number := strings.TrimSpace(trimmed[:split])
+	unit := strings.TrimSpace(trimmed[split:])
+
+	var zts types.ZenonTokenStandard
+	switch {
+	case unit == "":
+		return nil, types.ZeroTokenStandard, fmt.Errorf("%w: %q has no unit", ErrInvalidAmountUnit, s)
+	case strings.EqualFold(unit, "ZNN"):
+		zts = types.ZnnTokenStandard
+	case strings.EqualFold(unit, "QSR"):
+		zts = types.QsrTokenStandard
+	default:
+		return nil, types.ZeroTokenStandard, fmt.Errorf("%w: unknown unit %q", ErrInvalidAmountUnit, unit)
+	}
+
+	if !strings.ContainsAny(number, "0123456789") || strings.HasPrefix(number, "-") || strings.HasPrefix(number, "+") {
+		return nil, types.ZeroTokenStandard, fmt.Errorf("%w: invalid amount %q", ErrInvalidAmountUnit, s)
+	}
+	if _, fraction, ok := strings.Cut(number, "."); ok && len(fraction) > CoinDecimals {
+		return nil, types.ZeroTokenStandard, fmt.Errorf("%w: %q has more than %d decimals", ErrInvalidAmountUnit, s, CoinDecimals)
+	}
+	amount, err := ExtractDecimals(number, CoinDecimals)
+	if err != nil {
+		return nil, types.ZeroTokenStandard, fmt.Errorf("%w: %v", ErrInvalidAmountUnit, err)
+	}
+	return amount, zts, nil
+}
+
+// decimalsMultiplier returns 10^decimals.
+func decimalsMultiplier(decimals int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+}
@@ -1,8 +1,11 @@
 package utils
 
 import (
+	"errors"
 	"math/big"
 	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
 )
 
 // =============================================================================
@@ -246,3 +249,161 @@ func TestAmountRoundTrip(t *testing.T) {
 		}
 	}
 }
+
+// =============================================================================
+// RawToRat / RatToRaw Tests
+// =============================================================================
+
+func TestRawToRat(t *testing.T) {
+	got := RawToRat(big.NewInt(150000000), 8)
+	if got.Cmp(big.NewRat(3, 2)) != 0 {
+		t.Errorf("RawToRat(150000000, 8) = %s, want 3/2", got.RatString())
+	}
+	if RawToRat(nil, 8) != nil {
+		t.Error("RawToRat(nil, 8) should return nil")
+	}
+	if RawToRat(big.NewInt(1), -1) != nil {
+		t.Error("RawToRat with negative decimals should return nil")
+	}
+}
+
+func TestRatToRaw(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        *big.Rat
+		decimals int
+		want     string
+		wantErr  error
+	}{
+		{"whole", big.NewRat(100, 1), 8, "10000000000", nil},
+		{"fraction", big.NewRat(3, 2), 8, "150000000", nil},
+		{"smallest unit", big.NewRat(1, 100000000), 8, "1", nil},
+		{"negative", big.NewRat(-99, 100), 2, "-99", nil},
+		{"zero decimals", big.NewRat(42, 1), 0, "42", nil},
+		{"one third", big.NewRat(1, 3), 8, "", ErrNotRepresentable},
+		{"too many digits", big.NewRat(1, 1000000000), 8, "", ErrNotRepresentable},
+		{"half with zero decimals", big.NewRat(1, 2), 0, "", ErrNotRepresentable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RatToRaw(tt.r, tt.decimals)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RatToRaw() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RatToRaw() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("RatToRaw() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRatToRaw_RejectsInvalidInput(t *testing.T) {
+	if _, err := RatToRaw(nil, 8); err == nil {
+		t.Error("RatToRaw(nil) should fail")
+	}
+	if _, err := RatToRaw(big.NewRat(1, 1), -1); err == nil {
+		t.Error("RatToRaw with negative decimals should fail")
+	}
+}
+
+func TestRatArithmeticIsExact(t *testing.T) {
+	// Splitting 1 ZNN three ways leaves a remainder; splitting 0.3 does not.
+	third := new(big.Rat).Quo(RawToRat(big.NewInt(100000000), 8), big.NewRat(3, 1))
+	if _, err := RatToRaw(third, 8); !errors.Is(err, ErrNotRepresentable) {
+		t.Errorf("RatToRaw(1/3 ZNN) error = %v, want ErrNotRepresentable", err)
+	}
+
+	tenth := new(big.Rat).Quo(RawToRat(big.NewInt(30000000), 8), big.NewRat(3, 1))
+	raw, err := RatToRaw(tenth, 8)
+	if err != nil {
+		t.Fatalf("RatToRaw(0.1 ZNN) error = %v", err)
+	}
+	if raw.Int64() != 10000000 {
+		t.Errorf("RatToRaw(0.1 ZNN) = %s, want 10000000", raw)
+	}
+}
+
+// =============================================================================
+// ParseAmountWithUnit Tests
+// =============================================================================
+
+func TestParseAmountWithUnit(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantZts types.ZenonTokenStandard
+		wantErr bool
+	}{
+		{input: "10 ZNN", want: 1000000000, wantZts: types.ZnnTokenStandard},
+		{input: "0.5qsr", want: 50000000, wantZts: types.QsrTokenStandard},
+		{input: " 1.25  Znn ", want: 125000000, wantZts: types.ZnnTokenStandard},
+		{input: ".00000001 QSR", want: 1, wantZts: types.QsrTokenStandard},
+		{input: "100", wantErr: true},
+		{input: "5 FOO", wantErr: true},
+		{input: "ZNN", wantErr: true},
+		{input: ". ZNN", wantErr: true},
+		{input: "-5 ZNN", wantErr: true},
+		{input: "1.123456789 ZNN", wantErr: true},
+		{input: "1.2.3 QSR", wantErr: true},
+		{input: "10 ZNN QSR", wantErr: true},
+		{input: "1e3 ZNN", wantErr: true},
+		{input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, zts, err := ParseAmountWithUnit(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidAmountUnit) {
+					t.Errorf("ParseAmountWithUnit(%q) error = %v, want ErrInvalidAmountUnit", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAmountWithUnit(%q) error = %v", tt.input, err)
+			}
+			if got.Cmp(big.NewInt(tt.want)) != 0 || zts != tt.wantZts {
+				t.Errorf("ParseAmountWithUnit(%q) = %s, %s; want %d, %s", tt.input, got, zts, tt.want, tt.wantZts)
+			}
+		})
+	}
+}
+
+// =============================================================================
+// IsDustAmount Tests
+// =============================================================================
+
+func TestIsDustAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      *big.Int
+		decimals int
+		want     bool
+	}{
+		{"nil", nil, 8, true},
+		{"zero", big.NewInt(0), 8, true},
+		{"negative", big.NewInt(-100000000), 8, true},
+		{"one base unit at 8 decimals", big.NewInt(1), 8, true},
+		{"just below threshold at 8 decimals", big.NewInt(4999), 8, true},
+		{"threshold at 8 decimals", big.NewInt(5000), 8, false},
+		{"one ZNN", big.NewInt(100000000), 8, false},
+		{"just below threshold at 6 decimals", big.NewInt(49), 6, true},
+		{"threshold at 6 decimals", big.NewInt(50), 6, false},
+		{"one base unit at 2 decimals", big.NewInt(1), 2, false},
+		{"one base unit at 0 decimals", big.NewInt(1), 0, false},
+		{"zero at 0 decimals", big.NewInt(0), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDustAmount(tt.raw, tt.decimals); got != tt.want {
+				t.Errorf("IsDustAmount(%v, %d) = %v, want %v", tt.raw, tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+)
+
+// EncodeBech32 encodes data as a bech32 string with the given human-readable
+// prefix.
+//
+// This is the encoding behind types.Address.String (prefix "z", 20 address
+// bytes) and types.ZenonTokenStandard.String (prefix "zts", 10 bytes), so
+// tooling can build either from raw bytes, or encode other payloads the same
+// way.
+//
+// Parameters:
+//   - hrp: Human-readable prefix, such as types.AddressPrefix or
+//     types.ZTSPrefix
+//   - data: Payload bytes
+//
+// Returns the lowercase bech32 string, or an error if hrp is empty or holds
+// characters outside printable ASCII, or the result would exceed the
+// 90-character bech32 limit.
+//
+// Example:
+//
+//	s, err := utils.EncodeBech32(types.ZTSPrefix, types.ZnnTokenStandard.Bytes())
+//	// s == "zts1znnxxxxxxxxxxxxx9z4ulx"
+func EncodeBech32(hrp string, data []byte) (string, error) {
+	fiveBits, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert bech32 data to 5-bit groups: %w", err)
+	}
+	encoded, err := bech32.Encode(hrp, fiveBits)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode bech32: %w", err)
+	}
+	// bech32.Encode does not validate the prefix or the total length; decoding
+	// the result rejects anything DecodeBech32 and types.ParseAddress would.
+	if _, _, err := bech32.Decode(encoded); err != nil {
+		return "", fmt.Errorf("invalid bech32 prefix %q or payload length %d: %w", hrp, len(data), err)
+	}
+	return encoded, nil
+}
+
+// DecodeBech32 decodes a bech32 string into its human-readable prefix and
+// payload bytes.
+//
+// It accepts the same strings as types.ParseAddress and types.ParseZTS, but
+// does not check the prefix or payload length, so callers can inspect any
+// bech32 string.
+//
+// Parameters:
+//   - s: Bech32 string, in all-lowercase or all-uppercase
+//
+// Returns the lowercase prefix and the payload bytes, or an error if s is not
+// valid bech32 (bad checksum, mixed case, invalid characters, or padding).
+//
+// Example:
+//
+//	hrp, data, err := utils.DecodeBech32("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+//	// hrp == "z", len(data) == 20
+func DecodeBech32(s string) (hrp string, data []byte, err error) {
+	hrp, fiveBits, err := bech32.Decode(s)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to decode bech32: %w", err)
+	}
+	data, err = bech32.ConvertBits(fiveBits, 5, 8, false)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to convert bech32 data to bytes: %w", err)
+	}
+	return hrp, data, nil
+}
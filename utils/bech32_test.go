@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func TestBech32RoundTrip(t *testing.T) {
+	address := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	tests := []struct {
+		name string
+		hrp  string
+		data []byte
+		want string
+	}{
+		{"ZNN token standard", types.ZTSPrefix, types.ZnnTokenStandard.Bytes(), types.ZnnTokenStandard.String()},
+		{"QSR token standard", types.ZTSPrefix, types.QsrTokenStandard.Bytes(), types.QsrTokenStandard.String()},
+		{"user address", types.AddressPrefix, address.Bytes(), address.String()},
+		{"embedded contract", types.AddressPrefix, types.PlasmaContract.Bytes(), types.PlasmaContract.String()},
+		{"odd-length payload", "test", []byte{0xde, 0xad, 0xbe}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := EncodeBech32(tt.hrp, tt.data)
+			if err != nil {
+				t.Fatalf("EncodeBech32() error = %v", err)
+			}
+			if tt.want != "" && encoded != tt.want {
+				t.Errorf("EncodeBech32() = %s, want %s", encoded, tt.want)
+			}
+
+			for _, s := range []string{encoded, strings.ToUpper(encoded)} {
+				hrp, data, err := DecodeBech32(s)
+				if err != nil {
+					t.Fatalf("DecodeBech32(%s) error = %v", s, err)
+				}
+				if hrp != tt.hrp || !bytes.Equal(data, tt.data) {
+					t.Errorf("DecodeBech32(%s) = %s, %x; want %s, %x", s, hrp, data, tt.hrp, tt.data)
+				}
+			}
+		})
+	}
+
+	if znn, err := EncodeBech32(types.ZTSPrefix, types.ZnnTokenStandard.Bytes()); err != nil || znn != "zts1znnxxxxxxxxxxxxx9z4ulx" {
+		t.Errorf("ZNN ZTS = %s, %v", znn, err)
+	}
+}
+
+func TestBech32Errors(t *testing.T) {
+	invalid := []string{
+		"",
+		"z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww8", // bad checksum
+		"Z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7", // mixed case
+		"zts1znnxxxxxxxxxxxxx9z4ulb",               // bad checksum
+		"qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7",   // no separator
+	}
+	for _, s := range invalid {
+		if _, _, err := DecodeBech32(s); err == nil {
+			t.Errorf("DecodeBech32(%q) expected an error", s)
+		}
+	}
+
+	if _, err := EncodeBech32("", []byte{1}); err == nil {
+		t.Error("EncodeBech32() with an empty prefix expected an error")
+	}
+	if _, err := EncodeBech32("z", make([]byte, 64)); err == nil {
+		t.Error("EncodeBech32() over 90 characters expected an error")
+	}
+}
@@ -1,8 +1,12 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
+	"unicode/utf8"
 
+	"github.com/0x3639/znn-sdk-go/crypto"
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common/types"
 )
@@ -168,7 +172,7 @@ func GetTransactionBytes(block *nom.AccountBlock) []byte {
 // Example - Predicting a stake ID:
 //
 //	// Create stake template
-//	template := client.StakeApi.Stake(duration)
+//	template, _ := client.StakeApi.Stake(duration, amount)
 //
 //	// Autofill transaction parameters (height, previousHash, momentum, etc.)
 //	// ... populate template fields ...
@@ -215,3 +219,80 @@ func GetPoWData(block *nom.AccountBlock) types.Hash {
 		block.PreviousHash.Bytes(),
 	}))
 }
+
+// =============================================================================
+// Block Verification
+// =============================================================================
+
+// ErrBlockHashMismatch is returned by VerifyAccountBlock when a block's Hash
+// is not the hash of its contents.
+var ErrBlockHashMismatch = errors.New("account block hash mismatch")
+
+// VerifyAccountBlock checks that an account block fetched from a node is
+// internally consistent: its Hash matches its contents and, for user blocks,
+// its signature verifies against a public key that owns Address. Descendant
+// blocks of a contract block are verified the same way.
+//
+// Contract send and receive blocks are created by the protocol rather than
+// signed by a key, so only their hash is checked.
+//
+// Returns nil for a valid block, an error wrapping ErrBlockHashMismatch,
+// crypto.ErrInvalidSignature, or crypto.ErrAddressMismatch, or a size error
+// for a malformed key or signature.
+//
+// Example:
+//
+//	block, _ := client.LedgerApi.GetAccountBlockByHash(hash)
+//	if err := utils.VerifyAccountBlock(&block.AccountBlock); err != nil {
+//	    return fmt.Errorf("node returned a forged block: %w", err)
+//	}
+func VerifyAccountBlock(block *nom.AccountBlock) error {
+	if block == nil {
+		return errors.New("nil account block")
+	}
+	// ComputeHash covers descendant blocks, which GetTransactionHash leaves
+	// out because user templates never have any.
+	if computed := block.ComputeHash(); computed != block.Hash {
+		return fmt.Errorf("%w: block %s hashes to %s", ErrBlockHashMismatch, block.Hash, computed)
+	}
+	if block.BlockType != nom.BlockTypeContractSend && block.BlockType != nom.BlockTypeContractReceive {
+		if _, err := crypto.VerifyWithAddress(block.Signature, block.Hash.Bytes(), block.PublicKey, block.Address); err != nil {
+			return fmt.Errorf("block %s: %w", block.Hash, err)
+		}
+	}
+	for i, descendant := range block.DescendantBlocks {
+		if err := VerifyAccountBlock(descendant); err != nil {
+			return fmt.Errorf("descendant %d of block %s: %w", i, block.Hash, err)
+		}
+	}
+	return nil
+}
+
+// =============================================================================
+// Block Data
+// =============================================================================
+
+// ExtractMemo returns the human-readable memo a wallet wrote into the Data of a
+// plain send, such as the "note" shown in transaction history.
+//
+// There is no memo standard on Zenon, so Data is treated as a memo only when
+// it cannot be anything else: the block is a user send, its ToAddress is not an
+// embedded contract (whose Data is an ABI-encoded call), and Data is non-empty,
+// valid UTF-8.
+//
+// Returns the memo and true, or "" and false when the block carries no memo.
+//
+// Example:
+//
+//	if memo, ok := utils.ExtractMemo(&block.AccountBlock); ok {
+//	    fmt.Printf("Note: %s\n", memo)
+//	}
+func ExtractMemo(block *nom.AccountBlock) (string, bool) {
+	if block == nil || block.BlockType != nom.BlockTypeUserSend || len(block.Data) == 0 {
+		return "", false
+	}
+	if types.IsEmbeddedAddress(block.ToAddress) || !utf8.Valid(block.Data) {
+		return "", false
+	}
+	return string(block.Data), true
+}
@@ -1,10 +1,14 @@
 package utils
 
 import (
+	"bytes"
+	"crypto/ed25519"
 	"encoding/hex"
+	"errors"
 	"math/big"
 	"testing"
 
+	"github.com/0x3639/znn-sdk-go/crypto"
 	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common/types"
 )
@@ -335,3 +339,103 @@ func TestGetTransactionBytesWithData(t *testing.T) {
 		t.Errorf("Data hash mismatch")
 	}
 }
+
+func TestVerifyAccountBlock(t *testing.T) {
+	privateKey := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{9}, 32))
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	address := types.PubKeyToAddress(publicKey)
+
+	signed := func(mutate func(*nom.AccountBlock)) *nom.AccountBlock {
+		block := &nom.AccountBlock{
+			Version:       1,
+			BlockType:     nom.BlockTypeUserSend,
+			Height:        3,
+			Address:       address,
+			ToAddress:     types.PlasmaContract,
+			Amount:        big.NewInt(10),
+			TokenStandard: types.ZnnTokenStandard,
+			PublicKey:     publicKey,
+		}
+		if mutate != nil {
+			mutate(block)
+		}
+		block.Hash = block.ComputeHash()
+		block.Signature = ed25519.Sign(privateKey, block.Hash.Bytes())
+		return block
+	}
+
+	if err := VerifyAccountBlock(signed(nil)); err != nil {
+		t.Errorf("VerifyAccountBlock() on valid block error = %v", err)
+	}
+
+	tampered := signed(nil)
+	tampered.Amount = big.NewInt(11)
+	if err := VerifyAccountBlock(tampered); !errors.Is(err, ErrBlockHashMismatch) {
+		t.Errorf("VerifyAccountBlock() on tampered block error = %v, want ErrBlockHashMismatch", err)
+	}
+
+	otherOwner := signed(func(b *nom.AccountBlock) { b.Address = types.PlasmaContract })
+	if err := VerifyAccountBlock(otherOwner); !errors.Is(err, crypto.ErrAddressMismatch) {
+		t.Errorf("VerifyAccountBlock() with foreign address error = %v, want ErrAddressMismatch", err)
+	}
+
+	contract := &nom.AccountBlock{BlockType: nom.BlockTypeContractReceive, Address: types.PlasmaContract, Height: 1}
+	contract.DescendantBlocks = []*nom.AccountBlock{signed(nil)}
+	contract.Hash = contract.ComputeHash()
+	if err := VerifyAccountBlock(contract); err != nil {
+		t.Errorf("VerifyAccountBlock() on unsigned contract block error = %v", err)
+	}
+	contract.DescendantBlocks[0].Signature[0] ^= 0xFF
+	if err := VerifyAccountBlock(contract); !errors.Is(err, crypto.ErrInvalidSignature) {
+		t.Errorf("VerifyAccountBlock() with bad descendant error = %v, want ErrInvalidSignature", err)
+	}
+
+	if err := VerifyAccountBlock(nil); err == nil {
+		t.Error("VerifyAccountBlock(nil) should fail")
+	}
+}
+
+func TestExtractMemo(t *testing.T) {
+	recipient := types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+
+	tests := []struct {
+		name     string
+		block    *nom.AccountBlock
+		wantMemo string
+		wantOK   bool
+	}{
+		{
+			name:     "memo send",
+			block:    &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, ToAddress: recipient, Data: []byte("invoice #42 – thanks!")},
+			wantMemo: "invoice #42 – thanks!",
+			wantOK:   true,
+		},
+		{
+			name:  "contract call",
+			block: &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, ToAddress: types.PlasmaContract, Data: []byte("Fuse")},
+		},
+		{
+			name:  "binary data",
+			block: &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, ToAddress: recipient, Data: []byte{0x5a, 0xff, 0xfe, 0x00}},
+		},
+		{
+			name:  "no data",
+			block: &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, ToAddress: recipient},
+		},
+		{
+			name:  "receive block",
+			block: &nom.AccountBlock{BlockType: nom.BlockTypeUserReceive, ToAddress: recipient, Data: []byte("hello")},
+		},
+		{
+			name: "nil block",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memo, ok := ExtractMemo(tt.block)
+			if memo != tt.wantMemo || ok != tt.wantOK {
+				t.Errorf("ExtractMemo() = (%q, %v), want (%q, %v)", memo, ok, tt.wantMemo, tt.wantOK)
+			}
+		})
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"math/big"
+
+	"github.com/0x3639/znn-sdk-go/abi"
 )
 
 // =============================================================================
@@ -16,6 +20,25 @@ func Arraycopy(src []byte, startPos int, dest []byte, destPos int, length int) {
 	copy(dest[destPos:destPos+length], src[startPos:startPos+length])
 }
 
+// ReverseBytes returns a new slice holding the bytes of b in reverse order.
+// b itself is left unchanged; use ReverseBytesInPlace to avoid the copy.
+func ReverseBytes(b []byte) []byte {
+	reversed := make([]byte, len(b))
+	for i, v := range b {
+		reversed[len(b)-1-i] = v
+	}
+	return reversed
+}
+
+// ReverseBytesInPlace reverses b in place and returns it. Every slice sharing
+// b's backing array sees the change.
+func ReverseBytesInPlace(b []byte) []byte {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return b
+}
+
 // =============================================================================
 // BigInt Encoding/Decoding
 // =============================================================================
@@ -98,6 +121,40 @@ func BigIntToBytesSigned(b *big.Int, numBytes int) []byte {
 	return bytes
 }
 
+// BigIntToBytes32BE returns x as a 32-byte big-endian word, the layout of an
+// ABI uint256 and of EVM storage values. Use it to build bridge signature
+// payloads that must match what the EVM side computes.
+//
+// Returns an error if x is nil, negative, or does not fit in 256 bits.
+//
+// Example:
+//
+//	word, err := utils.BigIntToBytes32BE(amount)
+//	if err != nil {
+//	    return err
+//	}
+//	payload = append(payload, word[:]...)
+func BigIntToBytes32BE(x *big.Int) ([32]byte, error) {
+	var word [32]byte
+	if x == nil {
+		return word, errors.New("nil integer")
+	}
+	if x.Sign() < 0 {
+		return word, fmt.Errorf("negative integer %s has no uint256 encoding", x)
+	}
+	if x.BitLen() > 256 {
+		return word, fmt.Errorf("integer %s overflows 256 bits", x)
+	}
+	x.FillBytes(word[:])
+	return word, nil
+}
+
+// Bytes32BEToBigInt reads a 32-byte big-endian word as an unsigned integer.
+// It is the inverse of BigIntToBytes32BE.
+func Bytes32BEToBigInt(b [32]byte) *big.Int {
+	return new(big.Int).SetBytes(b[:])
+}
+
 // BytesToBigInt converts bytes to big.Int
 func BytesToBigInt(bb []byte) *big.Int {
 	if len(bb) == 0 {
@@ -184,6 +241,65 @@ func BytesToBase64(bytes []byte) string {
 	return base64.StdEncoding.EncodeToString(bytes)
 }
 
+// =============================================================================
+// String Encoding/Decoding
+// =============================================================================
+
+// EncodeString returns s as raw UTF-8 bytes, with no length prefix or
+// padding. Use it for free-form block data such as a transfer memo; decode
+// the result with DecodeRawString.
+//
+// Embedded contract calls use ABI encoding instead (a 32-byte length word
+// followed by the bytes, zero-padded to a multiple of 32); build those with
+// the contract's abi.Abi and read them with DecodeAbiString.
+//
+// Example:
+//
+//	data := utils.EncodeString("Hello Zenon")
+//	template := client.LedgerApi.SendTemplate(to, types.ZnnTokenStandard, amount, data)
+func EncodeString(s string) []byte {
+	return []byte(s)
+}
+
+// DecodeRawString returns data as a UTF-8 string. It is the inverse of
+// EncodeString and does not interpret length prefixes or padding, so ABI
+// encoded data decodes to garbage; use DecodeAbiString for that.
+func DecodeRawString(data []byte) string {
+	return string(data)
+}
+
+// DecodeAbiString decodes an ABI-encoded string whose 32-byte length word
+// starts at offset.
+//
+// Parameters:
+//   - data: ABI-encoded bytes
+//   - offset: Position of the string's length word. For a string argument of
+//     a contract call, this is 4 (the selector) plus the offset stored in the
+//     argument's head word
+//
+// Returns the string, or an error if the length word is out of range or the
+// data is too short.
+//
+// Example:
+//
+//	// A call whose only argument is a string: the head word at 4 points to
+//	// the length word, 32 bytes after the start of the arguments
+//	name, err := utils.DecodeAbiString(block.Data, 4+32)
+//
+// Note: To decode every argument of an embedded contract call, prefer
+// embedded.DescribeBlock.
+func DecodeAbiString(data []byte, offset int) (string, error) {
+	stringType, err := abi.NewStringType()
+	if err != nil {
+		return "", err
+	}
+	decoded, err := stringType.Decode(data, offset)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode ABI string at offset %d: %w", offset, err)
+	}
+	return decoded.(string), nil
+}
+
 // =============================================================================
 // Hex Encoding
 // =============================================================================
@@ -197,7 +313,61 @@ func BytesToHex(bytes []byte) string {
 // Padding
 // =============================================================================
 
-// LeftPadBytes pads bytes on the left with zeros to reach the specified size
+// ErrPadOverflow is returned by PadBytesLeft and PadBytesRight when the data
+// is longer than the requested size.
+var ErrPadOverflow = errors.New("data longer than padded size")
+
+// PadBytesLeft returns a new slice of size bytes with data right-aligned and
+// zeros in front, the layout of ABI integer words:
+//
+//	PadBytesLeft([]byte{0x01, 0x02}, 4) // 00 00 01 02
+//
+// Returns an error wrapping ErrPadOverflow if data is longer than size; data
+// is never truncated. The result never shares memory with data.
+func PadBytesLeft(data []byte, size int) ([]byte, error) {
+	if len(data) > size {
+		return nil, fmt.Errorf("%w: %d > %d", ErrPadOverflow, len(data), size)
+	}
+	result := make([]byte, size)
+	copy(result[size-len(data):], data)
+	return result, nil
+}
+
+// PadBytesRight returns a new slice of size bytes with data left-aligned and
+// zeros after it, the layout of ABI bytesN and of dynamic bytes and string
+// contents:
+//
+//	PadBytesRight([]byte{0x01, 0x02}, 4) // 01 02 00 00
+//
+// Returns an error wrapping ErrPadOverflow if data is longer than size; data
+// is never truncated. The result never shares memory with data.
+func PadBytesRight(data []byte, size int) ([]byte, error) {
+	if len(data) > size {
+		return nil, fmt.Errorf("%w: %d > %d", ErrPadOverflow, len(data), size)
+	}
+	result := make([]byte, size)
+	copy(result, data)
+	return result, nil
+}
+
+// PadBytes returns a new slice of size bytes holding data left-padded with
+// zeros, like PadBytesLeft. When data is already size bytes or longer, it
+// returns a copy of data unchanged. The result never shares memory with data,
+// so writing to it cannot corrupt the caller's slice.
+//
+// Prefer PadBytesLeft or PadBytesRight when building ABI words, where the
+// direction matters and over-long input is a bug.
+func PadBytes(data []byte, size int) []byte {
+	if len(data) >= size {
+		return append([]byte(nil), data...)
+	}
+	return LeftPadBytes(data, size)
+}
+
+// LeftPadBytes pads bytes on the left with zeros to reach the specified size.
+//
+// When bytes is already at least size long it is returned as is, so the
+// result aliases the input; use PadBytes for a result that never does.
 func LeftPadBytes(bytes []byte, size int) []byte {
 	if len(bytes) >= size {
 		return bytes
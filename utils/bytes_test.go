@@ -2,8 +2,13 @@ package utils
 
 import (
 	"bytes"
+	"errors"
 	"math/big"
+	"strings"
 	"testing"
+
+	"github.com/0x3639/znn-sdk-go/abi"
+	"github.com/0x3639/znn-sdk-go/embedded"
 )
 
 // =============================================================================
@@ -165,6 +170,48 @@ func TestBytesToBigInt(t *testing.T) {
 	}
 }
 
+func TestBigIntToBytes32BE(t *testing.T) {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	tests := []struct {
+		name  string
+		value *big.Int
+		want  [32]byte
+	}{
+		{name: "zero", value: big.NewInt(0)},
+		{name: "one", value: big.NewInt(1), want: [32]byte{31: 0x01}},
+		{name: "two bytes", value: big.NewInt(0x1234), want: [32]byte{30: 0x12, 31: 0x34}},
+		{name: "max uint256", value: maxUint256, want: [32]byte{
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := BigIntToBytes32BE(test.value)
+			if err != nil {
+				t.Fatalf("BigIntToBytes32BE() error = %v", err)
+			}
+			if got != test.want {
+				t.Errorf("BigIntToBytes32BE() = %x, want %x", got, test.want)
+			}
+			encoded, _ := abi.EncodeUintBig(test.value)
+			if !bytes.Equal(got[:], encoded) {
+				t.Errorf("BigIntToBytes32BE() = %x, ABI uint256 = %x", got, encoded)
+			}
+			if back := Bytes32BEToBigInt(got); back.Cmp(test.value) != 0 {
+				t.Errorf("Bytes32BEToBigInt() = %s, want %s", back, test.value)
+			}
+		})
+	}
+
+	for _, bad := range []*big.Int{nil, big.NewInt(-1), new(big.Int).Add(maxUint256, big.NewInt(1))} {
+		if _, err := BigIntToBytes32BE(bad); err == nil {
+			t.Errorf("BigIntToBytes32BE(%v) should fail", bad)
+		}
+	}
+}
+
 // =============================================================================
 // Merge Tests
 // =============================================================================
@@ -277,6 +324,50 @@ func TestBase64ToBytes_Empty(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// String Tests
+// =============================================================================
+
+func TestRawStringRoundTrip(t *testing.T) {
+	for _, original := range []string{"", "Hello Zenon", "zenon ⚡ 日本"} {
+		data := EncodeString(original)
+		if !bytes.Equal(data, []byte(original)) {
+			t.Errorf("EncodeString(%q) = %x, want raw UTF-8", original, data)
+		}
+		if got := DecodeRawString(data); got != original {
+			t.Errorf("DecodeRawString(EncodeString(%q)) = %q", original, got)
+		}
+	}
+}
+
+func TestAbiStringRoundTrip(t *testing.T) {
+	for _, original := range []string{"", "Pillar", strings.Repeat("long-name-", 10)} {
+		data, err := embedded.Pillar.EncodeFunction("Delegate", []interface{}{original})
+		if err != nil {
+			t.Fatalf("Encode(%q) error = %v", original, err)
+		}
+		got, err := DecodeAbiString(data, abi.EncodedSignLength+32)
+		if err != nil {
+			t.Fatalf("DecodeAbiString(%q) error = %v", original, err)
+		}
+		if got != original {
+			t.Errorf("DecodeAbiString() = %q, want %q", got, original)
+		}
+		if raw := DecodeRawString(data); raw == original {
+			t.Errorf("DecodeRawString() of ABI data unexpectedly returned %q", raw)
+		}
+	}
+}
+
+func TestDecodeAbiString_Invalid(t *testing.T) {
+	if _, err := DecodeAbiString(EncodeString("Hello Zenon"), 0); err == nil {
+		t.Error("DecodeAbiString() of raw data should fail")
+	}
+	if _, err := DecodeAbiString(make([]byte, 32), 64); err == nil {
+		t.Error("DecodeAbiString() past the end of the data should fail")
+	}
+}
+
 // =============================================================================
 // Hex Tests
 // =============================================================================
@@ -332,3 +423,117 @@ func TestLeftPadBytes_LargerThanTarget(t *testing.T) {
 		t.Errorf("LeftPadBytes() = %v, want %v", result, input)
 	}
 }
+
+func TestPadBytes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		size  int
+		want  []byte
+	}{
+		{name: "shorter", input: []byte{1, 2, 3}, size: 5, want: []byte{0, 0, 1, 2, 3}},
+		{name: "exact", input: []byte{1, 2, 3}, size: 3, want: []byte{1, 2, 3}},
+		{name: "longer", input: []byte{1, 2, 3}, size: 2, want: []byte{1, 2, 3}},
+		{name: "empty", input: []byte{}, size: 2, want: []byte{0, 0}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original := append([]byte(nil), test.input...)
+			got := PadBytes(test.input, test.size)
+			if !bytes.Equal(got, test.want) {
+				t.Errorf("PadBytes() = %v, want %v", got, test.want)
+			}
+			if len(got) > 0 {
+				got[len(got)-1] = 0xFF
+			}
+			if !bytes.Equal(test.input, original) {
+				t.Errorf("PadBytes() result aliases input: input now %v, want %v", test.input, original)
+			}
+		})
+	}
+}
+
+// =============================================================================
+// Reverse Tests
+// =============================================================================
+
+func TestReverseBytes(t *testing.T) {
+	input := []byte{1, 2, 3, 4}
+	got := ReverseBytes(input)
+
+	if !bytes.Equal(got, []byte{4, 3, 2, 1}) {
+		t.Errorf("ReverseBytes() = %v, want [4 3 2 1]", got)
+	}
+	if !bytes.Equal(input, []byte{1, 2, 3, 4}) {
+		t.Errorf("ReverseBytes() modified its input: %v", input)
+	}
+	got[0] = 0xFF
+	if input[3] != 4 {
+		t.Error("ReverseBytes() result aliases input")
+	}
+	if got := ReverseBytes(nil); len(got) != 0 {
+		t.Errorf("ReverseBytes(nil) = %v, want empty", got)
+	}
+}
+
+func TestReverseBytesInPlace(t *testing.T) {
+	for _, test := range []struct{ input, want []byte }{
+		{[]byte{1, 2, 3, 4}, []byte{4, 3, 2, 1}},
+		{[]byte{1, 2, 3}, []byte{3, 2, 1}},
+		{[]byte{1}, []byte{1}},
+		{[]byte{}, []byte{}},
+	} {
+		got := ReverseBytesInPlace(test.input)
+		if !bytes.Equal(got, test.want) || !bytes.Equal(test.input, test.want) {
+			t.Errorf("ReverseBytesInPlace() = %v, input %v, want %v", got, test.input, test.want)
+		}
+	}
+}
+
+func TestPadBytesLeftRight(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []byte
+		size      int
+		wantLeft  []byte
+		wantRight []byte
+		wantErr   bool
+	}{
+		{name: "under length", input: []byte{1, 2}, size: 4, wantLeft: []byte{0, 0, 1, 2}, wantRight: []byte{1, 2, 0, 0}},
+		{name: "exact length", input: []byte{1, 2, 3}, size: 3, wantLeft: []byte{1, 2, 3}, wantRight: []byte{1, 2, 3}},
+		{name: "over length", input: []byte{1, 2, 3}, size: 2, wantErr: true},
+		{name: "empty", input: nil, size: 2, wantLeft: []byte{0, 0}, wantRight: []byte{0, 0}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original := append([]byte(nil), test.input...)
+
+			left, err := PadBytesLeft(test.input, test.size)
+			if test.wantErr {
+				if !errors.Is(err, ErrPadOverflow) {
+					t.Errorf("PadBytesLeft() error = %v, want ErrPadOverflow", err)
+				}
+			} else if err != nil || !bytes.Equal(left, test.wantLeft) {
+				t.Errorf("PadBytesLeft() = %v, %v, want %v", left, err, test.wantLeft)
+			}
+
+			right, err := PadBytesRight(test.input, test.size)
+			if test.wantErr {
+				if !errors.Is(err, ErrPadOverflow) {
+					t.Errorf("PadBytesRight() error = %v, want ErrPadOverflow", err)
+				}
+			} else if err != nil || !bytes.Equal(right, test.wantRight) {
+				t.Errorf("PadBytesRight() = %v, %v, want %v", right, err, test.wantRight)
+			}
+
+			for _, result := range [][]byte{left, right} {
+				for i := range result {
+					result[i] = 0xFF
+				}
+			}
+			if !bytes.Equal(test.input, original) {
+				t.Errorf("input modified to %v, want %v", test.input, original)
+			}
+		})
+	}
+}
@@ -22,8 +22,9 @@
 //	// Reverse bytes
 //	reversed := utils.ReverseBytes(data)
 //
-//	// Pad bytes to specific length
-//	padded := utils.PadBytes(data, 32)
+//	// Pad to a 32-byte word: numbers left-pad, bytesN right-pad
+//	word, err := utils.PadBytesLeft(data, 32)
+//	word, err = utils.PadBytesRight(data, 32)
 //
 // # Block Utilities
 //
@@ -34,11 +35,28 @@
 //	    // Process hash
 //	}
 //
+// # Address Utilities
+//
+// Helpers for validating, comparing, and displaying addresses:
+//
 //	// Check if address is valid
 //	if utils.IsValidAddress(addressString) {
 //	    // Process address
 //	}
 //
+//	// Store the canonical lowercase form of user input
+//	canonical, err := utils.NormalizeAddress(addressString)
+//
+//	// Compare without worrying about casing
+//	same := utils.AddressEqual(a, b)
+//
+//	// Abbreviate for display: "z1qq…ww7"
+//	label := utils.ShortAddress(addr, 4, 3)
+//
+//	// Build or inspect raw bech32 strings (addresses use "z", tokens "zts")
+//	zts, err := utils.EncodeBech32(types.ZTSPrefix, rawBytes)
+//	hrp, data, err := utils.DecodeBech32("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+//
 // # Common Patterns
 //
 // Working with token amounts:
@@ -59,11 +77,28 @@
 //
 // Utilities for encoding transaction data:
 //
-//	// Encode string data for transaction
+//	// Encode a raw UTF-8 memo for a transaction
 //	data := utils.EncodeString("Hello Zenon")
 //
-//	// Decode data from transaction
-//	message := utils.DecodeString(block.Data)
+//	// Decode the memo from a received block
+//	message := utils.DecodeRawString(block.Data)
+//
+//	// Decode an ABI-encoded string argument of a contract call
+//	name, err := utils.DecodeAbiString(block.Data, 4+32)
+//
+// # Offline Signing
+//
+// Autofilled blocks can be moved to an air-gapped machine and back as JSON:
+//
+//	// Online: after filling height, previous hash, and momentum
+//	data, err := utils.MarshalUnsignedTransaction(block, requiredDifficulty)
+//
+//	// Offline: generate the nonce, hash, and sign, then
+//	signed, err := utils.MarshalSignedTransaction(block)
+//
+//	// Online: validate and publish
+//	block, err := utils.UnmarshalSignedTransaction(signed)
+//	err = client.LedgerApi.PublishRawTransaction(block)
 //
 // For more information, see https://pkg.go.dev/github.com/0x3639/znn-sdk-go/utils
 package utils
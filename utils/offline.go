@@ -0,0 +1,198 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0x3639/znn-sdk-go/crypto"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/pow"
+)
+
+// =============================================================================
+// Offline Transaction Serialization
+// =============================================================================
+
+const (
+	// OfflineTransactionVersion is the version of the offline transaction
+	// envelope written by MarshalUnsignedTransaction and MarshalSignedTransaction.
+	OfflineTransactionVersion = 1
+
+	unsignedTransactionFormat = "zenon-unsigned-transaction"
+	signedTransactionFormat   = "zenon-signed-transaction"
+)
+
+// ErrInvalidOfflineTransaction is wrapped by every error returned when an
+// offline transaction envelope is malformed or fails validation.
+var ErrInvalidOfflineTransaction = errors.New("invalid offline transaction")
+
+// offlineTransaction is the JSON envelope exchanged between the online and
+// offline machines. The block uses go-zenon's account block JSON encoding,
+// which carries every field including Data and Nonce.
+type offlineTransaction struct {
+	Format             string            `json:"format"`
+	Version            int               `json:"version"`
+	Block              *nom.AccountBlock `json:"block"`
+	RequiredDifficulty string            `json:"requiredDifficulty,omitempty"`
+}
+
+// MarshalUnsignedTransaction serializes an autofilled but unsigned transaction
+// for transfer to an offline signer.
+//
+// The online machine fills in everything that needs the network (height,
+// previous hash, momentum acknowledgement, chain identifier, and the PoW
+// difficulty reported by the node); the offline machine then needs nothing
+// else to generate the nonce and sign.
+//
+// Parameters:
+//   - block: Account block with all chain-position fields set
+//   - requiredDifficulty: PoW difficulty the block needs, or nil/zero when the
+//     sender has enough plasma
+//
+// Returns the JSON envelope, or an error wrapping ErrInvalidOfflineTransaction
+// if block is nil or the difficulty is negative.
+//
+// Example (online machine):
+//
+//	required, _ := client.PlasmaApi.GetRequiredPoWForAccountBlock(params)
+//	data, err := utils.MarshalUnsignedTransaction(block, new(big.Int).SetUint64(required.RequiredDifficulty))
+//	os.WriteFile("unsigned.json", data, 0o600)
+func MarshalUnsignedTransaction(block *nom.AccountBlock, requiredDifficulty *big.Int) ([]byte, error) {
+	if block == nil {
+		return nil, fmt.Errorf("%w: nil block", ErrInvalidOfflineTransaction)
+	}
+	if requiredDifficulty == nil {
+		requiredDifficulty = big.NewInt(0)
+	}
+	if requiredDifficulty.Sign() < 0 {
+		return nil, fmt.Errorf("%w: negative required difficulty %s", ErrInvalidOfflineTransaction, requiredDifficulty)
+	}
+	return json.Marshal(offlineTransaction{
+		Format:             unsignedTransactionFormat,
+		Version:            OfflineTransactionVersion,
+		Block:              block,
+		RequiredDifficulty: requiredDifficulty.String(),
+	})
+}
+
+// UnmarshalUnsignedTransaction parses an envelope written by
+// MarshalUnsignedTransaction.
+//
+// Parameters:
+//   - data: JSON envelope produced on the online machine
+//
+// Returns the block and its required PoW difficulty, or an error wrapping
+// ErrInvalidOfflineTransaction if the envelope is malformed, has an unknown
+// version, or is not an unsigned transaction.
+//
+// Example (offline machine):
+//
+//	block, difficulty, err := utils.UnmarshalUnsignedTransaction(data)
+//	if err != nil {
+//	    return err
+//	}
+//	// generate the nonce for difficulty, then set Hash, PublicKey, Signature
+func UnmarshalUnsignedTransaction(data []byte) (*nom.AccountBlock, *big.Int, error) {
+	envelope, err := unmarshalOfflineTransaction(data, unsignedTransactionFormat)
+	if err != nil {
+		return nil, nil, err
+	}
+	difficulty := big.NewInt(0)
+	if envelope.RequiredDifficulty != "" {
+		if _, ok := difficulty.SetString(envelope.RequiredDifficulty, 10); !ok || difficulty.Sign() < 0 {
+			return nil, nil, fmt.Errorf("%w: invalid required difficulty %q", ErrInvalidOfflineTransaction, envelope.RequiredDifficulty)
+		}
+	}
+	return envelope.Block, difficulty, nil
+}
+
+// MarshalSignedTransaction serializes a signed transaction for transfer back
+// to the online machine.
+//
+// The block is validated before it is written: its Hash must equal
+// GetTransactionHash, the signature must verify against PublicKey, PublicKey
+// must belong to the block's Address, and a non-zero Difficulty must be met by
+// the Nonce.
+//
+// Parameters:
+//   - block: Fully signed account block
+//
+// Returns the JSON envelope, or an error wrapping ErrInvalidOfflineTransaction
+// if the block fails validation.
+//
+// Example (offline machine):
+//
+//	signed, err := utils.MarshalSignedTransaction(block)
+//	os.WriteFile("signed.json", signed, 0o600)
+func MarshalSignedTransaction(block *nom.AccountBlock) ([]byte, error) {
+	if err := validateSignedTransaction(block); err != nil {
+		return nil, err
+	}
+	return json.Marshal(offlineTransaction{
+		Format:  signedTransactionFormat,
+		Version: OfflineTransactionVersion,
+		Block:   block,
+	})
+}
+
+// UnmarshalSignedTransaction parses and validates an envelope written by
+// MarshalSignedTransaction, applying the same checks.
+//
+// Parameters:
+//   - data: JSON envelope produced on the offline machine
+//
+// Returns a block ready for LedgerApi.PublishRawTransaction, or an error
+// wrapping ErrInvalidOfflineTransaction.
+//
+// Example (online machine):
+//
+//	block, err := utils.UnmarshalSignedTransaction(data)
+//	if err != nil {
+//	    return err
+//	}
+//	err = client.LedgerApi.PublishRawTransaction(block)
+func UnmarshalSignedTransaction(data []byte) (*nom.AccountBlock, error) {
+	envelope, err := unmarshalOfflineTransaction(data, signedTransactionFormat)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSignedTransaction(envelope.Block); err != nil {
+		return nil, err
+	}
+	return envelope.Block, nil
+}
+
+func unmarshalOfflineTransaction(data []byte, format string) (*offlineTransaction, error) {
+	envelope := new(offlineTransaction)
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOfflineTransaction, err)
+	}
+	if envelope.Format != format {
+		return nil, fmt.Errorf("%w: format %q, want %q", ErrInvalidOfflineTransaction, envelope.Format, format)
+	}
+	if envelope.Version != OfflineTransactionVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidOfflineTransaction, envelope.Version)
+	}
+	if envelope.Block == nil {
+		return nil, fmt.Errorf("%w: missing block", ErrInvalidOfflineTransaction)
+	}
+	return envelope, nil
+}
+
+func validateSignedTransaction(block *nom.AccountBlock) error {
+	if block == nil {
+		return fmt.Errorf("%w: nil block", ErrInvalidOfflineTransaction)
+	}
+	if want := GetTransactionHash(block); block.Hash != want {
+		return fmt.Errorf("%w: hash %s does not match block contents (%s)", ErrInvalidOfflineTransaction, block.Hash, want)
+	}
+	if ok, err := crypto.VerifyWithAddress(block.Signature, block.Hash.Bytes(), block.PublicKey, block.Address); !ok {
+		return fmt.Errorf("%w: %v", ErrInvalidOfflineTransaction, err)
+	}
+	if block.Difficulty > 0 && !pow.CheckPoWNonce(block) {
+		return fmt.Errorf("%w: nonce does not satisfy difficulty %d", ErrInvalidOfflineTransaction, block.Difficulty)
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/crypto"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/pow"
+)
+
+func offlineTestKey() ed25519.PrivateKey {
+	seed := make([]byte, ed25519.SeedSize)
+	for i := range seed {
+		seed[i] = byte(i + 1)
+	}
+	return ed25519.NewKeyFromSeed(seed)
+}
+
+func offlineUnsignedBlock(t *testing.T) *nom.AccountBlock {
+	t.Helper()
+	privateKey := offlineTestKey()
+	publicKey := []byte(privateKey.Public().(ed25519.PublicKey))
+	return &nom.AccountBlock{
+		Version:         1,
+		ChainIdentifier: 1,
+		BlockType:       nom.BlockTypeUserSend,
+		Height:          7,
+		PreviousHash:    types.HexToHashPanic("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+		MomentumAcknowledged: types.HashHeight{
+			Hash:   types.HexToHashPanic("fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"),
+			Height: 1234,
+		},
+		Address:       types.PubKeyToAddress(publicKey),
+		ToAddress:     types.PlasmaContract,
+		Amount:        big.NewInt(150000000),
+		TokenStandard: types.QsrTokenStandard,
+		Data:          []byte{0xde, 0xad, 0xbe, 0xef},
+		FusedPlasma:   0,
+		Difficulty:    1000,
+	}
+}
+
+// signOffline completes block the way an offline signer would.
+func signOffline(t *testing.T, block *nom.AccountBlock) {
+	t.Helper()
+	privateKey := offlineTestKey()
+	if block.Difficulty > 0 {
+		nonce := pow.GetPoWNonce(new(big.Int).SetUint64(block.Difficulty), GetPoWData(block))
+		copy(block.Nonce.Data[:], nonce)
+	}
+	block.PublicKey = []byte(privateKey.Public().(ed25519.PublicKey))
+	block.Hash = GetTransactionHash(block)
+	signature, err := crypto.Sign(block.Hash.Bytes(), privateKey)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	block.Signature = signature
+}
+
+// assertSameOfflineBlock compares every field that is serialized or signed.
+// Empty byte slices may decode as nil, so slices are compared by content.
+func assertSameOfflineBlock(t *testing.T, got, want *nom.AccountBlock) {
+	t.Helper()
+	if !bytes.Equal(GetTransactionBytes(got), GetTransactionBytes(want)) {
+		t.Errorf("transaction bytes differ\n  got:  %+v\n  want: %+v", got, want)
+	}
+	if !bytes.Equal(got.Data, want.Data) {
+		t.Errorf("Data = %x, want %x", got.Data, want.Data)
+	}
+	if got.Nonce != want.Nonce {
+		t.Errorf("Nonce = %x, want %x", got.Nonce.Data, want.Nonce.Data)
+	}
+	if got.Hash != want.Hash {
+		t.Errorf("Hash = %s, want %s", got.Hash, want.Hash)
+	}
+	if !bytes.Equal(got.PublicKey, want.PublicKey) || !bytes.Equal(got.Signature, want.Signature) {
+		t.Errorf("PublicKey/Signature = %x/%x, want %x/%x", got.PublicKey, got.Signature, want.PublicKey, want.Signature)
+	}
+}
+
+func TestUnsignedTransaction_RoundTrip(t *testing.T) {
+	block := offlineUnsignedBlock(t)
+	block.Nonce.Data = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+	data, err := MarshalUnsignedTransaction(block, big.NewInt(1000))
+	if err != nil {
+		t.Fatalf("MarshalUnsignedTransaction() error = %v", err)
+	}
+	decoded, difficulty, err := UnmarshalUnsignedTransaction(data)
+	if err != nil {
+		t.Fatalf("UnmarshalUnsignedTransaction() error = %v", err)
+	}
+	assertSameOfflineBlock(t, decoded, block)
+	if difficulty.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("difficulty = %s, want 1000", difficulty)
+	}
+}
+
+func TestUnsignedTransaction_NilDifficultyIsZero(t *testing.T) {
+	data, err := MarshalUnsignedTransaction(offlineUnsignedBlock(t), nil)
+	if err != nil {
+		t.Fatalf("MarshalUnsignedTransaction() error = %v", err)
+	}
+	_, difficulty, err := UnmarshalUnsignedTransaction(data)
+	if err != nil {
+		t.Fatalf("UnmarshalUnsignedTransaction() error = %v", err)
+	}
+	if difficulty.Sign() != 0 {
+		t.Errorf("difficulty = %s, want 0", difficulty)
+	}
+}
+
+func TestMarshalUnsignedTransaction_Rejects(t *testing.T) {
+	if _, err := MarshalUnsignedTransaction(nil, nil); !errors.Is(err, ErrInvalidOfflineTransaction) {
+		t.Errorf("nil block error = %v, want ErrInvalidOfflineTransaction", err)
+	}
+	if _, err := MarshalUnsignedTransaction(offlineUnsignedBlock(t), big.NewInt(-1)); !errors.Is(err, ErrInvalidOfflineTransaction) {
+		t.Errorf("negative difficulty error = %v, want ErrInvalidOfflineTransaction", err)
+	}
+}
+
+func TestSignedTransaction_RoundTrip(t *testing.T) {
+	data, err := MarshalUnsignedTransaction(offlineUnsignedBlock(t), big.NewInt(1000))
+	if err != nil {
+		t.Fatalf("MarshalUnsignedTransaction() error = %v", err)
+	}
+	block, _, err := UnmarshalUnsignedTransaction(data)
+	if err != nil {
+		t.Fatalf("UnmarshalUnsignedTransaction() error = %v", err)
+	}
+	signOffline(t, block)
+
+	signed, err := MarshalSignedTransaction(block)
+	if err != nil {
+		t.Fatalf("MarshalSignedTransaction() error = %v", err)
+	}
+	decoded, err := UnmarshalSignedTransaction(signed)
+	if err != nil {
+		t.Fatalf("UnmarshalSignedTransaction() error = %v", err)
+	}
+	assertSameOfflineBlock(t, decoded, block)
+	if !pow.CheckPoWNonce(decoded) {
+		t.Error("decoded nonce does not satisfy difficulty")
+	}
+}
+
+func TestMarshalSignedTransaction_Rejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*nom.AccountBlock)
+		want   string
+	}{
+		{"unsigned", func(b *nom.AccountBlock) { b.Signature = nil }, "signature size"},
+		{"tampered amount", func(b *nom.AccountBlock) { b.Amount = big.NewInt(1) }, "hash"},
+		{"tampered data", func(b *nom.AccountBlock) { b.Data = []byte{0x00} }, "hash"},
+		{"bad signature", func(b *nom.AccountBlock) { b.Signature[0] ^= 0xff }, "signature does not verify"},
+		{"bad nonce", func(b *nom.AccountBlock) {
+			b.Difficulty = 1 << 40
+			b.Hash = GetTransactionHash(b)
+			b.Signature, _ = crypto.Sign(b.Hash.Bytes(), offlineTestKey())
+		}, "nonce"},
+		{"foreign address", func(b *nom.AccountBlock) {
+			b.Address = types.PlasmaContract
+			b.Hash = GetTransactionHash(b)
+			b.Signature, _ = crypto.Sign(b.Hash.Bytes(), offlineTestKey())
+		}, "public key does not match address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := offlineUnsignedBlock(t)
+			signOffline(t, block)
+			tt.mutate(block)
+
+			_, err := MarshalSignedTransaction(block)
+			if !errors.Is(err, ErrInvalidOfflineTransaction) {
+				t.Fatalf("error = %v, want ErrInvalidOfflineTransaction", err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %q, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalOfflineTransaction_Rejects(t *testing.T) {
+	unsigned, err := MarshalUnsignedTransaction(offlineUnsignedBlock(t), nil)
+	if err != nil {
+		t.Fatalf("MarshalUnsignedTransaction() error = %v", err)
+	}
+	if _, err := UnmarshalSignedTransaction(unsigned); !errors.Is(err, ErrInvalidOfflineTransaction) {
+		t.Errorf("unsigned envelope as signed: error = %v", err)
+	}
+
+	tests := map[string]string{
+		"not json":        `{`,
+		"wrong version":   `{"format":"zenon-unsigned-transaction","version":2,"block":{}}`,
+		"missing block":   `{"format":"zenon-unsigned-transaction","version":1}`,
+		"bad difficulty":  `{"format":"zenon-unsigned-transaction","version":1,"block":{},"requiredDifficulty":"-5"}`,
+		"signed envelope": `{"format":"zenon-signed-transaction","version":1,"block":{}}`,
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := UnmarshalUnsignedTransaction([]byte(input)); !errors.Is(err, ErrInvalidOfflineTransaction) {
+				t.Errorf("error = %v, want ErrInvalidOfflineTransaction", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+)
+
+// =============================================================================
+// QR Transaction Payloads
+// =============================================================================
+
+const (
+	// QRPayloadVersion is the format version written by TransactionToQRPayload.
+	QRPayloadVersion = 1
+
+	qrPayloadScheme = "znn-tx-v"
+)
+
+// TransactionToQRPayload encodes an account block as a compact, text-safe
+// string suitable for a QR code.
+//
+// The payload is "znn-tx-v1:" followed by the unpadded base64url encoding of
+// the block's JSON. The version prefix lets readers reject formats they do not
+// understand instead of misparsing them. The block may be unsigned (for a
+// mobile signer to scan) or signed (to scan back for publishing); no
+// validation is performed here.
+//
+// Parameters:
+//   - block: Account block to encode
+//
+// Returns the payload string, or an error wrapping ErrInvalidOfflineTransaction
+// if block is nil.
+//
+// Example:
+//
+//	payload, err := utils.TransactionToQRPayload(block)
+//	if err != nil {
+//	    return err
+//	}
+//	// render payload with any QR code library
+//
+// Note: A plain send with a short memo encodes to roughly 1300 characters,
+// within the byte-mode capacity of a version 40 QR code at medium error
+// correction (2331 bytes). Large Data fields can exceed it.
+func TransactionToQRPayload(block *nom.AccountBlock) (string, error) {
+	if block == nil {
+		return "", fmt.Errorf("%w: nil block", ErrInvalidOfflineTransaction)
+	}
+	data, err := json.Marshal(block)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidOfflineTransaction, err)
+	}
+	return qrPayloadScheme + strconv.Itoa(QRPayloadVersion) + ":" + base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// TransactionFromQRPayload decodes a payload produced by TransactionToQRPayload.
+//
+// Parameters:
+//   - payload: Scanned QR text; surrounding whitespace is ignored
+//
+// Returns the decoded block, or an error wrapping ErrInvalidOfflineTransaction
+// if the prefix is missing, the version is unsupported, or the body does not
+// decode.
+//
+// Example:
+//
+//	block, err := utils.TransactionFromQRPayload(scanned)
+//	if err != nil {
+//	    return err
+//	}
+//	signed, err := utils.MarshalSignedTransaction(block)
+func TransactionFromQRPayload(payload string) (*nom.AccountBlock, error) {
+	payload = strings.TrimSpace(payload)
+	if !strings.HasPrefix(payload, qrPayloadScheme) {
+		return nil, fmt.Errorf("%w: missing %q prefix", ErrInvalidOfflineTransaction, qrPayloadScheme)
+	}
+	versionText, body, ok := strings.Cut(strings.TrimPrefix(payload, qrPayloadScheme), ":")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed QR payload header", ErrInvalidOfflineTransaction)
+	}
+	if version, err := strconv.Atoi(versionText); err != nil || version != QRPayloadVersion {
+		return nil, fmt.Errorf("%w: unsupported QR payload version %q", ErrInvalidOfflineTransaction, versionText)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOfflineTransaction, err)
+	}
+	block := new(nom.AccountBlock)
+	if err := json.Unmarshal(data, block); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOfflineTransaction, err)
+	}
+	return block, nil
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func TestQRPayload_RoundTrip(t *testing.T) {
+	block := offlineUnsignedBlock(t)
+	signOffline(t, block)
+
+	payload, err := TransactionToQRPayload(block)
+	if err != nil {
+		t.Fatalf("TransactionToQRPayload() error = %v", err)
+	}
+	if !strings.HasPrefix(payload, "znn-tx-v1:") {
+		t.Errorf("payload prefix = %q, want znn-tx-v1:", payload[:10])
+	}
+	if strings.ContainsAny(payload[len("znn-tx-v1:"):], "+/=") {
+		t.Error("payload body is not unpadded base64url")
+	}
+
+	decoded, err := TransactionFromQRPayload(" " + payload + "\n")
+	if err != nil {
+		t.Fatalf("TransactionFromQRPayload() error = %v", err)
+	}
+	assertSameOfflineBlock(t, decoded, block)
+}
+
+// TestQRPayload_Size keeps a typical signed send within the byte-mode capacity
+// of a version 40 QR code at medium error correction.
+func TestQRPayload_Size(t *testing.T) {
+	const qrVersion40MediumBytes = 2331
+
+	block := offlineUnsignedBlock(t)
+	block.ToAddress = types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7")
+	block.TokenStandard = types.ZnnTokenStandard
+	block.Amount = new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(100_000_000))
+	block.Data = []byte("invoice 2026-10-16")
+	signOffline(t, block)
+
+	payload, err := TransactionToQRPayload(block)
+	if err != nil {
+		t.Fatalf("TransactionToQRPayload() error = %v", err)
+	}
+	if len(payload) > qrVersion40MediumBytes {
+		t.Errorf("len(payload) = %d, want <= %d", len(payload), qrVersion40MediumBytes)
+	}
+}
+
+func TestTransactionToQRPayload_NilBlock(t *testing.T) {
+	if _, err := TransactionToQRPayload(nil); !errors.Is(err, ErrInvalidOfflineTransaction) {
+		t.Errorf("error = %v, want ErrInvalidOfflineTransaction", err)
+	}
+}
+
+func TestTransactionFromQRPayload_Rejects(t *testing.T) {
+	tests := map[string]string{
+		"no prefix":      "eyJ9",
+		"no separator":   "znn-tx-v1",
+		"future version": "znn-tx-v2:e30",
+		"bad version":    "znn-tx-vx:e30",
+		"bad base64":     "znn-tx-v1:!!!",
+		"bad json":       "znn-tx-v1:eyI",
+	}
+	for name, payload := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := TransactionFromQRPayload(payload); !errors.Is(err, ErrInvalidOfflineTransaction) {
+				t.Errorf("error = %v, want ErrInvalidOfflineTransaction", err)
+			}
+		})
+	}
+}
@@ -15,6 +15,9 @@ const (
 
 	// DerivationPath is the base BIP44 path for Zenon wallets
 	DerivationPath = "m/44'/" + CoinType + "'"
+
+	// ZenonCoinType is CoinType as a number, for GetKeyPairForCoin
+	ZenonCoinType = 73404
 )
 
 // GetDerivationAccount returns the BIP44 derivation path for a given account index
@@ -22,3 +25,10 @@ const (
 func GetDerivationAccount(account int) string {
 	return fmt.Sprintf("%s/%d'", DerivationPath, account)
 }
+
+// GetDerivationAccountForCoin returns the BIP44 derivation path for a given
+// coin type and account index.
+// For example: coin type 148, account 0 returns "m/44'/148'/0'"
+func GetDerivationAccountForCoin(coinType, account int) string {
+	return fmt.Sprintf("m/44'/%d'/%d'", coinType, account)
+}
@@ -41,3 +41,12 @@ func TestGetDerivationAccount_Large(t *testing.T) {
 		t.Errorf("GetDerivationAccount(100) = %s, want %s", path, expected)
 	}
 }
+
+func TestGetDerivationAccountForCoin(t *testing.T) {
+	if got := GetDerivationAccountForCoin(148, 2); got != "m/44'/148'/2'" {
+		t.Errorf("GetDerivationAccountForCoin(148, 2) = %q", got)
+	}
+	if got := GetDerivationAccountForCoin(ZenonCoinType, 7); got != GetDerivationAccount(7) {
+		t.Errorf("GetDerivationAccountForCoin(ZenonCoinType, 7) = %q, want %q", got, GetDerivationAccount(7))
+	}
+}
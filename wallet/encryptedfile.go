@@ -1,9 +1,11 @@
 package wallet
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -36,12 +38,19 @@ type EncryptedFile struct {
 // Argon2Params controls key derivation. CipherData contains AES-GCM ciphertext
 // followed by its authentication tag. Nonce and CipherData are hexadecimal
 // strings with an optional 0x prefix.
+//
+// Checksum is an optional SHA-256 digest of the salt, nonce, and cipher data.
+// Because it does not depend on the password, Decrypt can check it first and
+// report damaged files as [ErrCorruptedKeystore] rather than
+// [ErrIncorrectPassword]. It detects accidental corruption only; tampering is
+// still caught by the AES-GCM tag. Files written by other SDKs omit it.
 type CryptoParams struct {
 	Argon2Params *Argon2Params `json:"argon2Params"`
-	CipherData   string        `json:"cipherData"` // Hex encoded
-	CipherName   string        `json:"cipherName"` // "aes-256-gcm"
-	Kdf          string        `json:"kdf"`        // "argon2.IDKey"
-	Nonce        string        `json:"nonce"`      // Hex encoded
+	CipherData   string        `json:"cipherData"`         // Hex encoded
+	CipherName   string        `json:"cipherName"`         // "aes-256-gcm"
+	Kdf          string        `json:"kdf"`                // "argon2.IDKey"
+	Nonce        string        `json:"nonce"`              // Hex encoded
+	Checksum     string        `json:"checksum,omitempty"` // Hex encoded SHA-256
 }
 
 // Argon2Params contains the Argon2id key-derivation parameters persisted in a
@@ -58,6 +67,45 @@ type Argon2Params struct {
 	Parallelism uint8  `json:"parallelism,omitempty"`
 }
 
+// EncryptionParams selects the Argon2id cost used when encrypting a key file.
+//
+// Zero fields use the stable Zenon defaults from
+// [crypto.DefaultArgon2Parameters], so a partially filled value only changes
+// the costs it sets. The derived key length is always 32 bytes for AES-256.
+// Whatever values are used are persisted in the key file, and decryption reads
+// them back, so files encrypted with custom costs need no extra configuration
+// to open.
+//
+// Example:
+//
+//	// Lighter settings for a memory-constrained device.
+//	params := &wallet.EncryptionParams{MemoryKiB: 16 * 1024, Iterations: 3}
+//	file, err := keystore.ToEncryptedFile(password, nil, params)
+type EncryptionParams struct {
+	Iterations  uint32 // Argon2 time cost
+	MemoryKiB   uint32 // Argon2 memory cost in KiB
+	Parallelism uint8  // Argon2 lanes
+}
+
+// argon2Parameters resolves params against the stable defaults. A nil
+// receiver yields the defaults unchanged.
+func (params *EncryptionParams) argon2Parameters() crypto.Argon2Parameters {
+	resolved := crypto.DefaultArgon2Parameters()
+	if params == nil {
+		return resolved
+	}
+	if params.Iterations != 0 {
+		resolved.Iterations = params.Iterations
+	}
+	if params.MemoryKiB != 0 {
+		resolved.Memory = params.MemoryKiB
+	}
+	if params.Parallelism != 0 {
+		resolved.Parallelism = params.Parallelism
+	}
+	return resolved
+}
+
 // Encrypt creates a version-one encrypted key file from plaintext data.
 //
 // Parameters:
@@ -82,6 +130,25 @@ type Argon2Params struct {
 // Zenon associated data. Prefer [KeyStore.ToEncryptedFile] for wallet entropy,
 // because it also records the derived base address.
 func Encrypt(data []byte, password string, metadata map[string]interface{}) (*EncryptedFile, error) {
+	return EncryptWithParams(data, password, metadata, nil)
+}
+
+// EncryptWithParams is like [Encrypt] but derives the AES key with the given
+// Argon2id costs, which are persisted in the returned file.
+//
+// Parameters:
+//   - data: Plaintext bytes to authenticate and encrypt.
+//   - password: UTF-8 password used by Argon2id.
+//   - metadata: Optional top-level key-file metadata, retained as in [Encrypt].
+//   - params: Argon2id costs; nil uses the stable defaults.
+//
+// Example:
+//
+//	file, err := EncryptWithParams(entropy, password, metadata, &EncryptionParams{Iterations: 4})
+//
+// Security Note: Lowering the memory or time cost below the defaults makes
+// offline password guessing cheaper. Only do so where the defaults cannot run.
+func EncryptWithParams(data []byte, password string, metadata map[string]interface{}, encryption *EncryptionParams) (*EncryptedFile, error) {
 	timestamp := time.Now().Unix()
 
 	// Generate random salt (16 bytes)
@@ -97,7 +164,7 @@ func Encrypt(data []byte, password string, metadata map[string]interface{}) (*En
 	}
 
 	// Derive key using Argon2
-	params := crypto.DefaultArgon2Parameters()
+	params := encryption.argon2Parameters()
 	key := crypto.DeriveKey([]byte(password), salt, params)
 
 	// Create AES-256-GCM cipher
@@ -133,6 +200,7 @@ func Encrypt(data []byte, password string, metadata map[string]interface{}) (*En
 			CipherName: "aes-256-gcm",
 			Kdf:        "argon2.IDKey",
 			Nonce:      "0x" + hex.EncodeToString(nonce),
+			Checksum:   "0x" + hex.EncodeToString(payloadChecksum(salt, nonce, ciphertext)),
 		},
 	}
 
@@ -149,15 +217,21 @@ func Encrypt(data []byte, password string, metadata map[string]interface{}) (*En
 // Parameters:
 //   - password: UTF-8 password used to derive the AES key.
 //
-// Decrypt returns the plaintext bytes. It returns [ErrIncorrectPassword] when
-// AES-GCM authentication fails and a descriptive error for malformed or
-// unsupported key-file parameters.
+// Decrypt returns the plaintext bytes. It returns [ErrCorruptedKeystore] when
+// the salt, nonce, or cipher data cannot be decoded or do not match the stored
+// checksum, [ErrIncorrectPassword] when AES-GCM authentication fails, and a
+// descriptive error for unsupported key-file parameters. Files without a
+// checksum cannot tell corruption from a wrong password; both are reported as
+// [ErrIncorrectPassword].
 //
 // Example:
 //
 //	plaintext, err := file.Decrypt("correct horse battery staple")
-//	if errors.Is(err, ErrIncorrectPassword) {
-//		log.Print("password or key-file authentication is invalid")
+//	switch {
+//	case errors.Is(err, ErrCorruptedKeystore):
+//		log.Print("key file is damaged; restore it from a backup")
+//	case errors.Is(err, ErrIncorrectPassword):
+//		log.Print("wrong password")
 //	}
 //
 // Security Note: Callers are responsible for clearing plaintext when it is no
@@ -171,6 +245,9 @@ func (ef *EncryptedFile) Decrypt(password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := ef.verifyChecksum(salt, nonce, ciphertext); err != nil {
+		return nil, err
+	}
 
 	// Derive key using the persisted parameters, falling back to all stable
 	// defaults for legacy salt-only files.
@@ -205,25 +282,50 @@ func (ef *EncryptedFile) validateEncryptionEnvelope() error {
 func (ef *EncryptedFile) decodeEncryptionPayload() ([]byte, []byte, []byte, error) {
 	salt, err := hexToBytes(ef.Crypto.Argon2Params.Salt)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("invalid Argon2 salt: %w", err)
+		return nil, nil, nil, fmt.Errorf("%w: invalid Argon2 salt: %v", ErrCorruptedKeystore, err)
 	}
 	if len(salt) != 16 {
-		return nil, nil, nil, fmt.Errorf("invalid Argon2 salt length: got %d, want 16", len(salt))
+		return nil, nil, nil, fmt.Errorf("%w: invalid Argon2 salt length: got %d, want 16", ErrCorruptedKeystore, len(salt))
 	}
 	nonce, err := hexToBytes(ef.Crypto.Nonce)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("invalid AES-GCM nonce: %w", err)
+		return nil, nil, nil, fmt.Errorf("%w: invalid AES-GCM nonce: %v", ErrCorruptedKeystore, err)
 	}
 	if len(nonce) != 12 {
-		return nil, nil, nil, fmt.Errorf("invalid AES-GCM nonce length: got %d, want 12", len(nonce))
+		return nil, nil, nil, fmt.Errorf("%w: invalid AES-GCM nonce length: got %d, want 12", ErrCorruptedKeystore, len(nonce))
 	}
 	ciphertext, err := hexToBytes(ef.Crypto.CipherData)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("invalid cipher data: %w", err)
+		return nil, nil, nil, fmt.Errorf("%w: invalid cipher data: %v", ErrCorruptedKeystore, err)
 	}
 	return salt, nonce, ciphertext, nil
 }
 
+// verifyChecksum compares the decoded payload with the stored checksum. Files
+// without a checksum pass unchecked.
+func (ef *EncryptedFile) verifyChecksum(salt, nonce, ciphertext []byte) error {
+	if ef.Crypto.Checksum == "" {
+		return nil
+	}
+	stored, err := hexToBytes(ef.Crypto.Checksum)
+	if err != nil {
+		return fmt.Errorf("%w: invalid checksum: %v", ErrCorruptedKeystore, err)
+	}
+	if !bytes.Equal(stored, payloadChecksum(salt, nonce, ciphertext)) {
+		return fmt.Errorf("%w: checksum mismatch", ErrCorruptedKeystore)
+	}
+	return nil
+}
+
+// payloadChecksum returns SHA-256(salt || nonce || ciphertext).
+func payloadChecksum(salt, nonce, ciphertext []byte) []byte {
+	digest := sha256.New()
+	digest.Write(salt)
+	digest.Write(nonce)
+	digest.Write(ciphertext)
+	return digest.Sum(nil)
+}
+
 func decryptAESGCM(key, nonce, ciphertext []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
@@ -383,6 +485,43 @@ func FromJSON(data []byte) (*EncryptedFile, error) {
 	return ef, nil
 }
 
+// PeekKeyfile returns the plaintext metadata of a raw key file, such as
+// baseAddress, walletType, and any labels, without the password and without a
+// KeyStoreManager.
+//
+// The result never includes the crypto section, so nothing in it helps
+// recover the wallet. Metadata is not covered by the payload's authentication
+// tag: treat it as a hint until the file has been decrypted.
+//
+// Parameters:
+//   - data: Contents of a key file, for example read from a backup location
+//
+// Returns the metadata (empty if the file has none), or an error if data is
+// not valid JSON, or an error wrapping ErrInvalidKeyStore if it has no crypto
+// section.
+//
+// Example:
+//
+//	data, err := os.ReadFile("/backups/main-wallet")
+//	if err != nil {
+//	    return err
+//	}
+//	info, err := wallet.PeekKeyfile(data)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(info[wallet.BaseAddressKey])
+func PeekKeyfile(data []byte) (map[string]interface{}, error) {
+	ef, err := FromJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse keyfile: %w", err)
+	}
+	if ef.Crypto == nil {
+		return nil, fmt.Errorf("%w: missing crypto section", ErrInvalidKeyStore)
+	}
+	return ef.Metadata, nil
+}
+
 // hexToBytes converts a hex string (with or without 0x prefix) to bytes
 func hexToBytes(s string) ([]byte, error) {
 	// Remove 0x prefix if present
@@ -2,6 +2,7 @@ package wallet
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"strings"
@@ -180,6 +181,76 @@ func TestDecrypt_IncorrectPassword(t *testing.T) {
 	}
 }
 
+// flipHexByte inverts the last byte of a 0x-prefixed hex string.
+func flipHexByte(t *testing.T, value string) string {
+	t.Helper()
+	decoded, err := hexToBytes(value)
+	if err != nil || len(decoded) == 0 {
+		t.Fatalf("hexToBytes(%q) = %x, %v", value, decoded, err)
+	}
+	decoded[len(decoded)-1] ^= 0xff
+	return "0x" + hex.EncodeToString(decoded)
+}
+
+func TestDecrypt_CorruptedKeystore(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*EncryptedFile)
+	}{
+		{"ciphertext byte", func(ef *EncryptedFile) { ef.Crypto.CipherData = flipHexByte(t, ef.Crypto.CipherData) }},
+		{"checksum byte", func(ef *EncryptedFile) { ef.Crypto.Checksum = flipHexByte(t, ef.Crypto.Checksum) }},
+		{"nonce byte", func(ef *EncryptedFile) { ef.Crypto.Nonce = flipHexByte(t, ef.Crypto.Nonce) }},
+		{"salt byte", func(ef *EncryptedFile) { ef.Crypto.Argon2Params.Salt = flipHexByte(t, ef.Crypto.Argon2Params.Salt) }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ef, err := Encrypt([]byte("secret data"), "password123", nil)
+			if err != nil {
+				t.Fatalf("Encrypt() error = %v", err)
+			}
+			test.mutate(ef)
+
+			_, err = ef.Decrypt("password123")
+			if !errors.Is(err, ErrCorruptedKeystore) {
+				t.Fatalf("Decrypt() error = %v, want ErrCorruptedKeystore", err)
+			}
+			if errors.Is(err, ErrIncorrectPassword) {
+				t.Fatalf("Decrypt() error = %v also matches ErrIncorrectPassword", err)
+			}
+		})
+	}
+}
+
+func TestDecrypt_WrongPasswordIsNotCorruption(t *testing.T) {
+	ef, err := Encrypt([]byte("secret data"), "password123", nil)
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	if ef.Crypto.Checksum == "" {
+		t.Fatal("Encrypt() did not write a checksum")
+	}
+	_, err = ef.Decrypt("wrongpassword")
+	if !errors.Is(err, ErrIncorrectPassword) || errors.Is(err, ErrCorruptedKeystore) {
+		t.Fatalf("Decrypt() error = %v, want only ErrIncorrectPassword", err)
+	}
+}
+
+func TestDecrypt_WithoutChecksumFallsBackToIncorrectPassword(t *testing.T) {
+	ef, err := Encrypt([]byte("secret data"), "password123", nil)
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	ef.Crypto.Checksum = ""
+	if _, err := ef.Decrypt("password123"); err != nil {
+		t.Fatalf("Decrypt() without checksum error = %v", err)
+	}
+
+	ef.Crypto.CipherData = flipHexByte(t, ef.Crypto.CipherData)
+	if _, err := ef.Decrypt("password123"); !errors.Is(err, ErrIncorrectPassword) {
+		t.Fatalf("Decrypt() error = %v, want ErrIncorrectPassword for a file without checksum", err)
+	}
+}
+
 func TestDecrypt_EmptyPassword(t *testing.T) {
 	data := []byte("secret data")
 	password := ""
@@ -554,3 +625,54 @@ func BenchmarkFromJSON(b *testing.B) {
 		FromJSON(jsonData)
 	}
 }
+
+// =============================================================================
+// PeekKeyfile Tests
+// =============================================================================
+
+func TestPeekKeyfile_ReadsBaseAddressWithoutPassword(t *testing.T) {
+	ks, err := NewKeyStoreFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	if err != nil {
+		t.Fatalf("NewKeyStoreFromMnemonic() error = %v", err)
+	}
+	file, err := ks.ToEncryptedFile("password", map[string]interface{}{"label": "Savings"})
+	if err != nil {
+		t.Fatalf("ToEncryptedFile() error = %v", err)
+	}
+	data, err := file.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+
+	info, err := PeekKeyfile(data)
+	if err != nil {
+		t.Fatalf("PeekKeyfile() error = %v", err)
+	}
+	baseAddress, err := ks.GetBaseAddress()
+	if err != nil {
+		t.Fatalf("GetBaseAddress() error = %v", err)
+	}
+	if info[BaseAddressKey] != baseAddress.String() {
+		t.Errorf("baseAddress = %v, want %s", info[BaseAddressKey], baseAddress)
+	}
+	if info[WalletTypeKey] != KeyStoreWalletType {
+		t.Errorf("walletType = %v, want %s", info[WalletTypeKey], KeyStoreWalletType)
+	}
+	if info["label"] != "Savings" {
+		t.Errorf("label = %v, want Savings", info["label"])
+	}
+	for _, key := range []string{"crypto", "timestamp", "version"} {
+		if _, ok := info[key]; ok {
+			t.Errorf("PeekKeyfile() exposes %q", key)
+		}
+	}
+}
+
+func TestPeekKeyfile_Rejects(t *testing.T) {
+	if _, err := PeekKeyfile([]byte("{not json")); err == nil {
+		t.Error("PeekKeyfile() should fail on malformed JSON")
+	}
+	if _, err := PeekKeyfile([]byte(`{"baseAddress":"z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7"}`)); !errors.Is(err, ErrInvalidKeyStore) {
+		t.Errorf("PeekKeyfile() without crypto error = %v, want ErrInvalidKeyStore", err)
+	}
+}
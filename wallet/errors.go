@@ -1,6 +1,9 @@
 package wallet
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // WalletError represents a wallet-related error
 type WalletError struct {
@@ -28,4 +31,15 @@ var (
 	ErrInvalidPrivateKey    = errors.New("invalid private key")
 	ErrAddressNotFound      = errors.New("address not found in wallet")
 	ErrKeystoreNotFound     = errors.New("keystore not found")
+	ErrCorruptedKeystore    = errors.New("keystore is corrupted")
+	ErrInvalidDerivation    = errors.New("invalid derivation index")
+	ErrReservedMetadataKey  = errors.New("metadata key is reserved")
+)
+
+// Mnemonic validation errors returned by ValidateMnemonicPhrase. Each wraps
+// ErrInvalidMnemonic.
+var (
+	ErrInvalidMnemonicWordCount = fmt.Errorf("%w: word count must be 12, 15, 18, 21, or 24", ErrInvalidMnemonic)
+	ErrInvalidMnemonicWord      = fmt.Errorf("%w: word not in BIP39 wordlist", ErrInvalidMnemonic)
+	ErrInvalidMnemonicChecksum  = fmt.Errorf("%w: checksum mismatch", ErrInvalidMnemonic)
 )
@@ -191,3 +191,70 @@ func TestToEncryptedFileOverridesCallerBaseAddressWithoutMutation(t *testing.T)
 		t.Fatalf("file baseAddress = %v, want %s", got, address)
 	}
 }
+
+func TestToEncryptedFileWithCustomEncryptionParams(t *testing.T) {
+	store, err := NewKeyStoreFromEntropy(bytes.Repeat([]byte{0x5a}, 32))
+	if err != nil {
+		t.Fatalf("NewKeyStoreFromEntropy() error = %v", err)
+	}
+	params := &EncryptionParams{Iterations: 2, MemoryKiB: 8 * 1024, Parallelism: 2}
+	file, err := store.ToEncryptedFile("password", nil, params)
+	if err != nil {
+		t.Fatalf("ToEncryptedFile() error = %v", err)
+	}
+	stored := file.Crypto.Argon2Params
+	if stored.TimeCost != 2 || stored.MemoryCost != 8*1024 || stored.Parallelism != 2 || stored.HashLength != 32 {
+		t.Fatalf("persisted Argon2 parameters = %+v", stored)
+	}
+
+	encoded, err := file.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	parsed, err := FromJSON(encoded)
+	if err != nil {
+		t.Fatalf("FromJSON() error = %v", err)
+	}
+	restored, err := FromEncryptedFile(parsed, "password")
+	if err != nil {
+		t.Fatalf("FromEncryptedFile() error = %v", err)
+	}
+	if !bytes.Equal(restored.Entropy, store.Entropy) {
+		t.Fatalf("restored entropy = %x, want %x", restored.Entropy, store.Entropy)
+	}
+	if !parsed.NeedsUpgrade() {
+		t.Fatal("NeedsUpgrade() = false for non-default parameters")
+	}
+	if _, err := FromEncryptedFile(parsed, "wrong"); !errors.Is(err, ErrIncorrectPassword) {
+		t.Fatalf("FromEncryptedFile(wrong password) error = %v, want ErrIncorrectPassword", err)
+	}
+}
+
+func TestToEncryptedFileEncryptionParamsDefaults(t *testing.T) {
+	store, err := NewKeyStoreFromEntropy(bytes.Repeat([]byte{0x6b}, 16))
+	if err != nil {
+		t.Fatalf("NewKeyStoreFromEntropy() error = %v", err)
+	}
+	defaults := sdkcrypto.DefaultArgon2Parameters()
+
+	file, err := store.ToEncryptedFile("password", nil, nil)
+	if err != nil {
+		t.Fatalf("ToEncryptedFile(nil params) error = %v", err)
+	}
+	if file.NeedsUpgrade() {
+		t.Fatalf("nil params persisted %+v, want defaults", file.Crypto.Argon2Params)
+	}
+
+	file, err = store.ToEncryptedFile("password", nil, &EncryptionParams{Iterations: 3})
+	if err != nil {
+		t.Fatalf("ToEncryptedFile(partial params) error = %v", err)
+	}
+	stored := file.Crypto.Argon2Params
+	if stored.TimeCost != 3 || stored.MemoryCost != defaults.Memory || stored.Parallelism != defaults.Parallelism {
+		t.Fatalf("partial params persisted %+v, want iterations 3 with default memory and parallelism", stored)
+	}
+
+	if _, err := store.ToEncryptedFile("password", nil, nil, nil); err == nil {
+		t.Fatal("ToEncryptedFile() with two params values error = nil")
+	}
+}
@@ -2,9 +2,12 @@ package wallet
 
 import (
 	"crypto/ed25519"
+	"fmt"
 	"runtime"
 
 	"github.com/0x3639/znn-sdk-go/crypto"
+	"github.com/0x3639/znn-sdk-go/utils"
+	"github.com/zenon-network/go-zenon/chain/nom"
 	"github.com/zenon-network/go-zenon/common/types"
 )
 
@@ -75,8 +78,10 @@ func (kp *KeyPair) GetAddress() (*types.Address, error) {
 			return nil, err
 		}
 
-		// Derive address from public key
-		addr := types.PubKeyToAddress(pubKey)
+		addr, err := crypto.AddressFromPublicKey(pubKey)
+		if err != nil {
+			return nil, err
+		}
 		kp.address = &addr
 	}
 	return kp.address, nil
@@ -87,6 +92,61 @@ func (kp *KeyPair) Sign(message []byte) ([]byte, error) {
 	return crypto.Sign(message, kp.privateKey)
 }
 
+// SignHash signs a 32-byte block hash with the private key, implementing Signer
+func (kp *KeyPair) SignHash(hash types.Hash) ([]byte, error) {
+	return kp.Sign(hash.Bytes())
+}
+
+// FinalizeBlock sets the signing fields of an account block from this
+// keypair in one step: Address, PublicKey, Hash (utils.GetTransactionHash),
+// and Signature over that hash. Setting them together keeps them consistent,
+// so the block passes utils.VerifyAccountBlock.
+//
+// Call it last, after the block is otherwise complete (autofilled, with PoW
+// or plasma): any later change invalidates the hash and signature. Because
+// the autofill and PoW depend on the address, a block that already carries a
+// different address is rejected rather than silently re-addressed.
+//
+// Parameters:
+//   - block: Account block to finalize, modified in place
+//
+// Returns an error if block is nil, its Address belongs to another account,
+// or the key cannot sign.
+//
+// Example:
+//
+//	// template autofilled for kp's address, with PoW or plasma
+//	if err := kp.FinalizeBlock(template); err != nil {
+//	    return err
+//	}
+//	err = client.LedgerApi.PublishRawTransaction(template)
+func (kp *KeyPair) FinalizeBlock(block *nom.AccountBlock) error {
+	if block == nil {
+		return fmt.Errorf("nil block")
+	}
+	address, err := kp.GetAddress()
+	if err != nil {
+		return err
+	}
+	if block.Address != types.ZeroAddress && block.Address != *address {
+		return fmt.Errorf("block address %s does not belong to this keypair (%s)", block.Address, address)
+	}
+	publicKey, err := kp.GetPublicKey()
+	if err != nil {
+		return err
+	}
+
+	block.Address = *address
+	block.PublicKey = publicKey
+	block.Hash = utils.GetTransactionHash(block)
+	signature, err := kp.SignHash(block.Hash)
+	if err != nil {
+		return fmt.Errorf("failed to sign block: %w", err)
+	}
+	block.Signature = signature
+	return nil
+}
+
 // Verify verifies a signature against a message using this keypair's public key
 func (kp *KeyPair) Verify(signature []byte, message []byte) (bool, error) {
 	pubKey, err := kp.GetPublicKey()
@@ -4,7 +4,15 @@ import (
 	"bytes"
 	"crypto/ed25519"
 	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math/big"
 	"testing"
+
+	"github.com/0x3639/znn-sdk-go/crypto"
+	"github.com/0x3639/znn-sdk-go/utils"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
 )
 
 // =============================================================================
@@ -256,6 +264,50 @@ func TestGetAddress_Deterministic(t *testing.T) {
 	}
 }
 
+func TestGetAddress_ZeroEntropyAccount0(t *testing.T) {
+	// All-zero entropy is "abandon abandon ... about"; account 0 is
+	// m/44'/73404'/0'.
+	ks, err := NewKeyStoreFromEntropy(make([]byte, 16))
+	if err != nil {
+		t.Fatalf("NewKeyStoreFromEntropy() error = %v", err)
+	}
+	kp, err := ks.GetKeyPair(0)
+	if err != nil {
+		t.Fatalf("GetKeyPair(0) error = %v", err)
+	}
+	pubKey, err := kp.GetPublicKey()
+	if err != nil {
+		t.Fatalf("GetPublicKey() error = %v", err)
+	}
+	if got, want := hex.EncodeToString(pubKey), "0676964bd73881f251782a9c40cda2e6063381fdc06e11c083ffae0170821379"; got != want {
+		t.Errorf("public key = %s, want %s", got, want)
+	}
+
+	const want = "z1qpvmy2md6as6p5au5pylsnrp66egw5gfx6dllm"
+	addr, err := kp.GetAddress()
+	if err != nil {
+		t.Fatalf("GetAddress() error = %v", err)
+	}
+	if addr.String() != want {
+		t.Errorf("GetAddress() = %s, want %s", addr, want)
+	}
+
+	derived, err := crypto.AddressFromPublicKey(pubKey)
+	if err != nil {
+		t.Fatalf("AddressFromPublicKey() error = %v", err)
+	}
+	if derived.String() != want {
+		t.Errorf("AddressFromPublicKey() = %s, want %s", derived, want)
+	}
+	core, err := crypto.CoreBytesFromPublicKey(pubKey)
+	if err != nil {
+		t.Fatalf("CoreBytesFromPublicKey() error = %v", err)
+	}
+	if got := hex.EncodeToString(core); got != "59b22b6dd761a0d3bca049f84c61d6b2875109" {
+		t.Errorf("CoreBytesFromPublicKey() = %s", got)
+	}
+}
+
 // =============================================================================
 // Sign Tests
 // =============================================================================
@@ -328,6 +380,58 @@ func TestSign_Deterministic(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// FinalizeBlock Tests
+// =============================================================================
+
+func TestFinalizeBlock_PassesVerifyAccountBlock(t *testing.T) {
+	kp, err := NewKeyPairFromSeed(make([]byte, 32))
+	if err != nil {
+		t.Fatalf("NewKeyPairFromSeed() error = %v", err)
+	}
+	block := &nom.AccountBlock{
+		Version:         1,
+		ChainIdentifier: 1,
+		BlockType:       nom.BlockTypeUserSend,
+		Height:          3,
+		ToAddress:       types.PillarContract,
+		TokenStandard:   types.ZnnTokenStandard,
+		Amount:          big.NewInt(100000000),
+		FusedPlasma:     21000,
+	}
+
+	if err := kp.FinalizeBlock(block); err != nil {
+		t.Fatalf("FinalizeBlock() error = %v", err)
+	}
+	if err := utils.VerifyAccountBlock(block); err != nil {
+		t.Errorf("VerifyAccountBlock() error = %v", err)
+	}
+	addr, _ := kp.GetAddress()
+	if block.Address != *addr {
+		t.Errorf("Address = %s, want %s", block.Address, addr)
+	}
+
+	// Changing the block afterwards breaks it.
+	block.Amount = big.NewInt(1)
+	if err := utils.VerifyAccountBlock(block); !errors.Is(err, utils.ErrBlockHashMismatch) {
+		t.Errorf("VerifyAccountBlock() after edit error = %v, want ErrBlockHashMismatch", err)
+	}
+}
+
+func TestFinalizeBlock_RejectsForeignAddress(t *testing.T) {
+	kp, _ := NewKeyPairFromSeed(make([]byte, 32))
+	block := &nom.AccountBlock{BlockType: nom.BlockTypeUserSend, Address: types.PillarContract}
+	if err := kp.FinalizeBlock(block); err == nil {
+		t.Error("FinalizeBlock() should reject a block addressed from another account")
+	}
+	if block.Signature != nil {
+		t.Error("FinalizeBlock() signed a rejected block")
+	}
+	if err := kp.FinalizeBlock(nil); err == nil {
+		t.Error("FinalizeBlock(nil) should fail")
+	}
+}
+
 // =============================================================================
 // Verify Tests
 // =============================================================================
@@ -1,9 +1,12 @@
 package wallet
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"runtime"
+	"sync"
 
 	"github.com/zenon-network/go-zenon/common/types"
 )
@@ -63,7 +66,40 @@ func NewKeyStoreFromEntropy(entropy []byte) (*KeyStore, error) {
 
 // NewKeyStoreRandom creates a new KeyStore with random entropy (256 bits)
 func NewKeyStoreRandom() (*KeyStore, error) {
-	mnemonic, err := GenerateMnemonic(256)
+	return NewKeyStoreRandomWithStrength(256)
+}
+
+// NewKeyStoreRandomWithStrength creates a new KeyStore from a random mnemonic
+// of the chosen entropy strength.
+//
+// The word count follows BIP39: 128 bits = 12 words, 160 = 15, 192 = 18,
+// 224 = 21, and 256 = 24. Use 128 bits for compatibility with tools that
+// expect 12-word phrases; NewKeyStoreRandom is the 256-bit shortcut.
+//
+// Parameters:
+//   - bits: Entropy strength; one of 128, 160, 192, 224, or 256
+//
+// Returns the new KeyStore, or ErrInvalidEntropy for any other strength.
+//
+// Example:
+//
+//	keystore, err := wallet.NewKeyStoreRandomWithStrength(128)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(len(strings.Fields(keystore.Mnemonic))) // 12
+//
+// Note: The stable encrypted key-file format stores only 128-bit or 256-bit
+// entropy, so ToEncryptedFile rejects keystores created with 160, 192, or
+// 224 bits.
+func NewKeyStoreRandomWithStrength(bits int) (*KeyStore, error) {
+	switch bits {
+	case 128, 160, 192, 224, 256:
+	default:
+		return nil, fmt.Errorf("%w: strength must be 128, 160, 192, 224, or 256 bits, got %d", ErrInvalidEntropy, bits)
+	}
+
+	mnemonic, err := GenerateMnemonic(bits)
 	if err != nil {
 		return nil, err
 	}
@@ -111,19 +147,57 @@ func (ks *KeyStore) GetKeyPair(account int) (*KeyPair, error) {
 	}
 
 	// Derive using BIP44 path
-	path := GetDerivationAccount(account)
-	keyData, err := DerivePath(path, ks.Seed)
-	if err != nil {
-		return nil, err
+	return ks.deriveKeyPair(GetDerivationAccount(account))
+}
+
+// GetKeyPairForCoin derives a keypair at m/44'/coinType'/account' from the
+// keystore's seed.
+//
+// This is for cross-chain tooling that keeps one seed for several networks.
+// GetKeyPairForCoin(ZenonCoinType, n) is identical to GetKeyPair(n).
+//
+// Parameters:
+//   - coinType: SLIP-0044 coin type, for example 148 for Stellar
+//   - account: Account index (0 for first address, 1 for second, etc.)
+//
+// Returns the derived KeyPair, or an error wrapping ErrInvalidDerivation if
+// either index is negative or not below 2^31.
+//
+// Example:
+//
+//	keypair, err := keystore.GetKeyPairForCoin(148, 0) // Stellar account 0
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	publicKey, _ := keypair.GetPublicKey()
+//
+// Note: Keys are derived with SLIP-0010 for Ed25519, which supports only
+// hardened levels. They match wallets of Ed25519 chains that use the same
+// three-level path (such as Stellar), but not secp256k1 chains like Bitcoin
+// or Ethereum, whose BIP32 derivation and key type differ. GetAddress always
+// returns a Zenon address.
+func (ks *KeyStore) GetKeyPairForCoin(coinType, account int) (*KeyPair, error) {
+	if ks.Seed == nil {
+		return nil, fmt.Errorf("keystore seed not initialized")
+	}
+	if coinType < 0 || int64(coinType) >= HardenedKeyStart {
+		return nil, fmt.Errorf("%w: coin type %d must be in [0, 2^31)", ErrInvalidDerivation, coinType)
 	}
+	if account < 0 || int64(account) >= HardenedKeyStart {
+		return nil, fmt.Errorf("%w: account %d must be in [0, 2^31)", ErrInvalidDerivation, account)
+	}
+	return ks.deriveKeyPair(GetDerivationAccountForCoin(coinType, account))
+}
 
-	// Create keypair from derived key
-	kp, err := NewKeyPairFromSeed(keyData.Key)
+// deriveKeyPair derives the Ed25519 keypair at path from the keystore seed.
+func (ks *KeyStore) deriveKeyPair(path string) (*KeyPair, error) {
+	keyData, err := DerivePath(path, ks.Seed)
 	if err != nil {
 		return nil, err
 	}
 
-	return kp, nil
+	// Create keypair from derived key
+	return NewKeyPairFromSeed(keyData.Key)
 }
 
 // DeriveAddressesByRange derives multiple addresses efficiently in a single operation.
@@ -133,7 +207,9 @@ func (ks *KeyStore) GetKeyPair(account int) (*KeyPair, error) {
 //   - Searching for addresses with specific properties
 //   - Generating address pools for services
 //
-// The range is [left, right) - includes left, excludes right.
+// The range is [left, right) - includes left, excludes right. Derivation is
+// spread across a worker pool of at most runtime.NumCPU() goroutines, and the
+// returned slice is always in index order.
 //
 // Parameters:
 //   - left: Starting account index (inclusive)
@@ -163,23 +239,198 @@ func (ks *KeyStore) DeriveAddressesByRange(left, right int) ([]*types.Address, e
 		return nil, fmt.Errorf("invalid range: [%d, %d)", left, right)
 	}
 
-	addresses := make([]*types.Address, 0, right-left)
+	addresses := make([]*types.Address, right-left)
+	err := ks.deriveParallel(left, right, func(i int, _ *KeyPair, addr *types.Address) bool {
+		addresses[i-left] = addr
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	for i := left; i < right; i++ {
+	return addresses, nil
+}
+
+// GetKeyPairsByRange derives the keypairs for a range of account indices.
+//
+// This is the keypair counterpart of DeriveAddressesByRange and shares its
+// parallel derivation. Each returned KeyPair already holds its address, so
+// callers that need to sign for many indices (for example, sweeping funds
+// from derived addresses into one) avoid deriving each index twice.
+//
+// The range is [left, right) - includes left, excludes right.
+//
+// Parameters:
+//   - left: Starting account index (inclusive)
+//   - right: Ending account index (exclusive)
+//
+// Returns the keypairs in index order, or an error if the range is invalid or
+// derivation fails.
+//
+// Example:
+//
+//	keypairs, err := keystore.GetKeyPairsByRange(1, 10)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, kp := range keypairs {
+//	    addr, _ := kp.GetAddress()
+//	    fmt.Println("Sweeping from", addr)
+//	}
+//
+// Security Note: Every returned KeyPair holds private key material. Call
+// Destroy on each one when it is no longer needed.
+func (ks *KeyStore) GetKeyPairsByRange(left, right int) ([]*KeyPair, error) {
+	if left < 0 || right < left {
+		return nil, fmt.Errorf("invalid range: [%d, %d)", left, right)
+	}
+
+	keyPairs := make([]*KeyPair, right-left)
+	err := ks.deriveParallel(left, right, func(i int, kp *KeyPair, _ *types.Address) bool {
+		keyPairs[i-left] = kp
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keyPairs, nil
+}
+
+// ScanAddresses runs BIP44 gap-limit discovery over the keystore's accounts,
+// the standard algorithm for recovering a wallet from its mnemonic.
+//
+// Starting at index start, it derives one address at a time and asks
+// hasActivity whether the chain has seen it. Scanning stops once gapLimit
+// consecutive addresses report no activity. Because hasActivity is called in
+// index order, it doubles as a progress callback.
+//
+// Parameters:
+//   - ctx: Cancels the scan between addresses
+//   - start: First account index to check
+//   - gapLimit: Number of consecutive unused addresses that ends the scan
+//     (20 is the usual BIP44 value)
+//   - hasActivity: Reports whether an address has been used, typically by
+//     querying its account height or unreceived blocks
+//
+// Returns the addresses from start through the last used one, so the address
+// at position i belongs to account start+i; the result is empty when none of
+// them is used. Returns an error if start or gapLimit is invalid, ctx is done,
+// derivation fails, or hasActivity returns an error.
+//
+// Example:
+//
+//	used, err := keystore.ScanAddresses(ctx, 0, 20, func(addr types.Address) (bool, error) {
+//	    info, err := client.LedgerApi.GetAccountInfoByAddress(addr)
+//	    if err != nil {
+//	        return false, err
+//	    }
+//	    return info.AccountHeight > 0, nil
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Restored %d accounts\n", len(used))
+func (ks *KeyStore) ScanAddresses(ctx context.Context, start int, gapLimit int, hasActivity func(types.Address) (bool, error)) ([]types.Address, error) {
+	if start < 0 {
+		return nil, fmt.Errorf("invalid start index: %d", start)
+	}
+	if gapLimit <= 0 {
+		return nil, fmt.Errorf("invalid gap limit: %d", gapLimit)
+	}
+
+	var addresses []types.Address
+	used := 0
+	for i, gap := start, 0; gap < gapLimit; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		kp, err := ks.GetKeyPair(i)
 		if err != nil {
 			return nil, fmt.Errorf("failed to derive account %d: %w", i, err)
 		}
-
 		addr, err := kp.GetAddress()
 		if err != nil {
+			kp.Destroy()
 			return nil, fmt.Errorf("failed to get address for account %d: %w", i, err)
 		}
+		address := *addr
+		kp.Destroy()
 
-		addresses = append(addresses, addr)
+		active, err := hasActivity(address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check activity of account %d: %w", i, err)
+		}
+		addresses = append(addresses, address)
+		if active {
+			used = len(addresses)
+			gap = 0
+		} else {
+			gap++
+		}
 	}
 
-	return addresses, nil
+	return addresses[:used], nil
+}
+
+// deriveParallel derives the keypairs for indices in [left, right) on a
+// bounded worker pool and calls visit for each one from the deriving worker.
+// visit may be called concurrently for different indices; returning true stops
+// the remaining derivations early. The first derivation error is returned.
+func (ks *KeyStore) deriveParallel(left, right int, visit func(index int, kp *KeyPair, addr *types.Address) bool) error {
+	if right <= left {
+		return nil
+	}
+	workers := runtime.NumCPU()
+	if workers > right-left {
+		workers = right - left
+	}
+
+	indices := make(chan int)
+	done := make(chan struct{})
+	var stopOnce sync.Once
+	stop := func() { stopOnce.Do(func() { close(done) }) }
+
+	var firstErr error
+	var errOnce sync.Once
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				kp, err := ks.GetKeyPair(i)
+				if err != nil {
+					errOnce.Do(func() { firstErr = fmt.Errorf("failed to derive account %d: %w", i, err) })
+					stop()
+					continue
+				}
+				addr, err := kp.GetAddress()
+				if err != nil {
+					errOnce.Do(func() { firstErr = fmt.Errorf("failed to get address for account %d: %w", i, err) })
+					stop()
+					continue
+				}
+				if visit(i, kp, addr) {
+					stop()
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := left; i < right; i++ {
+		select {
+		case indices <- i:
+		case <-done:
+			break feed
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	return firstErr
 }
 
 // FindResponse represents the result of finding an address in the keystore
@@ -189,7 +440,7 @@ type FindResponse struct {
 }
 
 // FindAddress searches for a specific address within the keystore by trying account
-// indices sequentially until found or maxAccounts is reached.
+// indices until found or maxAccounts is reached.
 //
 // This is useful when you know an address belongs to this wallet but don't know which
 // account index it uses. Common scenarios:
@@ -217,29 +468,28 @@ type FindResponse struct {
 //	    // Use result.KeyPair to sign transactions
 //	}
 //
-// Performance note: This is a linear search. If maxAccounts is large, it may take time.
+// Performance note: Indices are checked by a pool of at most runtime.NumCPU()
+// workers, and the search stops as soon as any worker finds a match. The cost
+// of a miss still grows linearly with maxAccounts.
 func (ks *KeyStore) FindAddress(address types.Address, maxAccounts int) (*FindResponse, error) {
 	if maxAccounts <= 0 {
 		maxAccounts = DefaultMaxIndex
 	}
 
-	for i := 0; i < maxAccounts; i++ {
-		kp, err := ks.GetKeyPair(i)
-		if err != nil {
-			return nil, err
-		}
-
-		addr, err := kp.GetAddress()
-		if err != nil {
-			return nil, err
-		}
-
-		if addr.String() == address.String() {
-			return &FindResponse{
-				Index:   i,
-				KeyPair: kp,
-			}, nil
+	var found *FindResponse
+	var foundOnce sync.Once
+	err := ks.deriveParallel(0, maxAccounts, func(i int, kp *KeyPair, addr *types.Address) bool {
+		if *addr != address {
+			return false
 		}
+		foundOnce.Do(func() { found = &FindResponse{Index: i, KeyPair: kp} })
+		return true
+	})
+	if found != nil {
+		return found, nil
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return nil, ErrAddressNotFound
@@ -265,10 +515,12 @@ func (ks *KeyStore) GetBaseAddress() (*types.Address, error) {
 // Parameters:
 //   - password: UTF-8 password used for Argon2id key derivation.
 //   - metadata: Optional additional top-level key-file properties.
+//   - params: Optional Argon2id costs. When omitted or nil, the stable Zenon
+//     defaults are used. At most one value may be supplied.
 //
 // ToEncryptedFile returns a version-one [EncryptedFile], or an error if the
-// keystore has no valid BIP39 entropy, account zero cannot be derived, or
-// encryption fails.
+// keystore has no valid BIP39 entropy, account zero cannot be derived, more
+// than one params value is given, or encryption fails.
 //
 // Example:
 //
@@ -278,13 +530,28 @@ func (ks *KeyStore) GetBaseAddress() (*types.Address, error) {
 //	}
 //	jsonData, err := file.ToJSON()
 //
+// Example - Stronger parameters on a server:
+//
+//	file, err := keystore.ToEncryptedFile(password, nil, &wallet.EncryptionParams{
+//		Iterations:  4,
+//		MemoryKiB:   256 * 1024,
+//		Parallelism: 4,
+//	})
+//
 // Security Note: Seed-only keystores cannot be serialized into the stable
 // entropy-based format. Existing Go-generated JSON payloads remain readable by
 // [FromEncryptedFile].
-func (ks *KeyStore) ToEncryptedFile(password string, metadata map[string]interface{}) (*EncryptedFile, error) {
+func (ks *KeyStore) ToEncryptedFile(password string, metadata map[string]interface{}, params ...*EncryptionParams) (*EncryptedFile, error) {
 	if ks == nil || (len(ks.Entropy) != 16 && len(ks.Entropy) != 32) {
 		return nil, fmt.Errorf("%w: stable key files require 16 or 32 bytes of entropy", ErrInvalidKeyStore)
 	}
+	if len(params) > 1 {
+		return nil, fmt.Errorf("at most one EncryptionParams value may be supplied, got %d", len(params))
+	}
+	var encryption *EncryptionParams
+	if len(params) == 1 {
+		encryption = params[0]
+	}
 
 	fileMetadata := make(map[string]interface{}, len(metadata)+2)
 	for key, value := range metadata {
@@ -301,7 +568,7 @@ func (ks *KeyStore) ToEncryptedFile(password string, metadata map[string]interfa
 		fileMetadata[WalletTypeKey] = KeyStoreWalletType
 	}
 
-	return Encrypt(ks.Entropy, password, fileMetadata)
+	return EncryptWithParams(ks.Entropy, password, fileMetadata, encryption)
 }
 
 // FromEncryptedFile decrypts and validates an encrypted Zenon key file.
@@ -316,9 +583,9 @@ func (ks *KeyStore) ToEncryptedFile(password string, metadata map[string]interfa
 //   - password: UTF-8 password used by the file's Argon2id configuration.
 //
 // FromEncryptedFile returns a ready-to-use KeyStore. It returns
-// [ErrIncorrectPassword] for authentication failures and [ErrInvalidKeyStore]
-// for invalid entropy, legacy payloads, missing metadata, or base-address
-// mismatches.
+// [ErrCorruptedKeystore] for damaged cipher data, [ErrIncorrectPassword] for
+// authentication failures, and [ErrInvalidKeyStore] for invalid entropy,
+// legacy payloads, missing metadata, or base-address mismatches.
 //
 // Example:
 //
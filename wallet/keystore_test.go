@@ -1,8 +1,14 @@
 package wallet
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
 )
 
 // =============================================================================
@@ -161,6 +167,43 @@ func TestNewKeyStoreRandom_Unique(t *testing.T) {
 	}
 }
 
+func TestNewKeyStoreRandomWithStrength(t *testing.T) {
+	tests := []struct {
+		bits      int
+		wantWords int
+	}{
+		{128, 12},
+		{160, 15},
+		{192, 18},
+		{224, 21},
+		{256, 24},
+	}
+
+	for _, tt := range tests {
+		ks, err := NewKeyStoreRandomWithStrength(tt.bits)
+		if err != nil {
+			t.Fatalf("NewKeyStoreRandomWithStrength(%d) error = %v", tt.bits, err)
+		}
+		if got := len(strings.Fields(ks.Mnemonic)); got != tt.wantWords {
+			t.Errorf("NewKeyStoreRandomWithStrength(%d) words = %d, want %d", tt.bits, got, tt.wantWords)
+		}
+		if len(ks.Entropy) != tt.bits/8 {
+			t.Errorf("NewKeyStoreRandomWithStrength(%d) entropy = %d bytes, want %d", tt.bits, len(ks.Entropy), tt.bits/8)
+		}
+		if !ValidateMnemonicString(ks.Mnemonic) {
+			t.Errorf("NewKeyStoreRandomWithStrength(%d) produced an invalid BIP39 mnemonic", tt.bits)
+		}
+	}
+}
+
+func TestNewKeyStoreRandomWithStrength_InvalidStrength(t *testing.T) {
+	for _, bits := range []int{0, 64, 129, 512} {
+		if _, err := NewKeyStoreRandomWithStrength(bits); !errors.Is(err, ErrInvalidEntropy) {
+			t.Errorf("NewKeyStoreRandomWithStrength(%d) error = %v, want ErrInvalidEntropy", bits, err)
+		}
+	}
+}
+
 // =============================================================================
 // GetKeyPair Tests
 // =============================================================================
@@ -298,6 +341,165 @@ func TestDeriveAddressesByRange_InvalidRange(t *testing.T) {
 	}
 }
 
+func TestDeriveAddressesByRange_MatchesSequentialDerivation(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	ks, _ := NewKeyStoreFromMnemonic(mnemonic)
+
+	left, right := 7, 71
+	addresses, err := ks.DeriveAddressesByRange(left, right)
+	if err != nil {
+		t.Fatalf("DeriveAddressesByRange() error = %v", err)
+	}
+	if len(addresses) != right-left {
+		t.Fatalf("len(addresses) = %d, want %d", len(addresses), right-left)
+	}
+
+	for i := left; i < right; i++ {
+		kp, err := ks.GetKeyPair(i)
+		if err != nil {
+			t.Fatalf("GetKeyPair(%d) error = %v", i, err)
+		}
+		want, _ := kp.GetAddress()
+		if addresses[i-left].String() != want.String() {
+			t.Errorf("addresses[%d] = %s, want %s", i-left, addresses[i-left], want)
+		}
+	}
+}
+
+// =============================================================================
+// GetKeyPairsByRange Tests
+// =============================================================================
+
+func TestGetKeyPairsByRange_MatchesAddresses(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	ks, _ := NewKeyStoreFromMnemonic(mnemonic)
+
+	keyPairs, err := ks.GetKeyPairsByRange(2, 12)
+	if err != nil {
+		t.Fatalf("GetKeyPairsByRange() error = %v", err)
+	}
+	addresses, err := ks.DeriveAddressesByRange(2, 12)
+	if err != nil {
+		t.Fatalf("DeriveAddressesByRange() error = %v", err)
+	}
+	if len(keyPairs) != len(addresses) {
+		t.Fatalf("len(keyPairs) = %d, want %d", len(keyPairs), len(addresses))
+	}
+
+	for i, kp := range keyPairs {
+		addr, err := kp.GetAddress()
+		if err != nil {
+			t.Fatalf("GetAddress() error = %v", err)
+		}
+		if addr.String() != addresses[i].String() {
+			t.Errorf("keyPairs[%d] address = %s, want %s", i, addr, addresses[i])
+		}
+	}
+}
+
+func TestGetKeyPairsByRange_InvalidRange(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	ks, _ := NewKeyStoreFromMnemonic(mnemonic)
+
+	for _, tc := range []struct{ left, right int }{{-1, 5}, {5, 3}} {
+		if _, err := ks.GetKeyPairsByRange(tc.left, tc.right); err == nil {
+			t.Errorf("GetKeyPairsByRange(%d, %d) should return error", tc.left, tc.right)
+		}
+	}
+
+	keyPairs, err := ks.GetKeyPairsByRange(4, 4)
+	if err != nil || len(keyPairs) != 0 {
+		t.Errorf("GetKeyPairsByRange(4, 4) = %d keypairs, %v; want 0, nil", len(keyPairs), err)
+	}
+}
+
+// =============================================================================
+// ScanAddresses Tests
+// =============================================================================
+
+func TestScanAddresses_StopsAfterGap(t *testing.T) {
+	ks, _ := NewKeyStoreFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	expected, err := ks.DeriveAddressesByRange(0, 10)
+	if err != nil {
+		t.Fatalf("DeriveAddressesByRange() error = %v", err)
+	}
+	active := map[types.Address]bool{*expected[0]: true, *expected[2]: true, *expected[5]: true}
+
+	var checked []types.Address
+	addresses, err := ks.ScanAddresses(context.Background(), 0, 3, func(addr types.Address) (bool, error) {
+		checked = append(checked, addr)
+		return active[addr], nil
+	})
+	if err != nil {
+		t.Fatalf("ScanAddresses() error = %v", err)
+	}
+
+	// Index 5 is used and 6, 7, 8 are not, so the scan stops after 8
+	if len(checked) != 9 {
+		t.Errorf("checked %d addresses, want 9", len(checked))
+	}
+	for i, addr := range checked {
+		if addr != *expected[i] {
+			t.Errorf("checked[%d] = %s, want %s", i, addr, expected[i])
+		}
+	}
+	if len(addresses) != 6 {
+		t.Fatalf("len(addresses) = %d, want 6", len(addresses))
+	}
+	for i, addr := range addresses {
+		if addr != *expected[i] {
+			t.Errorf("addresses[%d] = %s, want %s", i, addr, expected[i])
+		}
+	}
+}
+
+func TestScanAddresses_NoActivity(t *testing.T) {
+	ks, _ := NewKeyStoreFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+
+	calls := 0
+	addresses, err := ks.ScanAddresses(context.Background(), 4, 5, func(types.Address) (bool, error) {
+		calls++
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("ScanAddresses() error = %v", err)
+	}
+	if len(addresses) != 0 || calls != 5 {
+		t.Errorf("got %d addresses after %d checks, want 0 after 5", len(addresses), calls)
+	}
+}
+
+func TestScanAddresses_Errors(t *testing.T) {
+	ks, _ := NewKeyStoreFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	unused := func(types.Address) (bool, error) { return false, nil }
+
+	if _, err := ks.ScanAddresses(context.Background(), -1, 20, unused); err == nil {
+		t.Error("ScanAddresses() with negative start should fail")
+	}
+	if _, err := ks.ScanAddresses(context.Background(), 0, 0, unused); err == nil {
+		t.Error("ScanAddresses() with zero gap limit should fail")
+	}
+
+	queryErr := errors.New("node unavailable")
+	_, err := ks.ScanAddresses(context.Background(), 0, 20, func(types.Address) (bool, error) {
+		return false, queryErr
+	})
+	if !errors.Is(err, queryErr) {
+		t.Errorf("ScanAddresses() error = %v, want %v", err, queryErr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	_, err = ks.ScanAddresses(ctx, 0, 20, func(types.Address) (bool, error) {
+		calls++
+		cancel()
+		return true, nil
+	})
+	if !errors.Is(err, context.Canceled) || calls != 1 {
+		t.Errorf("ScanAddresses() error = %v after %d checks, want context.Canceled after 1", err, calls)
+	}
+}
+
 // =============================================================================
 // FindAddress Tests
 // =============================================================================
@@ -363,6 +565,30 @@ func TestFindAddress_DefaultMaxIndex(t *testing.T) {
 	}
 }
 
+func TestFindAddress_LastIndexInRange(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	ks, _ := NewKeyStoreFromMnemonic(mnemonic)
+
+	kp, _ := ks.GetKeyPair(39)
+	addr, _ := kp.GetAddress()
+
+	result, err := ks.FindAddress(*addr, 40)
+	if err != nil {
+		t.Fatalf("FindAddress() error = %v", err)
+	}
+	if result.Index != 39 {
+		t.Errorf("Index = %d, want 39", result.Index)
+	}
+	found, _ := result.KeyPair.GetAddress()
+	if found.String() != addr.String() {
+		t.Errorf("KeyPair address = %s, want %s", found, addr)
+	}
+
+	if _, err := ks.FindAddress(*addr, 39); !errors.Is(err, ErrAddressNotFound) {
+		t.Errorf("FindAddress() error = %v, want ErrAddressNotFound", err)
+	}
+}
+
 // =============================================================================
 // GetBaseAddress Tests
 // =============================================================================
@@ -604,6 +830,29 @@ func BenchmarkDeriveAddressesByRange(b *testing.B) {
 	}
 }
 
+func BenchmarkDeriveAddressesByRange500_Parallel(b *testing.B) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	ks, _ := NewKeyStoreFromMnemonic(mnemonic)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ks.DeriveAddressesByRange(0, 500)
+	}
+}
+
+func BenchmarkDeriveAddressesByRange500_Sequential(b *testing.B) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	ks, _ := NewKeyStoreFromMnemonic(mnemonic)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for index := 0; index < 500; index++ {
+			kp, _ := ks.GetKeyPair(index)
+			kp.GetAddress()
+		}
+	}
+}
+
 func BenchmarkToEncryptedFile(b *testing.B) {
 	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
 	ks, _ := NewKeyStoreFromMnemonic(mnemonic)
@@ -626,3 +875,82 @@ func BenchmarkFromEncryptedFile(b *testing.B) {
 		FromEncryptedFile(ef, password)
 	}
 }
+
+// =============================================================================
+// GetKeyPairForCoin Tests
+// =============================================================================
+
+// TestGetKeyPairForCoin_StellarVector checks the BIP44 plumbing against SEP-0005
+// test vector 1, since Stellar also derives Ed25519 keys with SLIP-0010 at
+// m/44'/148'/account'.
+func TestGetKeyPairForCoin_StellarVector(t *testing.T) {
+	ks, err := NewKeyStoreFromMnemonic("illness spike retreat truth genius clock brain pass fit cave bargain toe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kp, err := ks.GetKeyPairForCoin(148, 0)
+	if err != nil {
+		t.Fatalf("GetKeyPairForCoin() error = %v", err)
+	}
+	publicKey, err := kp.GetPublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// GDRXE2BQUC3AZNPVFSCEZ76NJ3WWL25FYFK6RGZGIEKWE4SOOHSUJUJ6 without the
+	// StrKey version byte and checksum
+	want := "e3726830a0b60cb5f52c844cffcd4eed65eba5c155e89b26411562724e71e544"
+	if got := hex.EncodeToString(publicKey); got != want {
+		t.Errorf("public key = %s, want %s", got, want)
+	}
+}
+
+func TestGetKeyPairForCoin_ZenonMatchesGetKeyPair(t *testing.T) {
+	ks, err := NewKeyStoreFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, account := range []int{0, 3} {
+		viaCoin, err := ks.GetKeyPairForCoin(ZenonCoinType, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		direct, err := ks.GetKeyPair(account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		coinAddr, _ := viaCoin.GetAddress()
+		directAddr, _ := direct.GetAddress()
+		if *coinAddr != *directAddr {
+			t.Errorf("account %d: GetKeyPairForCoin = %s, GetKeyPair = %s", account, coinAddr, directAddr)
+		}
+	}
+
+	other, _ := ks.GetKeyPairForCoin(148, 0)
+	zenon, _ := ks.GetKeyPair(0)
+	otherAddr, _ := other.GetAddress()
+	zenonAddr, _ := zenon.GetAddress()
+	if *otherAddr == *zenonAddr {
+		t.Error("a different coin type should derive a different key")
+	}
+}
+
+func TestGetKeyPairForCoin_InvalidIndexes(t *testing.T) {
+	ks, err := NewKeyStoreFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := [][2]int{{-1, 0}, {0, -1}}
+	if strconv.IntSize == 64 {
+		hardened := int64(HardenedKeyStart)
+		tests = append(tests, [2]int{int(hardened), 0}, [2]int{60, int(hardened)})
+	}
+	for _, test := range tests {
+		if _, err := ks.GetKeyPairForCoin(test[0], test[1]); !errors.Is(err, ErrInvalidDerivation) {
+			t.Errorf("GetKeyPairForCoin(%d, %d) error = %v, want ErrInvalidDerivation", test[0], test[1], err)
+		}
+	}
+	if _, err := (&KeyStore{}).GetKeyPairForCoin(148, 0); err == nil {
+		t.Error("GetKeyPairForCoin() without a seed should fail")
+	}
+}
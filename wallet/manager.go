@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -334,7 +335,8 @@ func (m *KeyStoreManager) CreateFromMnemonic(mnemonic, passphrase, name string)
 	return store, nil
 }
 
-// GetKeystoreInfo reads metadata from a keystore file without decrypting
+// GetKeystoreInfo reads metadata from a keystore file without decrypting.
+// See PeekKeyfile for keyfiles outside the wallet directory.
 func (m *KeyStoreManager) GetKeystoreInfo(keyStoreFile string) (map[string]interface{}, error) {
 	if keyStoreFile == "" {
 		return nil, fmt.Errorf("keystore file cannot be empty")
@@ -358,3 +360,108 @@ func (m *KeyStoreManager) GetKeystoreInfo(keyStoreFile string) (map[string]inter
 
 	return ef.Metadata, nil
 }
+
+// reservedMetadataKeys are top-level keyfile keys UpdateMetadata refuses to
+// change: the structural fields of EncryptedFile and the metadata that
+// identifies the wallet.
+var reservedMetadataKeys = map[string]bool{
+	"crypto":       true,
+	"timestamp":    true,
+	"version":      true,
+	BaseAddressKey: true,
+	WalletTypeKey:  true,
+}
+
+// UpdateMetadata edits the plaintext metadata of a keystore file in place,
+// without the password.
+//
+// Metadata is stored outside the encrypted payload and is not covered by its
+// authentication tag, so labels and tags can change without a slow
+// decrypt/encrypt cycle. The encrypted payload is written back unchanged.
+//
+// Parameters:
+//   - keyStoreFile: Filename of the keystore (not full path, just the name)
+//   - updates: Keys to set; a nil value removes the key. The values must be
+//     JSON-encodable.
+//
+// Returns an error wrapping ErrReservedMetadataKey if updates touches
+// baseAddress, walletType, crypto, timestamp, or version, or an error if the
+// file cannot be read, parsed, or written. The file is left unchanged on error.
+//
+// Example:
+//
+//	err := manager.UpdateMetadata("main-wallet", map[string]interface{}{
+//	    "label": "Savings",
+//	    "tags":  []string{"cold"},
+//	})
+//	info, _ := manager.GetKeystoreInfo("main-wallet")
+//	fmt.Println(info["label"]) // Savings
+func (m *KeyStoreManager) UpdateMetadata(keyStoreFile string, updates map[string]interface{}) error {
+	if keyStoreFile == "" {
+		return fmt.Errorf("keystore file cannot be empty")
+	}
+	for key := range updates {
+		if reservedMetadataKeys[key] {
+			return fmt.Errorf("%w: %q", ErrReservedMetadataKey, key)
+		}
+	}
+
+	filePath := filepath.Join(m.WalletPath, keyStoreFile)
+
+	// #nosec G304 - filePath is constructed from controlled wallet directory
+	jsonData, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read keystore file: %w", err)
+	}
+
+	// Work on raw values so the crypto section is written back byte for byte,
+	// including fields this SDK does not know about
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(jsonData, &fields); err != nil {
+		return fmt.Errorf("failed to parse keystore file: %w", err)
+	}
+	if _, ok := fields["crypto"]; !ok {
+		return fmt.Errorf("%w: %s has no crypto section", ErrInvalidKeyStore, keyStoreFile)
+	}
+	for key, value := range updates {
+		if value == nil {
+			delete(fields, key)
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode metadata %q: %w", key, err)
+		}
+		fields[key] = encoded
+	}
+
+	updated, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize keystore: %w", err)
+	}
+	return writeFileAtomic(filePath, updated)
+}
+
+// writeFileAtomic replaces path with data through a 0600 temporary file in
+// the same directory, so a crash never leaves a truncated keystore behind.
+// The temporary name starts with a dot, which ListAllKeyStores skips.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary keystore file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write keystore file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write keystore file: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace keystore file: %w", err)
+	}
+	return nil
+}
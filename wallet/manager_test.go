@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"encoding/json"
 	"errors"
 	"os"
 	"path/filepath"
@@ -648,6 +649,25 @@ func TestGetKeystoreInfo_FileNotFound(t *testing.T) {
 	}
 }
 
+func TestGetKeystoreInfo_WithoutCryptoSection(t *testing.T) {
+	manager, err := NewKeyStoreManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewKeyStoreManager() error = %v", err)
+	}
+	data := []byte(`{"baseAddress":"z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7","walletType":"keystore"}`)
+	if err := os.WriteFile(filepath.Join(manager.WalletPath, "metadata-only"), data, 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	info, err := manager.GetKeystoreInfo("metadata-only")
+	if err != nil {
+		t.Fatalf("GetKeystoreInfo() error = %v", err)
+	}
+	if info[BaseAddressKey] != "z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7" {
+		t.Errorf("baseAddress = %v", info[BaseAddressKey])
+	}
+}
+
 func TestGetKeystoreInfo_EmptyName(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "keystore-test-*")
 	if err != nil {
@@ -666,6 +686,103 @@ func TestGetKeystoreInfo_EmptyName(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// UpdateMetadata Tests
+// =============================================================================
+
+func TestUpdateMetadata_PersistsWithoutTouchingPayload(t *testing.T) {
+	manager, err := NewKeyStoreManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewKeyStoreManager() error = %v", err)
+	}
+	store, _ := NewKeyStoreRandom()
+	if err := manager.SaveKeyStore(store, "password", "test-wallet"); err != nil {
+		t.Fatalf("SaveKeyStore() error = %v", err)
+	}
+	before, _ := os.ReadFile(filepath.Join(manager.WalletPath, "test-wallet"))
+	original, _ := FromJSON(before)
+
+	err = manager.UpdateMetadata("test-wallet", map[string]interface{}{
+		"label": "Savings",
+		"tags":  []string{"cold", "bridge"},
+		"name":  nil,
+	})
+	if err != nil {
+		t.Fatalf("UpdateMetadata() error = %v", err)
+	}
+
+	info, err := manager.GetKeystoreInfo("test-wallet")
+	if err != nil {
+		t.Fatalf("GetKeystoreInfo() error = %v", err)
+	}
+	if info["label"] != "Savings" {
+		t.Errorf("label = %v, want Savings", info["label"])
+	}
+	if tags, ok := info["tags"].([]interface{}); !ok || len(tags) != 2 || tags[1] != "bridge" {
+		t.Errorf("tags = %v", info["tags"])
+	}
+	if _, ok := info["name"]; ok {
+		t.Error("a nil update should remove the key")
+	}
+	if info[BaseAddressKey] != original.Metadata[BaseAddressKey] || info[WalletTypeKey] != KeyStoreWalletType {
+		t.Errorf("reserved metadata changed: %v", info)
+	}
+
+	after, _ := os.ReadFile(filepath.Join(manager.WalletPath, "test-wallet"))
+	updated, _ := FromJSON(after)
+	originalCrypto, _ := json.Marshal(original.Crypto)
+	updatedCrypto, _ := json.Marshal(updated.Crypto)
+	if string(updatedCrypto) != string(originalCrypto) || updated.Timestamp != original.Timestamp || updated.Version != original.Version {
+		t.Error("UpdateMetadata() changed the encrypted payload")
+	}
+	if _, err := manager.ReadKeyStore("password", "test-wallet"); err != nil {
+		t.Errorf("ReadKeyStore() after UpdateMetadata error = %v", err)
+	}
+
+	files, _ := manager.ListAllKeyStores()
+	if len(files) != 1 {
+		t.Errorf("ListAllKeyStores() = %v, want only the keystore", files)
+	}
+}
+
+func TestUpdateMetadata_Errors(t *testing.T) {
+	manager, err := NewKeyStoreManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewKeyStoreManager() error = %v", err)
+	}
+	store, _ := NewKeyStoreRandom()
+	if err := manager.SaveKeyStore(store, "password", "test-wallet"); err != nil {
+		t.Fatalf("SaveKeyStore() error = %v", err)
+	}
+	before, _ := os.ReadFile(filepath.Join(manager.WalletPath, "test-wallet"))
+
+	for _, key := range []string{BaseAddressKey, WalletTypeKey, "crypto", "timestamp", "version"} {
+		err := manager.UpdateMetadata("test-wallet", map[string]interface{}{"label": "x", key: "y"})
+		if !errors.Is(err, ErrReservedMetadataKey) {
+			t.Errorf("UpdateMetadata(%q) error = %v, want ErrReservedMetadataKey", key, err)
+		}
+	}
+	if err := manager.UpdateMetadata("test-wallet", map[string]interface{}{"bad": func() {}}); err == nil {
+		t.Error("UpdateMetadata() should reject values that cannot be encoded")
+	}
+	if after, _ := os.ReadFile(filepath.Join(manager.WalletPath, "test-wallet")); string(after) != string(before) {
+		t.Error("a failed UpdateMetadata() modified the file")
+	}
+
+	if err := manager.UpdateMetadata("missing", map[string]interface{}{"label": "x"}); err == nil {
+		t.Error("UpdateMetadata() should fail for a missing file")
+	}
+	if err := manager.UpdateMetadata("", nil); err == nil {
+		t.Error("UpdateMetadata() should fail for an empty name")
+	}
+	if err := os.WriteFile(filepath.Join(manager.WalletPath, "plain"), []byte(`{"label":"x"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := manager.UpdateMetadata("plain", map[string]interface{}{"label": "y"}); !errors.Is(err, ErrInvalidKeyStore) {
+		t.Errorf("UpdateMetadata() on a file without crypto error = %v, want ErrInvalidKeyStore", err)
+	}
+}
+
 // =============================================================================
 // Integration Tests
 // =============================================================================
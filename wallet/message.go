@@ -0,0 +1,20 @@
+package wallet
+
+import (
+	"github.com/0x3639/znn-sdk-go/crypto"
+)
+
+// SignMessage signs an off-chain message, such as a login challenge or an
+// order, for verification with crypto.VerifyMessage.
+//
+// The signature covers crypto.MessageHash(domain, message) rather than the
+// message itself. The fixed Zenon message tag keeps the signature from being
+// accepted as an account block signature, and the domain binds it to one
+// context so that it cannot be replayed elsewhere.
+//
+// Example:
+//
+//	sig, err := wallet.SignMessage(kp, "app.example.com", challenge)
+func SignMessage(kp *KeyPair, domain, message string) ([]byte, error) {
+	return kp.Sign(crypto.MessageHash(domain, message))
+}
@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/crypto"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func newMessageTestKeyPair(t *testing.T) *KeyPair {
+	t.Helper()
+	kp, err := NewKeyPairFromSeed(bytes.Repeat([]byte{7}, 32))
+	if err != nil {
+		t.Fatalf("NewKeyPairFromSeed() error = %v", err)
+	}
+	return kp
+}
+
+func TestSignMessage_RoundTrip(t *testing.T) {
+	kp := newMessageTestKeyPair(t)
+	pubKey, _ := kp.GetPublicKey()
+
+	sig, err := SignMessage(kp, "app.example.com", "login:nonce-42")
+	if err != nil {
+		t.Fatalf("SignMessage() error = %v", err)
+	}
+
+	if !crypto.VerifyMessage("app.example.com", "login:nonce-42", sig, pubKey) {
+		t.Error("VerifyMessage() rejected a valid signature")
+	}
+	if crypto.VerifyMessage("other.example.com", "login:nonce-42", sig, pubKey) {
+		t.Error("VerifyMessage() accepted a signature from another domain")
+	}
+	if crypto.VerifyMessage("app.example.com", "login:nonce-43", sig, pubKey) {
+		t.Error("VerifyMessage() accepted a signature for another message")
+	}
+	if crypto.VerifyMessage("app.example", ".comlogin:nonce-42", sig, pubKey) {
+		t.Error("VerifyMessage() accepted a shifted domain/message boundary")
+	}
+	if crypto.VerifyMessage("app.example.com", "login:nonce-42", sig[:10], pubKey) {
+		t.Error("VerifyMessage() accepted a truncated signature")
+	}
+}
+
+func TestSignMessage_NotReplayableAsTransaction(t *testing.T) {
+	kp := newMessageTestKeyPair(t)
+	pubKey, _ := kp.GetPublicKey()
+	blockHash := types.NewHash([]byte("account block"))
+	domain := "app.example.com"
+
+	// A block signature over a hash must not pass as a message signature for
+	// a message made of the same hash bytes.
+	txSig, err := kp.SignHash(blockHash)
+	if err != nil {
+		t.Fatalf("SignHash() error = %v", err)
+	}
+	if crypto.VerifyMessage(domain, string(blockHash.Bytes()), txSig, pubKey) {
+		t.Error("VerifyMessage() accepted a transaction signature")
+	}
+	if crypto.VerifyMessage("", string(blockHash.Bytes()), txSig, pubKey) {
+		t.Error("VerifyMessage() accepted a transaction signature with an empty domain")
+	}
+
+	// A message signature over hash-shaped bytes must not pass as the
+	// signature of that block hash.
+	msgSig, err := SignMessage(kp, domain, string(blockHash.Bytes()))
+	if err != nil {
+		t.Fatalf("SignMessage() error = %v", err)
+	}
+	ok, err := crypto.Verify(msgSig, blockHash.Bytes(), pubKey)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if ok {
+		t.Error("message signature verified as a transaction signature")
+	}
+}
@@ -1,6 +1,9 @@
 package wallet
 
 import (
+	"errors"
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/tyler-smith/go-bip39"
@@ -9,8 +12,13 @@ import (
 // GenerateMnemonic generates a BIP39 mnemonic with the given entropy strength
 // strength must be 128, 160, 192, 224, or 256 bits
 // 128 bits = 12 words, 256 bits = 24 words
+// Entropy is read from crypto/rand unless a test has called SetRandSource.
 func GenerateMnemonic(strength int) (string, error) {
-	entropy, err := bip39.NewEntropy(strength)
+	if strength%32 != 0 || strength < 128 || strength > 256 {
+		return "", bip39.ErrEntropyLengthInvalid
+	}
+
+	entropy, err := readEntropy(strength / 8)
 	if err != nil {
 		return "", err
 	}
@@ -34,6 +42,115 @@ func ValidateMnemonicString(mnemonic string) bool {
 	return bip39.IsMnemonicValid(mnemonic)
 }
 
+// MnemonicWordError reports a mnemonic word that is not in the BIP39 English
+// wordlist. It matches ErrInvalidMnemonicWord and ErrInvalidMnemonic with
+// errors.Is.
+type MnemonicWordError struct {
+	// Index is the zero-based position of the word in the mnemonic.
+	Index int
+	// Word is the unrecognized word as entered.
+	Word string
+}
+
+func (e *MnemonicWordError) Error() string {
+	return fmt.Sprintf("%s: word %d %q", ErrInvalidMnemonicWord, e.Index+1, e.Word)
+}
+
+// Unwrap returns ErrInvalidMnemonicWord.
+func (e *MnemonicWordError) Unwrap() error {
+	return ErrInvalidMnemonicWord
+}
+
+// ValidateMnemonicPhrase checks a BIP39 mnemonic and explains why it is invalid.
+//
+// Unlike ValidateMnemonicString, which only reports validity, this function
+// returns a specific error so a user interface can tell the user what to fix.
+// Words are separated by any whitespace. Checks run in this order:
+//   - The word count must be 12, 15, 18, 21, or 24
+//   - Every word must be in the BIP39 English wordlist
+//   - The checksum encoded in the final word must match
+//
+// Parameters:
+//   - mnemonic: Space-separated mnemonic phrase
+//
+// Returns true and nil for a valid mnemonic. Otherwise returns false and one of:
+//   - ErrInvalidMnemonicWordCount when the number of words is not allowed
+//   - *MnemonicWordError (matching ErrInvalidMnemonicWord) for the first
+//     unknown word, including its position
+//   - ErrInvalidMnemonicChecksum when all words are known but the checksum fails
+//
+// All of these errors also match ErrInvalidMnemonic with errors.Is.
+//
+// Example:
+//
+//	ok, err := wallet.ValidateMnemonicPhrase(input)
+//	var wordErr *wallet.MnemonicWordError
+//	switch {
+//	case ok:
+//	    // continue onboarding
+//	case errors.As(err, &wordErr):
+//	    fmt.Printf("Word %d (%q) is not a BIP39 word\n", wordErr.Index+1, wordErr.Word)
+//	default:
+//	    fmt.Println(err)
+//	}
+//
+// See SuggestWords for autocompletion while the user types.
+func ValidateMnemonicPhrase(mnemonic string) (bool, error) {
+	words := strings.Fields(mnemonic)
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return false, fmt.Errorf("%w: got %d words", ErrInvalidMnemonicWordCount, len(words))
+	}
+
+	for i, word := range words {
+		if !IsValidWord(word) {
+			return false, &MnemonicWordError{Index: i, Word: word}
+		}
+	}
+
+	if _, err := bip39.EntropyFromMnemonic(strings.Join(words, " ")); err != nil {
+		if errors.Is(err, bip39.ErrChecksumIncorrect) {
+			return false, ErrInvalidMnemonicChecksum
+		}
+		return false, fmt.Errorf("%w: %w", ErrInvalidMnemonic, err)
+	}
+	return true, nil
+}
+
+// SuggestWords returns the BIP39 English words that start with prefix.
+//
+// This supports autocompletion while a user types a mnemonic. Matching is
+// case-insensitive and ignores surrounding whitespace, and results are in
+// wordlist (alphabetical) order.
+//
+// Parameters:
+//   - prefix: The partial word typed so far
+//
+// Returns the matching words, or nil when prefix is empty or nothing matches.
+//
+// Example:
+//
+//	fmt.Println(wallet.SuggestWords("aba"))
+//	// Output: [abandon]
+func SuggestWords(prefix string) []string {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" {
+		return nil
+	}
+
+	wordlist := bip39.GetWordList()
+	start := sort.SearchStrings(wordlist, prefix)
+	var suggestions []string
+	for _, word := range wordlist[start:] {
+		if !strings.HasPrefix(word, prefix) {
+			break
+		}
+		suggestions = append(suggestions, word)
+	}
+	return suggestions
+}
+
 // IsValidWord checks if a word is in the BIP39 wordlist
 func IsValidWord(word string) bool {
 	wordlist := bip39.GetWordList()
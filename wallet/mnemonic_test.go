@@ -1,7 +1,10 @@
 package wallet
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
+	"io"
 	"strings"
 	"testing"
 )
@@ -73,6 +76,50 @@ func TestGenerateMnemonic_Unique(t *testing.T) {
 	}
 }
 
+func TestGenerateMnemonic_SeededRandSource(t *testing.T) {
+	t.Cleanup(func() { SetRandSource(nil) })
+
+	// BIP39 test vector: all-zero entropy
+	SetRandSource(bytes.NewReader(make([]byte, 16)))
+	mnemonic, err := GenerateMnemonic(128)
+	if err != nil {
+		t.Fatalf("GenerateMnemonic(128) error = %v", err)
+	}
+	want := strings.Repeat("abandon ", 11) + "about"
+	if mnemonic != want {
+		t.Errorf("GenerateMnemonic(128) = %q, want %q", mnemonic, want)
+	}
+
+	seed := bytes.Repeat([]byte{0x7f}, 32)
+	SetRandSource(bytes.NewReader(seed))
+	first, err := NewKeyStoreRandom()
+	if err != nil {
+		t.Fatalf("NewKeyStoreRandom() error = %v", err)
+	}
+	SetRandSource(bytes.NewReader(seed))
+	second, err := NewKeyStoreRandom()
+	if err != nil {
+		t.Fatalf("NewKeyStoreRandom() error = %v", err)
+	}
+	if first.Mnemonic != second.Mnemonic {
+		t.Errorf("seeded mnemonics differ: %q vs %q", first.Mnemonic, second.Mnemonic)
+	}
+}
+
+func TestGenerateMnemonic_ShortRandSource(t *testing.T) {
+	t.Cleanup(func() { SetRandSource(nil) })
+
+	SetRandSource(bytes.NewReader(make([]byte, 8)))
+	if _, err := GenerateMnemonic(128); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("GenerateMnemonic(128) error = %v, want io.ErrUnexpectedEOF", err)
+	}
+
+	SetRandSource(nil)
+	if _, err := GenerateMnemonic(128); err != nil {
+		t.Errorf("GenerateMnemonic(128) after reset error = %v", err)
+	}
+}
+
 // =============================================================================
 // ValidateMnemonic Tests
 // =============================================================================
@@ -164,6 +211,86 @@ func TestValidateMnemonicString_Invalid(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// ValidateMnemonicPhrase Tests
+// =============================================================================
+
+func TestValidateMnemonicPhrase_Valid(t *testing.T) {
+	ok, err := ValidateMnemonicPhrase("  abandon abandon abandon abandon abandon abandon\tabandon abandon abandon abandon abandon about ")
+	if !ok || err != nil {
+		t.Errorf("ValidateMnemonicPhrase() = %v, %v; want true, nil", ok, err)
+	}
+}
+
+func TestValidateMnemonicPhrase_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		want     error
+	}{
+		{"empty", "", ErrInvalidMnemonicWordCount},
+		{"wrong word count", "abandon abandon abandon", ErrInvalidMnemonicWordCount},
+		{"unknown word", "abandon abandon zzz abandon abandon abandon abandon abandon abandon abandon abandon about", ErrInvalidMnemonicWord},
+		{"bad checksum", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", ErrInvalidMnemonicChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := ValidateMnemonicPhrase(tt.mnemonic)
+			if ok {
+				t.Fatal("ValidateMnemonicPhrase() = true, want false")
+			}
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ValidateMnemonicPhrase() error = %v, want %v", err, tt.want)
+			}
+			if !errors.Is(err, ErrInvalidMnemonic) {
+				t.Errorf("ValidateMnemonicPhrase() error = %v does not match ErrInvalidMnemonic", err)
+			}
+		})
+	}
+}
+
+func TestValidateMnemonicPhrase_ReportsWordPosition(t *testing.T) {
+	_, err := ValidateMnemonicPhrase("abandon abandon zzz abandon abandon abandon abandon abandon abandon abandon abandon about")
+
+	var wordErr *MnemonicWordError
+	if !errors.As(err, &wordErr) {
+		t.Fatalf("ValidateMnemonicPhrase() error = %v, want *MnemonicWordError", err)
+	}
+	if wordErr.Index != 2 || wordErr.Word != "zzz" {
+		t.Errorf("MnemonicWordError = {%d %q}, want {2 \"zzz\"}", wordErr.Index, wordErr.Word)
+	}
+}
+
+// =============================================================================
+// SuggestWords Tests
+// =============================================================================
+
+func TestSuggestWords(t *testing.T) {
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"aba", []string{"abandon"}},
+		{"zo", []string{"zone", "zoo"}},
+		{" ZO ", []string{"zone", "zoo"}},
+		{"abl", []string{"able"}},
+		{"xyz", nil},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		got := SuggestWords(tt.prefix)
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("SuggestWords(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+
+	if got := len(SuggestWords("a")); got != 136 {
+		t.Errorf("len(SuggestWords(\"a\")) = %d, want 136", got)
+	}
+}
+
 // =============================================================================
 // IsValidWord Tests
 // =============================================================================
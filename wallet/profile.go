@@ -0,0 +1,148 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// ProfileAccount is one derived account in a WalletProfile.
+type ProfileAccount struct {
+	// Index is the BIP44 account index (m/44'/73404'/Index')
+	Index int `json:"index"`
+	// Address is the account's address
+	Address types.Address `json:"address"`
+	// Label is an optional user-chosen name for the account
+	Label string `json:"label,omitempty"`
+}
+
+// WalletProfile is the public, watch-only view of a KeyStore: its addresses
+// and display metadata, without the seed, entropy, or mnemonic.
+//
+// It marshals to JSON and can be stored unencrypted, so a wallet can list its
+// accounts and balances before the user unlocks it. Nothing in a profile can
+// sign or derive further addresses.
+type WalletProfile struct {
+	// BaseAddress is the address at index 0, identifying the wallet
+	BaseAddress types.Address `json:"baseAddress"`
+	// Accounts lists the derived accounts in index order
+	Accounts []ProfileAccount `json:"accounts"`
+	// LastScannedIndex is the highest index checked for activity, for
+	// resuming discovery such as ScanAddresses
+	LastScannedIndex int `json:"lastScannedIndex"`
+	// Metadata holds any other JSON-encodable display data
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// PublicProfile derives the addresses at the given account indices and
+// returns them as a WalletProfile.
+//
+// Each keypair is destroyed as soon as its address is read. LastScannedIndex
+// starts at the highest index given; labels and metadata start empty.
+//
+// Parameters:
+//   - indices: Account indices to include, in any order (duplicates are
+//     rejected)
+//
+// Returns the profile, or an error if an index is negative or repeated, or
+// derivation fails.
+//
+// Example:
+//
+//	profile, err := keystore.PublicProfile([]int{0, 1, 2})
+//	if err != nil {
+//	    return err
+//	}
+//	profile.Accounts[1].Label = "Savings"
+//	data, _ := json.Marshal(profile)
+//	os.WriteFile("profile.json", data, 0o600)
+func (ks *KeyStore) PublicProfile(indices []int) (*WalletProfile, error) {
+	baseAddress, err := ks.GetBaseAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := append([]int(nil), indices...)
+	sort.Ints(sorted)
+	profile := &WalletProfile{BaseAddress: *baseAddress, Accounts: make([]ProfileAccount, 0, len(sorted))}
+	for i, index := range sorted {
+		if index < 0 {
+			return nil, fmt.Errorf("%w: %d", ErrInvalidDerivation, index)
+		}
+		if i > 0 && sorted[i-1] == index {
+			return nil, fmt.Errorf("duplicate account index %d", index)
+		}
+		kp, err := ks.GetKeyPair(index)
+		if err != nil {
+			return nil, err
+		}
+		address, err := kp.GetAddress()
+		kp.Destroy()
+		if err != nil {
+			return nil, err
+		}
+		profile.Accounts = append(profile.Accounts, ProfileAccount{Index: index, Address: *address})
+		profile.LastScannedIndex = index
+	}
+	return profile, nil
+}
+
+// LoadWalletProfile parses a WalletProfile saved as JSON.
+//
+// Parameters:
+//   - data: JSON produced by marshalling a WalletProfile
+//
+// Returns the profile with Accounts in index order, or an error if data is
+// not valid JSON, an address is malformed, or an index is negative or
+// repeated.
+//
+// Example:
+//
+//	data, err := os.ReadFile("profile.json")
+//	if err != nil {
+//	    return err
+//	}
+//	profile, err := wallet.LoadWalletProfile(data)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, address := range profile.Addresses() {
+//	    showBalance(address)
+//	}
+func LoadWalletProfile(data []byte) (*WalletProfile, error) {
+	profile := new(WalletProfile)
+	if err := json.Unmarshal(data, profile); err != nil {
+		return nil, fmt.Errorf("failed to parse wallet profile: %w", err)
+	}
+	sort.Slice(profile.Accounts, func(i, j int) bool { return profile.Accounts[i].Index < profile.Accounts[j].Index })
+	for i, account := range profile.Accounts {
+		if account.Index < 0 {
+			return nil, fmt.Errorf("%w: %d", ErrInvalidDerivation, account.Index)
+		}
+		if i > 0 && profile.Accounts[i-1].Index == account.Index {
+			return nil, fmt.Errorf("duplicate account index %d", account.Index)
+		}
+	}
+	return profile, nil
+}
+
+// Addresses returns the profile's addresses in index order.
+func (wp *WalletProfile) Addresses() []types.Address {
+	addresses := make([]types.Address, len(wp.Accounts))
+	for i, account := range wp.Accounts {
+		addresses[i] = account.Address
+	}
+	return addresses
+}
+
+// Account returns the profile entry for an account index, if present.
+func (wp *WalletProfile) Account(index int) (ProfileAccount, bool) {
+	for _, account := range wp.Accounts {
+		if account.Index == index {
+			return account, true
+		}
+	}
+	return ProfileAccount{}, false
+}
@@ -0,0 +1,105 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestPublicProfile_ContainsNoSecrets(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	ks, err := NewKeyStoreFromMnemonic(mnemonic)
+	if err != nil {
+		t.Fatalf("NewKeyStoreFromMnemonic() error = %v", err)
+	}
+
+	profile, err := ks.PublicProfile([]int{2, 0, 1})
+	if err != nil {
+		t.Fatalf("PublicProfile() error = %v", err)
+	}
+	profile.Accounts[1].Label = "Savings"
+	profile.Metadata = map[string]interface{}{"theme": "dark"}
+	data, err := json.Marshal(profile)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	text := strings.ToLower(string(data))
+	secrets := map[string]string{
+		"mnemonic": "abandon",
+		"entropy":  hex.EncodeToString(ks.Entropy),
+		"seed":     hex.EncodeToString(ks.Seed),
+	}
+	for name, secret := range secrets {
+		if strings.Contains(text, secret) {
+			t.Errorf("profile JSON contains the %s", name)
+		}
+	}
+	for _, field := range []string{"mnemonic", "entropy", "seed", "private"} {
+		if strings.Contains(text, field) {
+			t.Errorf("profile JSON has a %q field", field)
+		}
+	}
+
+	kp, _ := ks.GetKeyPair(0)
+	for _, secret := range [][]byte{kp.GetPrivateKey(), kp.GetPrivateKey()[:32]} {
+		if strings.Contains(text, hex.EncodeToString(secret)) {
+			t.Error("profile JSON contains private key material")
+		}
+	}
+}
+
+func TestLoadWalletProfile_RoundTrip(t *testing.T) {
+	ks, err := NewKeyStoreFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	if err != nil {
+		t.Fatalf("NewKeyStoreFromMnemonic() error = %v", err)
+	}
+	profile, err := ks.PublicProfile([]int{0, 1, 5})
+	if err != nil {
+		t.Fatalf("PublicProfile() error = %v", err)
+	}
+	profile.Accounts[2].Label = "Trading"
+	data, _ := json.Marshal(profile)
+
+	loaded, err := LoadWalletProfile(data)
+	if err != nil {
+		t.Fatalf("LoadWalletProfile() error = %v", err)
+	}
+	if loaded.BaseAddress != profile.BaseAddress || loaded.LastScannedIndex != 5 {
+		t.Errorf("loaded = %+v, want %+v", loaded, profile)
+	}
+	addresses := loaded.Addresses()
+	if len(addresses) != 3 {
+		t.Fatalf("Addresses() returned %d, want 3", len(addresses))
+	}
+	for i, index := range []int{0, 1, 5} {
+		kp, _ := ks.GetKeyPair(index)
+		want, _ := kp.GetAddress()
+		if addresses[i] != *want {
+			t.Errorf("address %d = %s, want %s", index, addresses[i], want)
+		}
+	}
+	if account, ok := loaded.Account(5); !ok || account.Label != "Trading" {
+		t.Errorf("Account(5) = %+v, %v, want label Trading", account, ok)
+	}
+	if _, ok := loaded.Account(3); ok {
+		t.Error("Account(3) should be absent")
+	}
+}
+
+func TestWalletProfile_Rejects(t *testing.T) {
+	ks, _ := NewKeyStoreFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	if _, err := ks.PublicProfile([]int{1, 1}); err == nil {
+		t.Error("PublicProfile() should reject duplicate indices")
+	}
+	if _, err := ks.PublicProfile([]int{-1}); err == nil {
+		t.Error("PublicProfile() should reject negative indices")
+	}
+	if _, err := LoadWalletProfile([]byte("{")); err == nil {
+		t.Error("LoadWalletProfile() should reject malformed JSON")
+	}
+	if _, err := LoadWalletProfile([]byte(`{"accounts":[{"index":0,"address":"z1notanaddress"}]}`)); err == nil {
+		t.Error("LoadWalletProfile() should reject a malformed address")
+	}
+}
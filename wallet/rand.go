@@ -0,0 +1,53 @@
+package wallet
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+)
+
+var (
+	randSourceMu sync.RWMutex
+	randSource   io.Reader = rand.Reader
+)
+
+// SetRandSource replaces the entropy source used by GenerateMnemonic, and so by
+// NewKeyStoreRandom and NewKeyStoreRandomWithStrength. Passing nil restores
+// the default, crypto/rand.Reader.
+//
+// This exists for tests only: a seeded reader makes generated mnemonics
+// reproducible, so golden tests can pin the resulting addresses. NEVER call it
+// in production code. Any predictable reader makes every generated wallet
+// recoverable by whoever knows the seed.
+//
+// The source is package-wide, so tests that set it must not run in parallel
+// with other wallet generation and should restore the default when done.
+//
+// Example:
+//
+//	wallet.SetRandSource(bytes.NewReader(bytes.Repeat([]byte{0x01}, 32)))
+//	defer wallet.SetRandSource(nil)
+//
+//	keystore, _ := wallet.NewKeyStoreRandom()
+//	// keystore.Mnemonic is the same on every run
+func SetRandSource(r io.Reader) {
+	if r == nil {
+		r = rand.Reader
+	}
+	randSourceMu.Lock()
+	randSource = r
+	randSourceMu.Unlock()
+}
+
+// readEntropy fills a new slice of n bytes from the current entropy source.
+func readEntropy(n int) ([]byte, error) {
+	randSourceMu.RLock()
+	source := randSource
+	randSourceMu.RUnlock()
+
+	entropy := make([]byte, n)
+	if _, err := io.ReadFull(source, entropy); err != nil {
+		return nil, err
+	}
+	return entropy, nil
+}
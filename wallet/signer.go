@@ -0,0 +1,26 @@
+package wallet
+
+import (
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// Signer signs account blocks on behalf of one address.
+//
+// It is the extension point for keys that cannot live in a KeyPair, such as
+// hardware wallets, HSMs, or cloud KMS. The transaction flow in the zenon
+// package only needs the account's public key and address and a signature
+// over each block hash, so an implementation never has to expose its private
+// key. *KeyPair implements Signer.
+//
+// Methods use the KeyPair naming so that KeyPair satisfies the interface
+// without changing its existing Sign(message) method.
+type Signer interface {
+	// SignHash returns the Ed25519 signature of the 32-byte block hash.
+	SignHash(hash types.Hash) ([]byte, error)
+	// GetPublicKey returns the 32-byte Ed25519 public key.
+	GetPublicKey() ([]byte, error)
+	// GetAddress returns the address derived from the public key.
+	GetAddress() (*types.Address, error)
+}
+
+var _ Signer = (*KeyPair)(nil)
@@ -0,0 +1,116 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// syriusKeyFile mirrors the key-file layout written by the Zenon Dart SDK and
+// the syrius wallet. Field names and order match the Dart EncryptedFile JSON,
+// which records only the Argon2 salt and relies on the fixed Dart parameters
+// (64 MiB memory, one iteration, four lanes, 32-byte key).
+type syriusKeyFile struct {
+	BaseAddress string             `json:"baseAddress"`
+	Crypto      syriusCryptoParams `json:"crypto"`
+	Timestamp   int64              `json:"timestamp"`
+	Version     int                `json:"version"`
+}
+
+type syriusCryptoParams struct {
+	Argon2Params syriusArgon2Params `json:"argon2Params"`
+	CipherData   string             `json:"cipherData"`
+	CipherName   string             `json:"cipherName"`
+	Kdf          string             `json:"kdf"`
+	Nonce        string             `json:"nonce"`
+}
+
+type syriusArgon2Params struct {
+	Salt string `json:"salt"`
+}
+
+// ToSyriusFile encrypts the keystore in the key-file layout used by the Zenon
+// Dart SDK and the syrius wallet.
+//
+// The payload is the raw BIP39 entropy encrypted with Argon2id and
+// AES-256-GCM, exactly as [KeyStore.ToEncryptedFile] does. The JSON differs
+// only in layout: it contains just baseAddress, crypto, timestamp, and
+// version, and argon2Params records only the salt because the Dart SDK always
+// uses the default Argon2 parameters.
+//
+// Parameters:
+//   - password: UTF-8 password used for Argon2id key derivation.
+//
+// ToSyriusFile returns the compact JSON key file, or an error if the keystore
+// has no valid BIP39 entropy or encryption fails.
+//
+// Example:
+//
+//	data, err := keystore.ToSyriusFile("correct horse battery staple")
+//	if err != nil {
+//		return err
+//	}
+//	err = os.WriteFile(filepath.Join(syriusWalletDir, address), data, 0600)
+//
+// See FromSyriusFile to read files produced by syrius or the Dart SDK.
+func (ks *KeyStore) ToSyriusFile(password string) ([]byte, error) {
+	file, err := ks.ToEncryptedFile(password, nil)
+	if err != nil {
+		return nil, err
+	}
+	baseAddress, _ := file.Metadata[BaseAddressKey].(string) //nolint:errcheck // always set by ToEncryptedFile
+
+	return json.Marshal(syriusKeyFile{
+		BaseAddress: baseAddress,
+		Crypto: syriusCryptoParams{
+			Argon2Params: syriusArgon2Params{Salt: file.Crypto.Argon2Params.Salt},
+			CipherData:   file.Crypto.CipherData,
+			CipherName:   file.Crypto.CipherName,
+			Kdf:          file.Crypto.Kdf,
+			Nonce:        file.Crypto.Nonce,
+		},
+		Timestamp: file.Timestamp,
+		Version:   file.Version,
+	})
+}
+
+// FromSyriusFile decrypts a key file written by the Zenon Dart SDK or the
+// syrius wallet.
+//
+// The file is read with the Dart field names and the default Argon2
+// parameters, then validated like [FromEncryptedFile]: the decrypted entropy
+// must derive the address recorded in baseAddress.
+//
+// Parameters:
+//   - data: Key-file JSON as written by syrius or the Dart SDK.
+//   - password: UTF-8 password used when the key file was created.
+//
+// FromSyriusFile returns the decrypted KeyStore. It returns
+// [ErrIncorrectPassword] when authentication fails and [ErrInvalidKeyStore]
+// when the JSON is malformed or the base address does not match.
+//
+// Example:
+//
+//	data, err := os.ReadFile(syriusKeyFilePath)
+//	if err != nil {
+//		return err
+//	}
+//	keystore, err := wallet.FromSyriusFile(data, password)
+func FromSyriusFile(data []byte, password string) (*KeyStore, error) {
+	var file syriusKeyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKeyStore, err)
+	}
+
+	return FromEncryptedFile(&EncryptedFile{
+		Metadata: map[string]interface{}{BaseAddressKey: file.BaseAddress},
+		Crypto: &CryptoParams{
+			Argon2Params: &Argon2Params{Salt: file.Crypto.Argon2Params.Salt},
+			CipherData:   file.Crypto.CipherData,
+			CipherName:   file.Crypto.CipherName,
+			Kdf:          file.Crypto.Kdf,
+			Nonce:        file.Crypto.Nonce,
+		},
+		Timestamp: file.Timestamp,
+		Version:   file.Version,
+	}, password)
+}
@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFromSyriusFileDartFixture(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "syrius_keyfile.json"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	store, err := FromSyriusFile(data, "password")
+	if err != nil {
+		t.Fatalf("FromSyriusFile() error = %v", err)
+	}
+	address, err := store.GetBaseAddress()
+	if err != nil {
+		t.Fatalf("GetBaseAddress() error = %v", err)
+	}
+	if got, want := address.String(), "z1qq9n7fpaqd8lpcljandzmx4xtku9w4ftwyg0mq"; got != want {
+		t.Fatalf("base address = %s, want %s", got, want)
+	}
+	if got, want := hex.EncodeToString(store.Entropy), "00e089c2d43064b3462ce24fc09099fe9fd2cf3657b6335462972baa911d31fc"; got != want {
+		t.Fatalf("entropy = %s, want %s", got, want)
+	}
+
+	if _, err := FromSyriusFile(data, "wrong"); !errors.Is(err, ErrIncorrectPassword) {
+		t.Fatalf("FromSyriusFile() wrong password error = %v, want ErrIncorrectPassword", err)
+	}
+}
+
+func TestToSyriusFileUsesDartLayout(t *testing.T) {
+	store, err := NewKeyStoreFromEntropy(bytes.Repeat([]byte{0x5a}, 32))
+	if err != nil {
+		t.Fatalf("NewKeyStoreFromEntropy() error = %v", err)
+	}
+
+	data, err := store.ToSyriusFile("password")
+	if err != nil {
+		t.Fatalf("ToSyriusFile() error = %v", err)
+	}
+
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(data, &document); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	for _, field := range []string{"baseAddress", "crypto", "timestamp", "version"} {
+		if _, ok := document[field]; !ok {
+			t.Errorf("key file is missing %q", field)
+		}
+	}
+	if len(document) != 4 {
+		t.Errorf("key file has %d top-level fields, want 4", len(document))
+	}
+	var crypto struct {
+		Argon2Params map[string]json.RawMessage `json:"argon2Params"`
+	}
+	if err := json.Unmarshal(document["crypto"], &crypto); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if _, ok := crypto.Argon2Params["salt"]; !ok || len(crypto.Argon2Params) != 1 {
+		t.Errorf("argon2Params = %v, want only salt", crypto.Argon2Params)
+	}
+
+	restored, err := FromSyriusFile(data, "password")
+	if err != nil {
+		t.Fatalf("FromSyriusFile() error = %v", err)
+	}
+	if !bytes.Equal(restored.Entropy, store.Entropy) {
+		t.Fatalf("restored entropy = %x, want %x", restored.Entropy, store.Entropy)
+	}
+}
+
+func TestFromSyriusFileRejectsMalformedJSON(t *testing.T) {
+	if _, err := FromSyriusFile([]byte("{"), "password"); !errors.Is(err, ErrInvalidKeyStore) {
+		t.Fatalf("FromSyriusFile() error = %v, want ErrInvalidKeyStore", err)
+	}
+}
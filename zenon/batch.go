@@ -0,0 +1,101 @@
+package zenon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/0x3639/znn-sdk-go/api"
+	"github.com/0x3639/znn-sdk-go/wallet"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// SendRequest describes one transfer of a SendBatch.
+type SendRequest struct {
+	// ToAddress is the recipient
+	ToAddress types.Address
+	// TokenStandard is the token to send (for example types.ZnnTokenStandard)
+	TokenStandard types.ZenonTokenStandard
+	// Amount is the raw amount in base units; it must be positive
+	// (api.ErrNonPositiveAmount otherwise)
+	Amount *big.Int
+	// Data is optional payload attached to the send block
+	Data []byte
+}
+
+// BatchSendError reports which request of a SendBatch failed.
+//
+// Requests before Index were published; Index and everything after it were
+// not. When the batch was cancelled, Err wraps the context's error. Unwrap
+// returns the underlying error, so errors.Is and errors.As see through it
+// (for example to api.ErrInsufficientBalance).
+type BatchSendError struct {
+	// Index is the position in the sends slice of the request that failed
+	Index int
+	// Err is the error returned while validating, preparing, or publishing it
+	Err error
+}
+
+func (e *BatchSendError) Error() string {
+	return fmt.Sprintf("batch send %d failed: %v", e.Index, e.Err)
+}
+
+func (e *BatchSendError) Unwrap() error {
+	return e.Err
+}
+
+// SendBatch publishes one send block per request, in order, from the signer's
+// account.
+//
+// Every request is validated before anything is published. Each block then
+// goes through Send, which re-reads the account frontier, so every block
+// builds on the one published before it and plasma or PoW is resolved per
+// block.
+//
+// The context is checked before each request and during its PoW, and a block
+// is only published if the context is still live once it is signed. A
+// cancelled batch therefore stops cleanly between blocks: everything returned
+// is on chain and nothing after it was published.
+//
+// Parameters:
+//   - ctx: Bounds the whole batch
+//   - signer: The wallet.Signer (for example a *wallet.KeyPair) of the paying
+//     account
+//   - sends: Transfers to publish, in order
+//
+// Returns the published blocks. On failure it returns the blocks published so
+// far together with a *BatchSendError whose Index identifies the failed
+// request; pass sends[err.Index:] to SendBatch to resume. If ctx is done, that
+// error wraps ctx.Err().
+//
+// Example:
+//
+//	published, err := z.SendBatch(ctx, keyPair, payroll)
+//	var batchErr *zenon.BatchSendError
+//	if errors.As(err, &batchErr) {
+//	    log.Printf("paid %d of %d: %v", len(published), len(payroll), batchErr.Err)
+//	    remaining := payroll[batchErr.Index:]
+//	    // fix the cause, then z.SendBatch(ctx, keyPair, remaining)
+//	}
+func (z *Zenon) SendBatch(ctx context.Context, signer wallet.Signer, sends []SendRequest) ([]*nom.AccountBlock, error) {
+	for i, send := range sends {
+		if send.Amount == nil || send.Amount.Sign() <= 0 {
+			return nil, &BatchSendError{Index: i, Err: fmt.Errorf("%w, got %v", api.ErrNonPositiveAmount, send.Amount)}
+		}
+	}
+
+	published := make([]*nom.AccountBlock, 0, len(sends))
+	for i, send := range sends {
+		if err := ctx.Err(); err != nil {
+			return published, &BatchSendError{Index: i, Err: err}
+		}
+		transaction := z.client.LedgerApi.SendTemplate(send.ToAddress, send.TokenStandard, send.Amount, send.Data)
+		block, err := z.send(ctx, transaction, signer)
+		if err != nil {
+			return published, &BatchSendError{Index: i, Err: err}
+		}
+		published = append(published, block)
+	}
+	return published, nil
+}
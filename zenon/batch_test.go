@@ -0,0 +1,133 @@
+package zenon
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0x3639/znn-sdk-go/api"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func batchRequests() []SendRequest {
+	return []SendRequest{
+		{ToAddress: types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz"), TokenStandard: types.ZnnTokenStandard, Amount: big.NewInt(100)},
+		{ToAddress: types.ParseAddressPanic("z1qqjnwjjpnue8xmmpanz6csze6tcmtzzdtfsww7"), TokenStandard: types.QsrTokenStandard, Amount: big.NewInt(200), Data: []byte("memo")},
+		{ToAddress: types.PlasmaContract, TokenStandard: types.ZnnTokenStandard, Amount: big.NewInt(300)},
+	}
+}
+
+func TestSendBatchPublishesInOrderOnRefreshedFrontier(t *testing.T) {
+	fixture := &zenonRPCFixture{
+		momentum:              testMomentum(10, 1, types.ZeroHash),
+		errors:                make(map[string]string),
+		frontierFromPublished: true,
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	sends := batchRequests()
+	published, err := NewZenon(client).SendBatch(context.Background(), testKeyPair(t), sends)
+	if err != nil {
+		t.Fatalf("SendBatch: %v", err)
+	}
+	if len(published) != len(sends) || len(fixture.publishedBlocks) != len(sends) {
+		t.Fatalf("published %d/%d blocks, want %d", len(published), len(fixture.publishedBlocks), len(sends))
+	}
+	for i, block := range fixture.publishedBlocks {
+		send := sends[i]
+		if block.ToAddress != send.ToAddress || block.TokenStandard != send.TokenStandard || block.Amount.Cmp(send.Amount) != 0 || string(block.Data) != string(send.Data) {
+			t.Errorf("block %d = to %s %s %s data %q, want request %d", i, block.ToAddress, block.Amount, block.TokenStandard, block.Data, i)
+		}
+		if block.Height != uint64(i+1) {
+			t.Errorf("block %d height = %d, want %d", i, block.Height, i+1)
+		}
+		if i > 0 && block.PreviousHash != fixture.publishedBlocks[i-1].Hash {
+			t.Errorf("block %d previous hash = %s, want %s", i, block.PreviousHash, fixture.publishedBlocks[i-1].Hash)
+		}
+		if published[i].Hash != block.Hash {
+			t.Errorf("returned block %d hash = %s, want %s", i, published[i].Hash, block.Hash)
+		}
+	}
+}
+
+func TestSendBatchReturnsPublishedBlocksAndFailedIndex(t *testing.T) {
+	fixture := &zenonRPCFixture{
+		momentum:              testMomentum(10, 1, types.ZeroHash),
+		errors:                make(map[string]string),
+		frontierFromPublished: true,
+		publishErrors:         map[int]string{1: "insufficient balance"},
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	published, err := NewZenon(client).SendBatch(context.Background(), testKeyPair(t), batchRequests())
+	var batchErr *BatchSendError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("SendBatch error = %v, want *BatchSendError", err)
+	}
+	if batchErr.Index != 1 {
+		t.Errorf("BatchSendError.Index = %d, want 1", batchErr.Index)
+	}
+	if !errors.Is(err, api.ErrInsufficientBalance) {
+		t.Errorf("SendBatch error = %v, want it to match api.ErrInsufficientBalance", err)
+	}
+	if len(published) != 1 || published[0].Amount.Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("published = %v, want only the first block", published)
+	}
+	if fixture.publishAttempts != 2 {
+		t.Errorf("publish attempts = %d, want the batch to stop at the failure", fixture.publishAttempts)
+	}
+}
+
+func TestSendBatchValidatesBeforePublishing(t *testing.T) {
+	fixture := &zenonRPCFixture{
+		momentum: testMomentum(10, 1, types.ZeroHash),
+		errors:   make(map[string]string),
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	sends := batchRequests()
+	sends[2].Amount = big.NewInt(0)
+	published, err := NewZenon(client).SendBatch(context.Background(), testKeyPair(t), sends)
+	var batchErr *BatchSendError
+	if !errors.As(err, &batchErr) || batchErr.Index != 2 {
+		t.Fatalf("SendBatch error = %v, want *BatchSendError at index 2", err)
+	}
+	if !errors.Is(err, api.ErrNonPositiveAmount) {
+		t.Errorf("SendBatch error = %v, want it to match api.ErrNonPositiveAmount", err)
+	}
+	if len(published) != 0 || len(fixture.calls) != 0 {
+		t.Fatalf("published %d blocks with %d calls, want nothing sent", len(published), len(fixture.calls))
+	}
+}
+
+func TestSendBatchStopsWhenCancelled(t *testing.T) {
+	fixture := &zenonRPCFixture{
+		momentum:              testMomentum(10, 1, types.ZeroHash),
+		errors:                make(map[string]string),
+		frontierFromPublished: true,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fixture.onPublish = cancel
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	published, err := NewZenon(client).SendBatch(ctx, testKeyPair(t), batchRequests())
+	var batchErr *BatchSendError
+	if !errors.As(err, &batchErr) || batchErr.Index != 1 {
+		t.Fatalf("SendBatch error = %v, want *BatchSendError at index 1", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("SendBatch error = %v, want it to match context.Canceled", err)
+	}
+	if len(published) != 1 || len(fixture.publishedBlocks) != 1 || published[0].Hash != fixture.publishedBlocks[0].Hash {
+		t.Fatalf("published %d blocks (%d on node), want only the first", len(published), len(fixture.publishedBlocks))
+	}
+	if fixture.publishAttempts != 1 {
+		t.Errorf("publish attempts = %d, want 1", fixture.publishAttempts)
+	}
+}
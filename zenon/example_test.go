@@ -0,0 +1,58 @@
+package zenon_test
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/0x3639/znn-sdk-go/api/embedded"
+	"github.com/0x3639/znn-sdk-go/rpc_client"
+	"github.com/0x3639/znn-sdk-go/wallet"
+	"github.com/0x3639/znn-sdk-go/zenon"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	nodeapi "github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// ExampleZenon_Send_mockClient runs the whole send flow (autofill, plasma
+// check, signing, publishing) offline against a MockClient.
+func ExampleZenon_Send_mockClient() {
+	seed := make([]byte, 32)
+	keyPair, err := wallet.NewKeyPairFromSeed(seed)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mock := rpc_client.NewMockClient().
+		On("ledger.getFrontierAccountBlock", nil). // a brand-new account
+		On("ledger.getFrontierMomentum", &nodeapi.Momentum{Momentum: &nom.Momentum{
+			Height: 1000, ChainIdentifier: 1,
+			Hash: types.HexToHashPanic("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		}}).
+		On("embedded.plasma.getRequiredPoWForAccountBlock", embedded.GetRequiredResult{
+			AvailablePlasma: 21000, BasePlasma: 21000,
+		}).
+		On("ledger.publishRawTransaction", nil)
+
+	client := rpc_client.NewRpcClientWithCaller(mock)
+	defer client.Stop()
+
+	recipient := types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")
+	template := client.LedgerApi.SendTemplate(recipient, types.ZnnTokenStandard, big.NewInt(150000000), nil)
+
+	published, err := zenon.NewZenon(client).Send(template, keyPair)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	sent := mock.CallsTo("ledger.publishRawTransaction")[0].Params[0].(*nom.AccountBlock)
+	fmt.Println("height:", published.Height)
+	fmt.Println("momentum:", published.MomentumAcknowledged.Height)
+	fmt.Println("signed:", len(sent.Signature) == 64)
+	fmt.Println("calls:", len(mock.Calls()))
+	// Output:
+	// height: 1
+	// momentum: 1000
+	// signed: true
+	// calls: 4
+}
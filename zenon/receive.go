@@ -0,0 +1,239 @@
+package zenon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/0x3639/znn-sdk-go/wallet"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	"github.com/zenon-network/go-zenon/rpc/api"
+)
+
+// receiveConfirmationPollInterval is how often ReceiveAndConfirm asks the node
+// whether the published receive block has been confirmed.
+const receiveConfirmationPollInterval = time.Second
+
+// ReceiveAndConfirm receives a send block and waits until the receive block is
+// confirmed in a momentum.
+//
+// It builds the receive template for fromBlockHash, runs it through the same
+// autofill, PoW/plasma, and signing flow as Send, publishes it, and then polls
+// the node with LedgerApi.WaitForConfirmation until a momentum includes it.
+//
+// Parameters:
+//   - ctx: Bounds the whole call. It is checked before each node request,
+//     cancels PoW generation, and ends the wait for confirmation
+//   - signer: The wallet.Signer of the account the send block was addressed to
+//   - fromBlockHash: Hash of the unreceived send block
+//
+// Returns the confirmed receive block as reported by the node, or an error if
+// preparing, publishing, or waiting fails. If ctx is done after the block was
+// published, the block stays published and may still be confirmed later; the
+// returned error wraps ctx.Err() and names the receive block hash.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+//	defer cancel()
+//	block, err := z.ReceiveAndConfirm(ctx, keyPair, sendBlockHash)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("Received in momentum %d\n", block.ConfirmationDetail.MomentumHeight)
+func (z *Zenon) ReceiveAndConfirm(ctx context.Context, signer wallet.Signer, fromBlockHash types.Hash) (*api.AccountBlock, error) {
+	published, err := z.send(ctx, z.client.LedgerApi.ReceiveTemplate(fromBlockHash), signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive block %s: %w", fromBlockHash, err)
+	}
+
+	confirmed, err := z.client.LedgerApi.WaitForConfirmation(ctx, published.Hash, receiveConfirmationPollInterval)
+	if err != nil {
+		return nil, fmt.Errorf("receive block %s published but not confirmed: %w", published.Hash, err)
+	}
+	return confirmed, nil
+}
+
+// ReceiveOptions selects which unreceived blocks ReceiveAll receives. The zero
+// value receives every block.
+type ReceiveOptions struct {
+	// MinAmount skips blocks below this amount, in base units of each block's
+	// token, so dust sent to the account is not received at the cost of plasma
+	// or PoW. Nil or zero receives every block.
+	MinAmount *big.Int
+	// IncludeZeroAmount receives zero-amount blocks, such as notifications
+	// from contract calls, even when MinAmount is positive.
+	IncludeZeroAmount bool
+}
+
+// ReceiveAll publishes a receive block for every unreceived send block
+// addressed to the signer's account, of any token, in the order the node
+// lists them.
+//
+// A node lists at most api.MaxUnreceivedBlocks pending blocks. While it
+// reports more, ReceiveAll lists again after receiving, so later blocks come
+// into view; it stops once a listing holds nothing new to receive.
+//
+// Each receive goes through the same flow as Send, so plasma or PoW is
+// resolved per block. The context is checked before each block and during its
+// PoW, and a block is only published if the context is still live once it is
+// signed, so a cancelled run stops cleanly between blocks.
+//
+// Parameters:
+//   - ctx: Bounds the whole run
+//   - signer: The wallet.Signer of the receiving account
+//   - opts: Which blocks to receive (see ReceiveOptions); blocks it skips stay
+//     unreceived, as listed by LedgerApi.GetUnreceivedAboveThreshold
+//
+// Returns the published receive blocks. On failure, or when ctx is done, it
+// returns the blocks published so far together with the error, which wraps
+// ctx.Err() on cancellation. Calling ReceiveAll again continues with the
+// blocks that are still unreceived.
+//
+// Example:
+//
+//	// Receive payments of at least 0.01 ZNN/QSR and contract notifications,
+//	// leaving dust pending
+//	received, err := z.ReceiveAll(ctx, keyPair, zenon.ReceiveOptions{
+//	    MinAmount:         big.NewInt(1000000),
+//	    IncludeZeroAmount: true,
+//	})
+//	fmt.Printf("Received %d blocks\n", len(received))
+//	if errors.Is(err, context.Canceled) {
+//	    return nil // the user closed the dialog; the rest stays pending
+//	}
+//
+// Note: When skipped dust fills all the blocks the node lists, payments
+// behind it stay out of view until some of that dust is received.
+func (z *Zenon) ReceiveAll(ctx context.Context, signer wallet.Signer, opts ReceiveOptions) ([]*nom.AccountBlock, error) {
+	address, err := signer.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive address: %w", err)
+	}
+
+	var received []*nom.AccountBlock
+	// The node may list a block again until our receive is confirmed.
+	done := make(map[types.Hash]bool)
+	for {
+		pending, more, err := z.client.LedgerApi.GetUnreceivedAboveThreshold(*address, opts.MinAmount, opts.IncludeZeroAmount)
+		if err != nil {
+			return received, err
+		}
+		receivedBefore := len(received)
+		for _, block := range pending {
+			if done[block.Hash] {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return received, fmt.Errorf("received %d blocks: %w", len(received), err)
+			}
+			published, err := z.send(ctx, z.client.LedgerApi.ReceiveTemplate(block.Hash), signer)
+			if err != nil {
+				return received, fmt.Errorf("failed to receive block %s: %w", block.Hash, err)
+			}
+			done[block.Hash] = true
+			received = append(received, published)
+		}
+		if !more || len(received) == receivedBefore {
+			return received, nil
+		}
+	}
+}
+
+// ReceiveUntilBalance receives incoming funds for the signer's account until
+// its balance of a token reaches minAmount, or ctx is done.
+//
+// Each round it publishes a receive block for every unreceived send block of
+// zts addressed to the account, listing again while the node reports more
+// pending than it lists, then reads the account balance. If the
+// balance is still short, it waits pollInterval and repeats. Unlike
+// LedgerApi.WaitForBalance, funds sent to the account count as soon as they
+// arrive, without anyone else receiving them. Receive blocks go through the
+// same flow as Send, so plasma or PoW is resolved per block.
+//
+// Parameters:
+//   - ctx: Bounds the whole call, including PoW for each receive block
+//   - signer: The wallet.Signer of the account to fund
+//   - zts: Token standard to wait for (for example types.ZnnTokenStandard)
+//   - minAmount: Balance to wait for, in base units
+//   - pollInterval: Delay between rounds (values <= 0 default to one second)
+//
+// Returns the observed balance once it is at least minAmount, or an error if
+// listing, receiving, or reading the balance fails. If ctx ends first, the
+// error wraps ctx.Err(); receive blocks published so far stay published.
+//
+// Example - Faucet onboarding:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+//	defer cancel()
+//	balance, err := z.ReceiveUntilBalance(ctx, keyPair, types.ZnnTokenStandard, big.NewInt(100000000), 5*time.Second)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("Funded with %s ZNN\n", utils.AddDecimals(balance, 8))
+func (z *Zenon) ReceiveUntilBalance(ctx context.Context, signer wallet.Signer, zts types.ZenonTokenStandard, minAmount *big.Int, pollInterval time.Duration) (*big.Int, error) {
+	if minAmount == nil {
+		return nil, fmt.Errorf("minAmount cannot be nil")
+	}
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	address, err := signer.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive address: %w", err)
+	}
+
+	received := make(map[types.Hash]bool)
+	for {
+		if err := z.receiveToken(ctx, signer, *address, zts, received); err != nil {
+			return nil, err
+		}
+
+		info, err := z.client.LedgerApi.GetAccountInfoByAddress(*address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account info: %w", err)
+		}
+		balance := info.Balance(zts)
+		if balance.Cmp(minAmount) >= 0 {
+			return balance, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return balance, fmt.Errorf("waiting for balance of %s: %w", address, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// receiveToken publishes a receive block for each unreceived block of zts the
+// node lists for address. While the node reports more blocks than it lists,
+// it lists again after receiving, until a listing holds no new block of zts.
+//
+// Hashes of received blocks are added to received, and blocks already in it
+// are skipped, because the node may list a block again until its receive is
+// confirmed.
+func (z *Zenon) receiveToken(ctx context.Context, signer wallet.Signer, address types.Address, zts types.ZenonTokenStandard, received map[types.Hash]bool) error {
+	for {
+		pending, more, err := z.client.LedgerApi.GetListedUnreceivedBlocks(address)
+		if err != nil {
+			return err
+		}
+		receivedAny := false
+		for _, block := range pending {
+			if block.TokenStandard != zts || received[block.Hash] {
+				continue
+			}
+			if _, err := z.send(ctx, z.client.LedgerApi.ReceiveTemplate(block.Hash), signer); err != nil {
+				return fmt.Errorf("failed to receive block %s: %w", block.Hash, err)
+			}
+			received[block.Hash] = true
+			receivedAny = true
+		}
+		if !more || !receivedAny {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,383 @@
+package zenon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0x3639/znn-sdk-go/api/embedded"
+	"github.com/0x3639/znn-sdk-go/pow"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	nodeapi "github.com/zenon-network/go-zenon/rpc/api"
+)
+
+func receiveFixture(t *testing.T) (*zenonRPCFixture, types.Hash) {
+	t.Helper()
+	address, err := testKeyPair(t).GetAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendHash := types.HexToHashPanic("3333333333333333333333333333333333333333333333333333333333333333")
+	return &zenonRPCFixture{
+		momentum: testMomentum(10, 1, types.ZeroHash),
+		source: &nodeapi.AccountBlock{AccountBlock: nom.AccountBlock{
+			BlockType: nom.BlockTypeUserSend, Hash: sendHash, ToAddress: *address,
+			TokenStandard: types.ZnnTokenStandard, Amount: big.NewInt(5),
+		}},
+		pow:    embedded.GetRequiredResult{BasePlasma: 21000},
+		errors: make(map[string]string),
+	}, sendHash
+}
+
+func TestReceiveAndConfirmReturnsConfirmedBlock(t *testing.T) {
+	fixture, sendHash := receiveFixture(t)
+	fixture.confirmPublished = true
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	block, err := NewZenon(client).ReceiveAndConfirm(context.Background(), testKeyPair(t), sendHash)
+	if err != nil {
+		t.Fatalf("ReceiveAndConfirm: %v", err)
+	}
+	if len(fixture.publishedBlocks) != 1 {
+		t.Fatalf("published %d blocks, want 1", len(fixture.publishedBlocks))
+	}
+	published := fixture.publishedBlocks[0]
+	if published.BlockType != nom.BlockTypeUserReceive || published.FromBlockHash != sendHash {
+		t.Fatalf("published block = type %d from %s, want receive of %s", published.BlockType, published.FromBlockHash, sendHash)
+	}
+	if block.Hash != published.Hash || block.ConfirmationDetail == nil || block.ConfirmationDetail.MomentumHeight != 11 {
+		t.Fatalf("confirmed block = %s %+v, want %s confirmed at 11", block.Hash, block.ConfirmationDetail, published.Hash)
+	}
+}
+
+func TestReceiveAndConfirmHonoursContext(t *testing.T) {
+	t.Run("cancelled before publish", func(t *testing.T) {
+		fixture, sendHash := receiveFixture(t)
+		client, cleanup := newZenonTestClient(t, fixture)
+		defer cleanup()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewZenon(client).ReceiveAndConfirm(ctx, testKeyPair(t), sendHash)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("error = %v, want context.Canceled", err)
+		}
+		if len(fixture.calls) != 0 {
+			t.Fatalf("RPC calls = %v, want none", fixture.calls)
+		}
+	})
+
+	t.Run("cancelled during PoW", func(t *testing.T) {
+		fixture, sendHash := receiveFixture(t)
+		fixture.pow = embedded.GetRequiredResult{RequiredDifficulty: pow.MaxReasonableDifficulty}
+		client, cleanup := newZenonTestClient(t, fixture)
+		defer cleanup()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := NewZenon(client).ReceiveAndConfirm(ctx, testKeyPair(t), sendHash)
+		if !errors.Is(err, context.DeadlineExceeded) || !errors.Is(err, pow.ErrCancelled) {
+			t.Fatalf("error = %v, want PoW cancelled by the deadline", err)
+		}
+		if len(fixture.publishedBlocks) != 0 {
+			t.Fatalf("published %d blocks, want none", len(fixture.publishedBlocks))
+		}
+	})
+
+	t.Run("cancelled while waiting", func(t *testing.T) {
+		fixture, sendHash := receiveFixture(t)
+		client, cleanup := newZenonTestClient(t, fixture)
+		defer cleanup()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := NewZenon(client).ReceiveAndConfirm(ctx, testKeyPair(t), sendHash)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("error = %v, want context.DeadlineExceeded", err)
+		}
+		if len(fixture.publishedBlocks) != 1 {
+			t.Fatalf("published %d blocks, want the receive block to stay published", len(fixture.publishedBlocks))
+		}
+	})
+}
+
+func receiveAllFixture(t *testing.T) (*zenonRPCFixture, []types.Hash) {
+	t.Helper()
+	fixture, sendHash := receiveFixture(t)
+	qsrHash := types.HexToHashPanic("4444444444444444444444444444444444444444444444444444444444444444")
+	source := fixture.source.(*nodeapi.AccountBlock)
+	qsr := &nodeapi.AccountBlock{AccountBlock: source.AccountBlock}
+	qsr.Hash, qsr.TokenStandard = qsrHash, types.QsrTokenStandard
+	fixture.unreceived = &nodeapi.AccountBlockList{List: []*nodeapi.AccountBlock{source, qsr}, Count: 2}
+	fixture.frontierFromPublished = true
+	return fixture, []types.Hash{sendHash, qsrHash}
+}
+
+func TestReceiveAllReceivesEveryToken(t *testing.T) {
+	fixture, hashes := receiveAllFixture(t)
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	received, err := NewZenon(client).ReceiveAll(context.Background(), testKeyPair(t), ReceiveOptions{})
+	if err != nil {
+		t.Fatalf("ReceiveAll: %v", err)
+	}
+	if len(received) != len(hashes) || len(fixture.publishedBlocks) != len(hashes) {
+		t.Fatalf("received %d blocks (%d published), want %d", len(received), len(fixture.publishedBlocks), len(hashes))
+	}
+	for i, block := range fixture.publishedBlocks {
+		if block.BlockType != nom.BlockTypeUserReceive || block.FromBlockHash != hashes[i] {
+			t.Errorf("block %d = type %d from %s, want receive of %s", i, block.BlockType, block.FromBlockHash, hashes[i])
+		}
+	}
+}
+
+func TestReceiveAllReceivesEveryPage(t *testing.T) {
+	fixture, _ := receiveFixture(t)
+	source := fixture.source.(*nodeapi.AccountBlock)
+	var pending []*nodeapi.AccountBlock
+	for i := 0; i < 55; i++ {
+		block := &nodeapi.AccountBlock{AccountBlock: source.AccountBlock}
+		block.Hash = types.HexToHashPanic(fmt.Sprintf("%064x", i+0x200))
+		pending = append(pending, block)
+	}
+	fixture.unreceived = &nodeapi.AccountBlockList{List: pending, Count: len(pending)}
+	fixture.frontierFromPublished = true
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	received, err := NewZenon(client).ReceiveAll(context.Background(), testKeyPair(t), ReceiveOptions{})
+	if err != nil {
+		t.Fatalf("ReceiveAll: %v", err)
+	}
+	if len(received) != len(pending) {
+		t.Fatalf("received %d blocks, want %d", len(received), len(pending))
+	}
+	if last := received[len(received)-1]; last.FromBlockHash != pending[54].Hash {
+		t.Errorf("last receive is from %s, want %s", last.FromBlockHash, pending[54].Hash)
+	}
+}
+
+func TestReceiveAllStopsWhenCancelled(t *testing.T) {
+	fixture, hashes := receiveAllFixture(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fixture.onPublish = cancel
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	received, err := NewZenon(client).ReceiveAll(ctx, testKeyPair(t), ReceiveOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ReceiveAll error = %v, want context.Canceled", err)
+	}
+	if len(received) != 1 || received[0].FromBlockHash != hashes[0] {
+		t.Fatalf("received = %v, want only the receive of %s", received, hashes[0])
+	}
+	if fixture.publishAttempts != 1 {
+		t.Errorf("publish attempts = %d, want 1", fixture.publishAttempts)
+	}
+}
+
+func receiveUntilBalanceFixture(t *testing.T, balance int64) (*zenonRPCFixture, types.Hash) {
+	t.Helper()
+	fixture, sendHash := receiveFixture(t)
+	address, err := testKeyPair(t).GetAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixture.unreceived = &nodeapi.AccountBlockList{List: []*nodeapi.AccountBlock{fixture.source.(*nodeapi.AccountBlock)}, Count: 1}
+	fixture.accountInfo = sweepAccountInfo(*address, types.ZnnTokenStandard, balance)
+	return fixture, sendHash
+}
+
+func TestReceiveUntilBalanceReceivesPending(t *testing.T) {
+	fixture, sendHash := receiveUntilBalanceFixture(t, 1234)
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	balance, err := NewZenon(client).ReceiveUntilBalance(context.Background(), testKeyPair(t), types.ZnnTokenStandard, big.NewInt(1000), time.Millisecond)
+	if err != nil {
+		t.Fatalf("ReceiveUntilBalance: %v", err)
+	}
+	if balance.Cmp(big.NewInt(1234)) != 0 {
+		t.Fatalf("balance = %s, want 1234", balance)
+	}
+	if len(fixture.publishedBlocks) != 1 || fixture.publishedBlocks[0].FromBlockHash != sendHash {
+		t.Fatalf("published %d blocks, want one receive of %s", len(fixture.publishedBlocks), sendHash)
+	}
+}
+
+func TestReceiveUntilBalanceReceivesFromFullMailbox(t *testing.T) {
+	fixture, _ := receiveUntilBalanceFixture(t, 1234)
+	source := fixture.source.(*nodeapi.AccountBlock)
+	// 500 QSR blocks fill everything the node lists. The first ZNN block is
+	// listed; the second comes into view once the first is received.
+	for i := 0; i < 502; i++ {
+		block := &nodeapi.AccountBlock{AccountBlock: source.AccountBlock}
+		block.Hash = types.HexToHashPanic(fmt.Sprintf("%064x", i+0x2000))
+		block.TokenStandard = types.QsrTokenStandard
+		fixture.mailbox = append(fixture.mailbox, block)
+	}
+	funding := []*nodeapi.AccountBlock{fixture.mailbox[100], fixture.mailbox[500]}
+	for _, block := range funding {
+		block.TokenStandard = types.ZnnTokenStandard
+	}
+	fixture.frontierFromPublished = true
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	if _, err := NewZenon(client).ReceiveUntilBalance(context.Background(), testKeyPair(t), types.ZnnTokenStandard, big.NewInt(1000), time.Millisecond); err != nil {
+		t.Fatalf("ReceiveUntilBalance: %v", err)
+	}
+	if len(fixture.publishedBlocks) != len(funding) {
+		t.Fatalf("published %d blocks, want %d receives", len(fixture.publishedBlocks), len(funding))
+	}
+	for i, block := range funding {
+		if fixture.publishedBlocks[i].FromBlockHash != block.Hash {
+			t.Errorf("receive %d is from %s, want %s", i, fixture.publishedBlocks[i].FromBlockHash, block.Hash)
+		}
+	}
+}
+
+func TestReceiveUntilBalanceStopsOnContext(t *testing.T) {
+	fixture, _ := receiveUntilBalanceFixture(t, 5)
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	balance, err := NewZenon(client).ReceiveUntilBalance(ctx, testKeyPair(t), types.ZnnTokenStandard, big.NewInt(1000), 5*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ReceiveUntilBalance error = %v, want context.DeadlineExceeded", err)
+	}
+	if balance == nil || balance.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("balance = %v, want last observed 5", balance)
+	}
+	// The node keeps listing the block; it must be received only once.
+	if len(fixture.publishedBlocks) != 1 {
+		t.Fatalf("published %d blocks, want 1", len(fixture.publishedBlocks))
+	}
+}
+
+func TestReceiveAllSkipsDust(t *testing.T) {
+	tests := []struct {
+		name              string
+		includeZeroAmount bool
+		want              []int // indexes into the pending blocks
+	}{
+		{name: "payments only", want: []int{1}},
+		{name: "payments and contract notifications", includeZeroAmount: true, want: []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture, _ := receiveFixture(t)
+			source := fixture.source.(*nodeapi.AccountBlock)
+			var hashes []types.Hash
+			var pending []*nodeapi.AccountBlock
+			for i, amount := range []int64{4999, 100000000, 0} { // dust, payment, notification
+				block := &nodeapi.AccountBlock{AccountBlock: source.AccountBlock}
+				block.Hash = types.HexToHashPanic(fmt.Sprintf("%064x", i+0x50))
+				block.Amount = big.NewInt(amount)
+				hashes = append(hashes, block.Hash)
+				pending = append(pending, block)
+			}
+			fixture.unreceived = &nodeapi.AccountBlockList{List: pending, Count: len(pending)}
+			fixture.frontierFromPublished = true
+			client, cleanup := newZenonTestClient(t, fixture)
+			defer cleanup()
+
+			received, err := NewZenon(client).ReceiveAll(context.Background(), testKeyPair(t), ReceiveOptions{
+				MinAmount:         big.NewInt(5000),
+				IncludeZeroAmount: tt.includeZeroAmount,
+			})
+			if err != nil {
+				t.Fatalf("ReceiveAll: %v", err)
+			}
+			if len(received) != len(tt.want) {
+				t.Fatalf("received %d blocks, want %d", len(received), len(tt.want))
+			}
+			for i, index := range tt.want {
+				if received[i].FromBlockHash != hashes[index] {
+					t.Errorf("receive %d is from %s, want %s", i, received[i].FromBlockHash, hashes[index])
+				}
+			}
+		})
+	}
+}
+
+func TestReceiveAllFindsPaymentBehindDust(t *testing.T) {
+	fixture, _ := receiveFixture(t)
+	source := fixture.source.(*nodeapi.AccountBlock)
+	// 60 dust blocks fill the first page and push the payment onto the second.
+	var pending []*nodeapi.AccountBlock
+	for i := 0; i < 61; i++ {
+		block := &nodeapi.AccountBlock{AccountBlock: source.AccountBlock}
+		block.Hash = types.HexToHashPanic(fmt.Sprintf("%064x", i+0x100))
+		block.Amount = big.NewInt(1)
+		pending = append(pending, block)
+	}
+	payment := pending[60]
+	payment.Amount = big.NewInt(100000000)
+	fixture.unreceived = &nodeapi.AccountBlockList{List: pending, Count: len(pending)}
+	fixture.frontierFromPublished = true
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	received, err := NewZenon(client).ReceiveAll(context.Background(), testKeyPair(t), ReceiveOptions{MinAmount: big.NewInt(5000)})
+	if err != nil {
+		t.Fatalf("ReceiveAll: %v", err)
+	}
+	if len(received) != 1 || received[0].FromBlockHash != payment.Hash {
+		t.Fatalf("received %d blocks, want only the payment %s", len(received), payment.Hash)
+	}
+	pages := 0
+	for _, method := range fixture.calls {
+		if method == "ledger.getUnreceivedBlocksByAddress" {
+			pages++
+		}
+	}
+	if pages != 2 {
+		t.Errorf("listed %d pages, want 2", pages)
+	}
+}
+
+func TestReceiveAllFindsPaymentInFloodedMailbox(t *testing.T) {
+	fixture, _ := receiveFixture(t)
+	source := fixture.source.(*nodeapi.AccountBlock)
+	// 500 dust blocks fill everything the node lists. The first payment is
+	// among the listed blocks; the second comes into view once it is received.
+	for i := 0; i < 502; i++ {
+		block := &nodeapi.AccountBlock{AccountBlock: source.AccountBlock}
+		block.Hash = types.HexToHashPanic(fmt.Sprintf("%064x", i+0x1000))
+		block.Amount = big.NewInt(1)
+		fixture.mailbox = append(fixture.mailbox, block)
+	}
+	payments := []*nodeapi.AccountBlock{fixture.mailbox[250], fixture.mailbox[500]}
+	for _, payment := range payments {
+		payment.Amount = big.NewInt(100000000)
+	}
+	fixture.frontierFromPublished = true
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	received, err := NewZenon(client).ReceiveAll(context.Background(), testKeyPair(t), ReceiveOptions{MinAmount: big.NewInt(5000)})
+	if err != nil {
+		t.Fatalf("ReceiveAll: %v", err)
+	}
+	if len(received) != len(payments) {
+		t.Fatalf("received %d blocks, want the %d payments", len(received), len(payments))
+	}
+	for i, payment := range payments {
+		if received[i].FromBlockHash != payment.Hash {
+			t.Errorf("receive %d is from %s, want payment %s", i, received[i].FromBlockHash, payment.Hash)
+		}
+	}
+	if len(fixture.mailbox) != 500 {
+		t.Errorf("mailbox holds %d blocks, want the 500 dust blocks left", len(fixture.mailbox))
+	}
+}
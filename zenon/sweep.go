@@ -0,0 +1,73 @@
+package zenon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0x3639/znn-sdk-go/wallet"
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// ErrZeroBalance is returned by SweepBalance when the account holds none of the
+// requested token after pending blocks have been received.
+var ErrZeroBalance = errors.New("nothing to sweep: balance is zero")
+
+// SweepBalance empties one token balance of an account into another address.
+//
+// It first receives every unreceived send block of tokenStandard addressed to
+// the signer's account, so that pending funds are not left behind, then reads
+// the resulting balance and publishes a single send block for the full amount.
+// While the node reports more pending blocks than the 500 it lists, it lists
+// again after receiving, until no new block of tokenStandard is listed.
+// Each receive and the final send go through Send, so plasma or PoW is
+// resolved per block exactly as for any other transaction.
+//
+// Parameters:
+//   - signer: The wallet.Signer (for example a *wallet.KeyPair) of the account
+//     being emptied
+//   - toAddress: Recipient of the swept balance
+//   - tokenStandard: Token to sweep (for example types.ZnnTokenStandard)
+//
+// Returns the published send block, [ErrZeroBalance] if there is nothing to
+// send, or an error if listing, receiving, or sending fails.
+// Receive blocks published before a failure stay published; calling
+// SweepBalance again continues from where it stopped.
+//
+// Example:
+//
+//	sent, err := z.SweepBalance(keyPair, coldWallet, types.ZnnTokenStandard)
+//	if errors.Is(err, zenon.ErrZeroBalance) {
+//	    return nil // already empty
+//	}
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("Swept %s ZNN in block %s\n", utils.AddDecimals(sent.Amount, 8), sent.Hash)
+//
+// Note: The balance is read from the node's account frontier, which includes
+// the receive blocks just published. Blocks that arrive after the unreceived
+// list is read are not included in the sweep.
+func (z *Zenon) SweepBalance(signer wallet.Signer, toAddress types.Address, tokenStandard types.ZenonTokenStandard) (*nom.AccountBlock, error) {
+	address, err := signer.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive address: %w", err)
+	}
+
+	if err := z.receiveToken(context.Background(), signer, *address, tokenStandard, make(map[types.Hash]bool)); err != nil {
+		return nil, err
+	}
+
+	info, err := z.client.LedgerApi.GetAccountInfoByAddress(*address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+	balance := info.Balance(tokenStandard)
+	if balance.Sign() <= 0 {
+		return nil, ErrZeroBalance
+	}
+
+	transaction := z.client.LedgerApi.SendTemplate(toAddress, tokenStandard, balance, nil)
+	return z.Send(transaction, signer)
+}
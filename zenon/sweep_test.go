@@ -0,0 +1,189 @@
+package zenon
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+	nodeapi "github.com/zenon-network/go-zenon/rpc/api"
+)
+
+func sweepAccountInfo(address types.Address, zts types.ZenonTokenStandard, balance int64) *nodeapi.AccountInfo {
+	return &nodeapi.AccountInfo{
+		Address: address,
+		BalanceInfoMap: map[types.ZenonTokenStandard]*nodeapi.BalanceInfo{
+			zts: {
+				TokenInfo: &nodeapi.Token{ZenonTokenStandard: zts, Decimals: 8, TotalSupply: big.NewInt(0), MaxSupply: big.NewInt(0)},
+				Balance:   big.NewInt(balance),
+			},
+		},
+	}
+}
+
+func TestSweepBalanceReceivesPendingThenSendsFullBalance(t *testing.T) {
+	kp := testKeyPair(t)
+	address, err := kp.GetAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	znnHash := types.HexToHashPanic("1111111111111111111111111111111111111111111111111111111111111111")
+	qsrHash := types.HexToHashPanic("2222222222222222222222222222222222222222222222222222222222222222")
+	pending := func(hash types.Hash, zts types.ZenonTokenStandard) *nodeapi.AccountBlock {
+		return &nodeapi.AccountBlock{AccountBlock: nom.AccountBlock{
+			BlockType: nom.BlockTypeUserSend, Hash: hash, ToAddress: *address, TokenStandard: zts, Amount: big.NewInt(5),
+		}}
+	}
+	fixture := &zenonRPCFixture{
+		momentum: testMomentum(10, 1, types.ZeroHash),
+		source:   pending(znnHash, types.ZnnTokenStandard),
+		unreceived: &nodeapi.AccountBlockList{
+			List:  []*nodeapi.AccountBlock{pending(znnHash, types.ZnnTokenStandard), pending(qsrHash, types.QsrTokenStandard)},
+			Count: 2,
+		},
+		accountInfo: sweepAccountInfo(*address, types.ZnnTokenStandard, 1234),
+		errors:      make(map[string]string),
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	to := types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")
+	sent, err := NewZenon(client).SweepBalance(kp, to, types.ZnnTokenStandard)
+	if err != nil {
+		t.Fatalf("SweepBalance: %v", err)
+	}
+
+	if len(fixture.publishedBlocks) != 2 {
+		t.Fatalf("published %d blocks, want receive + send", len(fixture.publishedBlocks))
+	}
+	receive := fixture.publishedBlocks[0]
+	if receive.BlockType != nom.BlockTypeUserReceive || receive.FromBlockHash != znnHash {
+		t.Fatalf("first block = type %d from %s, want receive of the pending ZNN block", receive.BlockType, receive.FromBlockHash)
+	}
+	if sent.BlockType != nom.BlockTypeUserSend || sent.ToAddress != to || sent.TokenStandard != types.ZnnTokenStandard {
+		t.Fatalf("sweep block = %+v", sent)
+	}
+	if sent.Amount.Cmp(big.NewInt(1234)) != 0 {
+		t.Fatalf("sweep amount = %s, want 1234", sent.Amount)
+	}
+}
+
+func TestSweepBalanceZeroBalance(t *testing.T) {
+	kp := testKeyPair(t)
+	address, err := kp.GetAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixture := &zenonRPCFixture{
+		momentum:    testMomentum(10, 1, types.ZeroHash),
+		unreceived:  &nodeapi.AccountBlockList{},
+		accountInfo: sweepAccountInfo(*address, types.QsrTokenStandard, 99),
+		errors:      make(map[string]string),
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	_, err = NewZenon(client).SweepBalance(kp, types.PlasmaContract, types.ZnnTokenStandard)
+	if !errors.Is(err, ErrZeroBalance) {
+		t.Fatalf("SweepBalance error = %v, want ErrZeroBalance", err)
+	}
+	if len(fixture.publishedBlocks) != 0 {
+		t.Fatalf("published %d blocks, want none", len(fixture.publishedBlocks))
+	}
+}
+
+func TestSweepBalanceReportsListingFailure(t *testing.T) {
+	fixture := &zenonRPCFixture{
+		momentum: testMomentum(10, 1, types.ZeroHash),
+		errors:   map[string]string{"ledger.getUnreceivedBlocksByAddress": "mailbox failed"},
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	_, err := NewZenon(client).SweepBalance(testKeyPair(t), types.PlasmaContract, types.ZnnTokenStandard)
+	if err == nil || !strings.Contains(err.Error(), "failed to list unreceived blocks") {
+		t.Fatalf("SweepBalance error = %v", err)
+	}
+}
+
+func TestSweepBalanceReceivesBeyondFirstPage(t *testing.T) {
+	kp := testKeyPair(t)
+	address, err := kp.GetAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 60 pending QSR blocks push the only ZNN block onto the second page.
+	unreceived := &nodeapi.AccountBlockList{Count: 61}
+	for i := 0; i < 61; i++ {
+		zts := types.QsrTokenStandard
+		if i == 60 {
+			zts = types.ZnnTokenStandard
+		}
+		unreceived.List = append(unreceived.List, &nodeapi.AccountBlock{AccountBlock: nom.AccountBlock{
+			BlockType: nom.BlockTypeUserSend, Hash: types.NewHash([]byte{byte(i)}), ToAddress: *address,
+			TokenStandard: zts, Amount: big.NewInt(5),
+		}})
+	}
+	znnHash := unreceived.List[60].Hash
+	fixture := &zenonRPCFixture{
+		momentum:    testMomentum(10, 1, types.ZeroHash),
+		source:      unreceived.List[60],
+		unreceived:  unreceived,
+		accountInfo: sweepAccountInfo(*address, types.ZnnTokenStandard, 5),
+		errors:      make(map[string]string),
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	if _, err := NewZenon(client).SweepBalance(kp, types.PlasmaContract, types.ZnnTokenStandard); err != nil {
+		t.Fatalf("SweepBalance: %v", err)
+	}
+	if len(fixture.publishedBlocks) != 2 || fixture.publishedBlocks[0].FromBlockHash != znnHash {
+		t.Fatalf("published %d blocks, want a receive of %s and the sweep", len(fixture.publishedBlocks), znnHash)
+	}
+}
+
+func TestSweepBalanceReceivesFromFullMailbox(t *testing.T) {
+	kp := testKeyPair(t)
+	address, err := kp.GetAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 500 QSR blocks fill everything the node lists. The first ZNN block is
+	// listed; the second comes into view once the first is received.
+	var mailbox []*nodeapi.AccountBlock
+	for i := 0; i < 502; i++ {
+		mailbox = append(mailbox, &nodeapi.AccountBlock{AccountBlock: nom.AccountBlock{
+			BlockType: nom.BlockTypeUserSend, Hash: types.HexToHashPanic(fmt.Sprintf("%064x", i+0x3000)), ToAddress: *address,
+			TokenStandard: types.QsrTokenStandard, Amount: big.NewInt(5),
+		}})
+	}
+	znnBlocks := []*nodeapi.AccountBlock{mailbox[100], mailbox[500]}
+	for _, block := range znnBlocks {
+		block.TokenStandard = types.ZnnTokenStandard
+	}
+	fixture := &zenonRPCFixture{
+		momentum:    testMomentum(10, 1, types.ZeroHash),
+		source:      znnBlocks[0],
+		mailbox:     mailbox,
+		accountInfo: sweepAccountInfo(*address, types.ZnnTokenStandard, 10),
+		errors:      make(map[string]string),
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	if _, err := NewZenon(client).SweepBalance(kp, types.PlasmaContract, types.ZnnTokenStandard); err != nil {
+		t.Fatalf("SweepBalance: %v", err)
+	}
+	if len(fixture.publishedBlocks) != 3 {
+		t.Fatalf("published %d blocks, want two receives and the sweep", len(fixture.publishedBlocks))
+	}
+	for i, block := range znnBlocks {
+		if fixture.publishedBlocks[i].FromBlockHash != block.Hash {
+			t.Errorf("receive %d is from %s, want %s", i, fixture.publishedBlocks[i].FromBlockHash, block.Hash)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package zenon
+
+import (
+	"sync"
+
+	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+// AccountChainTracker caches the frontier (height and hash) of account chains
+// this process publishes to, so sequential sends from one address skip the
+// ledger.getFrontierAccountBlock round trip.
+//
+// Assign one to Zenon.ChainTracker to enable it. The first block from an
+// address reads the frontier from the node as usual; every successful publish
+// then records the new block as the address's frontier, and the next block is
+// autofilled from the cache. Any failure while preparing or publishing a block
+// forgets the address, so the following send reads the node's frontier again
+// instead of building on a block that was never accepted.
+//
+// An AccountChainTracker is safe for concurrent use.
+//
+// Note: Only use a tracker when this process is the only writer of the
+// account chain. A block published from another wallet with the same key makes
+// the cached frontier stale; the node then rejects the next block with
+// api.ErrAccountHeightMismatch, which resets the cache.
+type AccountChainTracker struct {
+	mu        sync.Mutex
+	frontiers map[types.Address]types.HashHeight
+}
+
+// NewAccountChainTracker creates an empty AccountChainTracker.
+//
+// Example:
+//
+//	z := zenon.NewZenon(client)
+//	z.ChainTracker = zenon.NewAccountChainTracker()
+//	published, err := z.SendBatch(ctx, keyPair, payroll)
+func NewAccountChainTracker() *AccountChainTracker {
+	return &AccountChainTracker{frontiers: make(map[types.Address]types.HashHeight)}
+}
+
+// Frontier returns the cached frontier of address, and false when the
+// address is not cached.
+func (t *AccountChainTracker) Frontier(address types.Address) (types.HashHeight, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	frontier, ok := t.frontiers[address]
+	return frontier, ok
+}
+
+// Advance records a published block as the frontier of its account chain.
+func (t *AccountChainTracker) Advance(block *nom.AccountBlock) {
+	if block == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.frontiers[block.Address] = types.HashHeight{Hash: block.Hash, Height: block.Height}
+}
+
+// Reset forgets the cached frontier of address, so the next block from it is
+// autofilled from the node.
+func (t *AccountChainTracker) Reset(address types.Address) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.frontiers, address)
+}
@@ -0,0 +1,94 @@
+package zenon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zenon-network/go-zenon/common/types"
+)
+
+func countCalls(calls []string, method string) int {
+	n := 0
+	for _, call := range calls {
+		if call == method {
+			n++
+		}
+	}
+	return n
+}
+
+func TestAccountChainTrackerSkipsFrontierQueries(t *testing.T) {
+	fixture := &zenonRPCFixture{
+		momentum: testMomentum(10, 1, types.ZeroHash),
+		errors:   make(map[string]string),
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	z := NewZenon(client)
+	z.ChainTracker = NewAccountChainTracker()
+	published, err := z.SendBatch(context.Background(), testKeyPair(t), batchRequests())
+	if err != nil {
+		t.Fatalf("SendBatch: %v", err)
+	}
+
+	// The fixture always reports an empty chain, so only the tracker can
+	// have chained the blocks.
+	for i, block := range published {
+		if block.Height != uint64(i+1) {
+			t.Errorf("block %d height = %d, want %d", i, block.Height, i+1)
+		}
+		if i > 0 && block.PreviousHash != published[i-1].Hash {
+			t.Errorf("block %d previous hash = %s, want %s", i, block.PreviousHash, published[i-1].Hash)
+		}
+	}
+	if n := countCalls(fixture.calls, "ledger.getFrontierAccountBlock"); n != 1 {
+		t.Errorf("frontier queries = %d, want 1", n)
+	}
+	last := published[len(published)-1]
+	if frontier, ok := z.ChainTracker.Frontier(last.Address); !ok || frontier.Height != last.Height || frontier.Hash != last.Hash {
+		t.Errorf("tracked frontier = %+v, %v; want %d %s", frontier, ok, last.Height, last.Hash)
+	}
+}
+
+func TestAccountChainTrackerResetsAfterPublishError(t *testing.T) {
+	fixture := &zenonRPCFixture{
+		momentum:              testMomentum(10, 1, types.ZeroHash),
+		errors:                make(map[string]string),
+		frontierFromPublished: true,
+		publishErrors:         map[int]string{1: "account height or previous hash mismatch"},
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	z := NewZenon(client)
+	z.ChainTracker = NewAccountChainTracker()
+	kp := testKeyPair(t)
+	address, err := kp.GetAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sends := batchRequests()
+
+	if _, err := z.SendBatch(context.Background(), kp, sends); err == nil {
+		t.Fatal("SendBatch error = nil, want the second publish to fail")
+	}
+	if _, ok := z.ChainTracker.Frontier(*address); ok {
+		t.Fatal("tracker kept a frontier after a failed publish")
+	}
+	frontierQueries := countCalls(fixture.calls, "ledger.getFrontierAccountBlock")
+
+	// The retry must read the node's frontier again and build on the one
+	// block that was accepted.
+	resumed, err := z.SendBatch(context.Background(), kp, sends[1:])
+	if err != nil {
+		t.Fatalf("resumed SendBatch: %v", err)
+	}
+	if n := countCalls(fixture.calls, "ledger.getFrontierAccountBlock") - frontierQueries; n != 1 {
+		t.Errorf("frontier queries after reset = %d, want 1", n)
+	}
+	accepted := fixture.publishedBlocks[0]
+	if resumed[0].Height != 2 || resumed[0].PreviousHash != accepted.Hash {
+		t.Errorf("resumed block = height %d after %s, want height 2 after %s", resumed[0].Height, resumed[0].PreviousHash, accepted.Hash)
+	}
+}
@@ -1,6 +1,8 @@
 package zenon
 
 import (
+	"context"
+	"encoding/binary"
 	"fmt"
 	"math/big"
 
@@ -48,17 +50,17 @@ func normalizeBlockDefaults(transaction *nom.AccountBlock) {
 // checkAndSetFields populates the signing identity and chain-position fields of a
 // transaction and validates receive blocks.
 //
-// It sets Address and PublicKey from the keypair, autofills height/previousHash/
+// It sets Address and PublicKey from the signer, autofills height/previousHash/
 // momentumAcknowledged, and for receive blocks verifies that the referenced send
 // block exists, targets this address, and that no data is attached.
 //
 // Reference: znn_sdk_dart/lib/src/utils/block.dart:_checkAndSetFields
-func (z *Zenon) checkAndSetFields(transaction *nom.AccountBlock, keyPair *wallet.KeyPair) error {
-	address, err := keyPair.GetAddress()
+func (z *Zenon) checkAndSetFields(transaction *nom.AccountBlock, signer wallet.Signer) error {
+	address, err := signer.GetAddress()
 	if err != nil {
 		return fmt.Errorf("failed to derive address: %w", err)
 	}
-	publicKey, err := keyPair.GetPublicKey()
+	publicKey, err := signer.GetPublicKey()
 	if err != nil {
 		return fmt.Errorf("failed to derive public key: %w", err)
 	}
@@ -104,7 +106,8 @@ func (z *Zenon) checkAndSetFields(transaction *nom.AccountBlock, keyPair *wallet
 // transaction from current node state.
 //
 // Height and PreviousHash come from the sender's frontier account block (height 1
-// and the zero hash for a brand-new account). MomentumAcknowledged and
+// and the zero hash for a brand-new account), or from ChainTracker when it has
+// the sender's frontier cached. MomentumAcknowledged and
 // ChainIdentifier come from the node's frontier momentum. ChainIdentifier is only
 // set when the caller left it unset (zero), so an explicit chain ID is preserved;
 // go-zenon rejects blocks whose chain identifier is zero or does not match the
@@ -112,16 +115,20 @@ func (z *Zenon) checkAndSetFields(transaction *nom.AccountBlock, keyPair *wallet
 //
 // Reference: znn_sdk_dart/lib/src/utils/block.dart:_autofillTransactionParameters
 func (z *Zenon) autofillTransactionParameters(transaction *nom.AccountBlock) error {
-	frontier, err := z.client.LedgerApi.GetFrontierAccountBlock(transaction.Address)
-	if err != nil {
-		return fmt.Errorf("failed to get frontier account block: %w", err)
-	}
-
 	height := uint64(1)
 	previousHash := types.ZeroHash
-	if frontier != nil && frontier.Height != 0 {
-		height = frontier.Height + 1
-		previousHash = frontier.Hash
+	if cached, ok := z.cachedFrontier(transaction.Address); ok {
+		height = cached.Height + 1
+		previousHash = cached.Hash
+	} else {
+		frontier, err := z.client.LedgerApi.GetFrontierAccountBlock(transaction.Address)
+		if err != nil {
+			return fmt.Errorf("failed to get frontier account block: %w", err)
+		}
+		if frontier != nil && frontier.Height != 0 {
+			height = frontier.Height + 1
+			previousHash = frontier.Hash
+		}
 	}
 	transaction.Height = height
 	transaction.PreviousHash = previousHash
@@ -145,6 +152,15 @@ func (z *Zenon) autofillTransactionParameters(transaction *nom.AccountBlock) err
 	return nil
 }
 
+// cachedFrontier returns the sender's frontier from ChainTracker, if one is
+// set and knows the address.
+func (z *Zenon) cachedFrontier(address types.Address) (types.HashHeight, bool) {
+	if z.ChainTracker == nil {
+		return types.HashHeight{}, false
+	}
+	return z.ChainTracker.Frontier(address)
+}
+
 // requiredPoW asks the node how much Proof-of-Work, if any, the transaction needs.
 func (z *Zenon) requiredPoW(transaction *nom.AccountBlock) (*embedded.GetRequiredResult, error) {
 	param := embedded.GetRequiredParam{
@@ -162,10 +178,11 @@ func (z *Zenon) requiredPoW(transaction *nom.AccountBlock) (*embedded.GetRequire
 // When the node reports a required difficulty, the available plasma and difficulty
 // are recorded and a nonce is generated over the canonical PoW data hash
 // (SHA3-256(address || previousHash)). Otherwise the transaction proceeds on fused
-// plasma alone with a zero difficulty and nonce.
+// plasma alone with a zero difficulty and nonce. The nonce search stops when ctx
+// is done.
 //
 // Reference: znn_sdk_dart/lib/src/utils/block.dart:_setDifficulty
-func (z *Zenon) setDifficulty(transaction *nom.AccountBlock) error {
+func (z *Zenon) setDifficulty(ctx context.Context, transaction *nom.AccountBlock) error {
 	resp, err := z.requiredPoW(transaction)
 	if err != nil {
 		return fmt.Errorf("failed to query required PoW: %w", err)
@@ -190,8 +207,14 @@ func (z *Zenon) setDifficulty(transaction *nom.AccountBlock) error {
 		// Use go-zenon's canonical data hash so the generated nonce is guaranteed
 		// to satisfy the node's pow.CheckPoWNonce.
 		dataHash := gozenonpow.GetAccountBlockHash(transaction)
-		nonceBytes := pow.GeneratePowBytes(dataHash, transaction.Difficulty)
-		copy(transaction.Nonce.Data[:], nonceBytes)
+		nonce, err := pow.GeneratePoWFrom(ctx, dataHash, transaction.Difficulty, 0)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return fmt.Errorf("failed to generate PoW: %w: %w", err, ctxErr)
+			}
+			return fmt.Errorf("failed to generate PoW: %w", err)
+		}
+		binary.LittleEndian.PutUint64(transaction.Nonce.Data[:], nonce)
 
 		if z.PowCallback != nil {
 			z.PowCallback(pow.Done)
@@ -205,16 +228,16 @@ func (z *Zenon) setDifficulty(transaction *nom.AccountBlock) error {
 	return nil
 }
 
-// setHashAndSignature computes the transaction hash and signs it with the keypair.
+// setHashAndSignature computes the transaction hash and signs it with the signer.
 //
 // The signature is an ed25519 signature over the 32-byte transaction hash, matching
 // go-zenon's verification and the Dart/TypeScript SDKs.
 //
 // Reference: znn_sdk_dart/lib/src/utils/block.dart:_setHashAndSignature
-func (z *Zenon) setHashAndSignature(transaction *nom.AccountBlock, keyPair *wallet.KeyPair) error {
+func (z *Zenon) setHashAndSignature(transaction *nom.AccountBlock, signer wallet.Signer) error {
 	transaction.Hash = utils.GetTransactionHash(transaction)
 
-	signature, err := keyPair.Sign(transaction.Hash.Bytes())
+	signature, err := signer.SignHash(transaction.Hash)
 	if err != nil {
 		return fmt.Errorf("failed to sign transaction: %w", err)
 	}
@@ -5,7 +5,7 @@
 // several coordinated steps:
 //
 //  1. Autofill the block's height, previous hash, and acknowledged momentum
-//  2. Set the signing address and public key from the keypair
+//  2. Set the signing address and public key from the signer
 //  3. Query the required Proof-of-Work difficulty (or use available plasma)
 //  4. Generate the PoW nonce when difficulty is required
 //  5. Compute the transaction hash and sign it
@@ -14,7 +14,8 @@
 // The Zenon type wraps an *rpc_client.RpcClient and performs this whole flow via
 // Send (autofill -> PoW -> sign -> publish) or PrepareBlock (everything except
 // publish). This mirrors the official Dart and TypeScript SDKs' Zenon.send /
-// prepareBlock helpers.
+// prepareBlock helpers. ReceiveAndConfirm runs the same flow for a receive
+// block and then waits for it to be confirmed in a momentum.
 //
 // Basic usage:
 //
@@ -37,19 +38,21 @@
 package zenon
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/0x3639/znn-sdk-go/pow"
 	"github.com/0x3639/znn-sdk-go/rpc_client"
 	"github.com/0x3639/znn-sdk-go/wallet"
 	"github.com/zenon-network/go-zenon/chain/nom"
+	"github.com/zenon-network/go-zenon/common/types"
 )
 
 // Zenon coordinates the full transaction send flow against a connected node.
 //
 // Construct one with NewZenon. A Zenon is a thin, stateless wrapper around an
 // *rpc_client.RpcClient and is safe to reuse for many transactions. It holds no
-// keys; a *wallet.KeyPair is supplied per call.
+// keys; a wallet.Signer (such as a *wallet.KeyPair) is supplied per call.
 type Zenon struct {
 	client *rpc_client.RpcClient
 
@@ -59,6 +62,11 @@ type Zenon struct {
 	// plasma (no PoW required). Use it to surface progress to users, since PoW
 	// generation is synchronous and can take noticeable time at high difficulty.
 	PowCallback func(pow.PowStatus)
+
+	// ChainTracker, when non-nil, caches the sender's frontier between
+	// blocks so sequential sends skip the frontier query. See
+	// AccountChainTracker.
+	ChainTracker *AccountChainTracker
 }
 
 // NewZenon creates a Zenon send-flow helper bound to the given RPC client.
@@ -93,8 +101,8 @@ func (z *Zenon) Client() *rpc_client.RpcClient {
 // Parameters:
 //   - transaction: An unsigned *nom.AccountBlock template, typically returned by
 //     a LedgerApi or embedded contract method. It is mutated in place.
-//   - keyPair: The *wallet.KeyPair that signs the transaction. Its address
-//     becomes the block's sender.
+//   - signer: The wallet.Signer that signs the transaction, usually a
+//     *wallet.KeyPair. Its address becomes the block's sender.
 //
 // Returns the fully populated, published *nom.AccountBlock (the same pointer that
 // was passed in) or an error if any step fails. A nil error means the node
@@ -109,18 +117,41 @@ func (z *Zenon) Client() *rpc_client.RpcClient {
 //
 //	template := client.TokenApi.IssueToken(...)
 //	published, err := z.Send(template, keyPair)
-func (z *Zenon) Send(transaction *nom.AccountBlock, keyPair *wallet.KeyPair) (*nom.AccountBlock, error) {
-	if _, err := z.PrepareBlock(transaction, keyPair); err != nil {
+func (z *Zenon) Send(transaction *nom.AccountBlock, signer wallet.Signer) (*nom.AccountBlock, error) {
+	return z.send(context.Background(), transaction, signer)
+}
+
+// send is Send with a context that stops the flow before each node request and
+// during PoW generation.
+func (z *Zenon) send(ctx context.Context, transaction *nom.AccountBlock, signer wallet.Signer) (*nom.AccountBlock, error) {
+	if _, err := z.prepareBlock(ctx, transaction, signer); err != nil {
+		z.resetChainTracker(transaction)
 		return nil, err
 	}
 
+	if err := ctx.Err(); err != nil {
+		z.resetChainTracker(transaction)
+		return nil, fmt.Errorf("transaction not published: %w", err)
+	}
 	if err := z.client.LedgerApi.PublishRawTransaction(transaction); err != nil {
+		z.resetChainTracker(transaction)
 		return nil, fmt.Errorf("failed to publish transaction: %w", err)
 	}
 
+	if z.ChainTracker != nil {
+		z.ChainTracker.Advance(transaction)
+	}
 	return transaction, nil
 }
 
+// resetChainTracker forgets the cached frontier of the transaction's sender
+// after a failed send.
+func (z *Zenon) resetChainTracker(transaction *nom.AccountBlock) {
+	if z.ChainTracker != nil && transaction.Address != types.ZeroAddress {
+		z.ChainTracker.Reset(transaction.Address)
+	}
+}
+
 // PrepareBlock runs the full send flow except the final publish step.
 //
 // This is useful when you need to inspect, persist, or hand off a signed
@@ -129,7 +160,7 @@ func (z *Zenon) Send(transaction *nom.AccountBlock, keyPair *wallet.KeyPair) (*n
 //
 // Parameters:
 //   - transaction: An unsigned *nom.AccountBlock template. It is mutated in place.
-//   - keyPair: The *wallet.KeyPair that signs the transaction.
+//   - signer: The wallet.Signer that signs the transaction.
 //
 // Returns the populated and signed *nom.AccountBlock (the same pointer passed in)
 // or an error. After a successful call the block carries a valid hash, signature,
@@ -144,14 +175,26 @@ func (z *Zenon) Send(transaction *nom.AccountBlock, keyPair *wallet.KeyPair) (*n
 //	}
 //	// ... later ...
 //	err = client.LedgerApi.PublishRawTransaction(signed)
-func (z *Zenon) PrepareBlock(transaction *nom.AccountBlock, keyPair *wallet.KeyPair) (*nom.AccountBlock, error) {
-	if err := z.checkAndSetFields(transaction, keyPair); err != nil {
+func (z *Zenon) PrepareBlock(transaction *nom.AccountBlock, signer wallet.Signer) (*nom.AccountBlock, error) {
+	return z.prepareBlock(context.Background(), transaction, signer)
+}
+
+// prepareBlock is PrepareBlock with a context that is checked before the node
+// is queried and that cancels PoW generation.
+func (z *Zenon) prepareBlock(ctx context.Context, transaction *nom.AccountBlock, signer wallet.Signer) (*nom.AccountBlock, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := z.checkAndSetFields(transaction, signer); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	if err := z.setDifficulty(transaction); err != nil {
+	if err := z.setDifficulty(ctx, transaction); err != nil {
 		return nil, err
 	}
-	if err := z.setHashAndSignature(transaction, keyPair); err != nil {
+	if err := z.setHashAndSignature(transaction, signer); err != nil {
 		return nil, err
 	}
 	return transaction, nil
@@ -161,13 +204,13 @@ func (z *Zenon) PrepareBlock(transaction *nom.AccountBlock, keyPair *wallet.KeyP
 // whether the sending address lacks sufficient fused plasma to cover it.
 //
 // This queries the node without modifying or sending anything (beyond setting the
-// transaction's Address from the keypair so the query can be made). Use it to
+// transaction's Address from the signer so the query can be made). Use it to
 // decide whether to warn a user about an upcoming PoW computation.
 //
 // Parameters:
 //   - transaction: The *nom.AccountBlock template to evaluate. Its Address is set
-//     from keyPair as a side effect.
-//   - keyPair: The *wallet.KeyPair whose address will send the transaction.
+//     from signer as a side effect.
+//   - signer: The wallet.Signer whose address will send the transaction.
 //
 // Returns true if PoW would be required, false if available plasma is sufficient,
 // or an error if the node query fails.
@@ -178,8 +221,8 @@ func (z *Zenon) PrepareBlock(transaction *nom.AccountBlock, keyPair *wallet.KeyP
 //	if err == nil && needed {
 //	    fmt.Println("This transaction will require Proof-of-Work.")
 //	}
-func (z *Zenon) RequiresPoW(transaction *nom.AccountBlock, keyPair *wallet.KeyPair) (bool, error) {
-	address, err := keyPair.GetAddress()
+func (z *Zenon) RequiresPoW(transaction *nom.AccountBlock, signer wallet.Signer) (bool, error) {
+	address, err := signer.GetAddress()
 	if err != nil {
 		return false, fmt.Errorf("failed to derive address: %w", err)
 	}
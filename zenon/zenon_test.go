@@ -1,15 +1,19 @@
 package zenon
 
 import (
+	"bytes"
+	"crypto/ed25519"
 	"encoding/json"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/0x3639/znn-sdk-go/api/embedded"
+	"github.com/0x3639/znn-sdk-go/crypto"
 	"github.com/0x3639/znn-sdk-go/pow"
 	"github.com/0x3639/znn-sdk-go/rpc_client"
 	"github.com/0x3639/znn-sdk-go/transport"
@@ -181,6 +185,29 @@ type zenonRPCFixture struct {
 	errors    map[string]string
 	calls     []string
 	published *nom.AccountBlock
+
+	unreceived      interface{}
+	accountInfo     interface{}
+	publishedBlocks []*nom.AccountBlock
+
+	// mailbox, when set, replaces unreceived with a node-like mailbox: at most
+	// 500 blocks are listed, More is set while it holds 500 or more, and a
+	// published receive removes its send block.
+	mailbox []*nodeapi.AccountBlock
+
+	// frontierFromPublished serves the last published block as the account
+	// frontier, as a node does once it accepts a block.
+	frontierFromPublished bool
+	// publishErrors rejects the publish attempt with the given zero-based
+	// index with the mapped message.
+	publishErrors   map[int]string
+	publishAttempts int
+	// confirmPublished serves the last published block, with a confirmation
+	// detail, when it is looked up by hash.
+	confirmPublished bool
+	// onPublish runs after each accepted publish, for example to cancel a
+	// context mid-batch.
+	onPublish func()
 }
 
 func newZenonTestClient(t *testing.T, fixture *zenonRPCFixture) (*rpc_client.RpcClient, func()) {
@@ -195,7 +222,14 @@ func newZenonTestClient(t *testing.T, fixture *zenonRPCFixture) (*rpc_client.Rpc
 		}
 		fixture.calls = append(fixture.calls, rpcRequest.Method)
 		writer.Header().Set("Content-Type", "application/json")
-		if message := fixture.errors[rpcRequest.Method]; message != "" {
+		message := fixture.errors[rpcRequest.Method]
+		if rpcRequest.Method == "ledger.publishRawTransaction" {
+			if publishMessage := fixture.publishErrors[fixture.publishAttempts]; publishMessage != "" {
+				message = publishMessage
+			}
+			fixture.publishAttempts++
+		}
+		if message != "" {
 			_ = json.NewEncoder(writer).Encode(map[string]interface{}{
 				"jsonrpc": "2.0", "id": rpcRequest.ID,
 				"error": map[string]interface{}{"code": -32000, "message": message},
@@ -206,10 +240,20 @@ func newZenonTestClient(t *testing.T, fixture *zenonRPCFixture) (*rpc_client.Rpc
 		switch rpcRequest.Method {
 		case "ledger.getFrontierAccountBlock":
 			result = fixture.frontier
+			if fixture.frontierFromPublished && fixture.published != nil {
+				result = fixture.published
+			}
 		case "ledger.getFrontierMomentum":
 			result = fixture.momentum
 		case "ledger.getAccountBlockByHash":
 			result = fixture.source
+			if fixture.confirmPublished && fixture.published != nil && len(rpcRequest.Params) == 1 &&
+				rpcRequest.Params[0] == fixture.published.Hash.String() {
+				result = &nodeapi.AccountBlock{
+					AccountBlock:       *fixture.published,
+					ConfirmationDetail: &nodeapi.AccountBlockConfirmationDetail{NumConfirmations: 1, MomentumHeight: 11},
+				}
+			}
 		case "embedded.plasma.getRequiredPoWForAccountBlock":
 			result = fixture.pow
 		case "ledger.publishRawTransaction":
@@ -217,8 +261,19 @@ func newZenonTestClient(t *testing.T, fixture *zenonRPCFixture) (*rpc_client.Rpc
 				raw, _ := json.Marshal(rpcRequest.Params[0])
 				fixture.published = new(nom.AccountBlock)
 				_ = json.Unmarshal(raw, fixture.published)
+				fixture.publishedBlocks = append(fixture.publishedBlocks, fixture.published)
+				fixture.mailbox = slices.DeleteFunc(fixture.mailbox, func(block *nodeapi.AccountBlock) bool {
+					return block.Hash == fixture.published.FromBlockHash
+				})
+			}
+			if fixture.onPublish != nil {
+				fixture.onPublish()
 			}
 			result = nil
+		case "ledger.getUnreceivedBlocksByAddress":
+			result = fixture.unreceivedPage(rpcRequest.Params)
+		case "ledger.getAccountInfoByAddress":
+			result = fixture.accountInfo
 		default:
 			t.Errorf("unexpected RPC method %q", rpcRequest.Method)
 		}
@@ -242,6 +297,25 @@ func newZenonTestClient(t *testing.T, fixture *zenonRPCFixture) (*rpc_client.Rpc
 	return client, cleanup
 }
 
+// unreceivedPage cuts the requested page out of fixture.mailbox or
+// fixture.unreceived the way a node does: Count and More describe the whole list, not the page. Fixtures
+// that are not an AccountBlockList are served as they are.
+func (fixture *zenonRPCFixture) unreceivedPage(params []interface{}) interface{} {
+	list, ok := fixture.unreceived.(*nodeapi.AccountBlockList)
+	if fixture.mailbox != nil {
+		listed := fixture.mailbox[:min(len(fixture.mailbox), 500)]
+		list, ok = &nodeapi.AccountBlockList{List: listed, Count: len(listed), More: len(fixture.mailbox) >= 500}, true
+	}
+	if !ok || len(params) != 3 {
+		return fixture.unreceived
+	}
+	pageIndex, _ := params[1].(float64)
+	pageSize, _ := params[2].(float64)
+	start := min(int(pageIndex*pageSize), len(list.List))
+	end := min(start+int(pageSize), len(list.List))
+	return &nodeapi.AccountBlockList{List: list.List[start:end], Count: list.Count, More: list.More}
+}
+
 func testMomentum(height, chainIdentifier uint64, hash types.Hash) *nodeapi.Momentum {
 	return &nodeapi.Momentum{Momentum: &nom.Momentum{
 		Version:         1,
@@ -299,6 +373,58 @@ func TestZenonSendCompletesPlasmaBackedFlow(t *testing.T) {
 	}
 }
 
+// mockSigner implements wallet.Signer with an in-test Ed25519 key, standing in
+// for a hardware or remote signer that never exposes its private key.
+type mockSigner struct {
+	privateKey ed25519.PrivateKey
+	signed     []types.Hash
+}
+
+func (m *mockSigner) SignHash(hash types.Hash) ([]byte, error) {
+	m.signed = append(m.signed, hash)
+	return ed25519.Sign(m.privateKey, hash.Bytes()), nil
+}
+
+func (m *mockSigner) GetPublicKey() ([]byte, error) {
+	return []byte(m.privateKey.Public().(ed25519.PublicKey)), nil
+}
+
+func (m *mockSigner) GetAddress() (*types.Address, error) {
+	publicKey, _ := m.GetPublicKey()
+	address := types.PubKeyToAddress(publicKey)
+	return &address, nil
+}
+
+func TestZenonSendWithCustomSigner(t *testing.T) {
+	fixture := &zenonRPCFixture{
+		momentum: testMomentum(99, 7, types.HexToHashPanic("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")),
+		pow:      embedded.GetRequiredResult{BasePlasma: 21000},
+		errors:   make(map[string]string),
+	}
+	client, cleanup := newZenonTestClient(t, fixture)
+	defer cleanup()
+
+	signer := &mockSigner{privateKey: ed25519.NewKeyFromSeed(bytes.Repeat([]byte{0x42}, ed25519.SeedSize))}
+	to := types.ParseAddressPanic("z1qzal6c5s9rjnnxd2z7dvdhjxpmmj4fmw56a0mz")
+	template := client.LedgerApi.SendTemplate(to, types.ZnnTokenStandard, big.NewInt(42), nil)
+
+	published, err := NewZenon(client).Send(template, signer)
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	address, _ := signer.GetAddress()
+	if published.Address != *address {
+		t.Errorf("Address = %s, want signer address %s", published.Address, address)
+	}
+	if len(signer.signed) != 1 || signer.signed[0] != published.Hash {
+		t.Errorf("signer was asked to sign %v, want only %s", signer.signed, published.Hash)
+	}
+	ok, err := crypto.VerifyWithAddress(published.Signature, published.Hash.Bytes(), published.PublicKey, published.Address)
+	if !ok {
+		t.Errorf("published block does not authenticate: %v", err)
+	}
+}
+
 func TestZenonPrepareBlockGeneratesPoWAndPreservesChainID(t *testing.T) {
 	frontierHash := types.HexToHashPanic("bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
 	momentumHash := types.HexToHashPanic("cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc")